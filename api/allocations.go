@@ -271,6 +271,7 @@ type AllocationMetric struct {
 	AllocationTime    time.Duration
 	CoalescedFailures int
 	ScoreMetaData     []*NodeScoreMeta
+	NodeExplanations  map[string]*NodeExplanation
 }
 
 // NodeScoreMeta is used to serialize node scoring metadata
@@ -281,6 +282,17 @@ type NodeScoreMeta struct {
 	NormScore float64
 }
 
+// NodeExplanation is used to serialize why a node was filtered or how it was
+// scored when explaining a job plan
+type NodeExplanation struct {
+	NodeID    string
+	NodeName  string
+	Filtered  string
+	Exhausted string
+	Scores    map[string]float64
+	NormScore float64
+}
+
 // Stub returns a list stub for the allocation
 func (a *Allocation) Stub() *AllocationListStub {
 	return &AllocationListStub{
@@ -383,6 +395,7 @@ type AllocatedTaskResources struct {
 	Memory   AllocatedMemoryResources
 	Networks []*NetworkResource
 	Devices  []*AllocatedDeviceResource
+	Custom   map[string]uint64
 }
 
 type AllocatedSharedResources struct {
@@ -412,6 +425,7 @@ type AllocatedDeviceResource struct {
 	Type      string
 	Name      string
 	DeviceIDs []string
+	Share     uint64
 }
 
 // AllocIndexSort reverse sorts allocs by CreateIndex.
@@ -204,6 +204,8 @@ type DeploymentState struct {
 	PlacedAllocs      int
 	HealthyAllocs     int
 	UnhealthyAllocs   int
+	Stages            []*UpdateStage
+	Stage             int
 }
 
 // DeploymentIndexSort is a wrapper to sort deployments by CreateIndex. We
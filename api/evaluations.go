@@ -113,6 +113,7 @@ type Evaluation struct {
 	BlockedEval          string
 	RelatedEvals         []*EvaluationStub
 	FailedTGAllocs       map[string]*AllocationMetric
+	DeferredTGAllocs     map[string]string
 	ClassEligibility     map[string]bool
 	EscapedComputedClass bool
 	QuotaLimitReached    string
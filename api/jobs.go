@@ -28,6 +28,13 @@ const (
 	// PeriodicSpecCron is used for a cron spec.
 	PeriodicSpecCron = "cron"
 
+	// PeriodicConcurrencyAllow, PeriodicConcurrencyForbid and
+	// PeriodicConcurrencyReplace are the concurrency policies of periodic
+	// jobs.
+	PeriodicConcurrencyAllow   = "allow"
+	PeriodicConcurrencyForbid  = "forbid"
+	PeriodicConcurrencyReplace = "replace"
+
 	// DefaultNamespace is the default namespace.
 	DefaultNamespace = "default"
 
@@ -413,6 +420,7 @@ func (j *Jobs) PeriodicForce(jobID string, q *WriteOptions) (string, *WriteMeta,
 type PlanOptions struct {
 	Diff           bool
 	PolicyOverride bool
+	Explain        bool
 }
 
 func (j *Jobs) Plan(job *Job, diff bool, q *WriteOptions) (*JobPlanResponse, *WriteMeta, error) {
@@ -432,6 +440,7 @@ func (j *Jobs) PlanOpts(job *Job, opts *PlanOptions, q *WriteOptions) (*JobPlanR
 	if opts != nil {
 		req.Diff = opts.Diff
 		req.PolicyOverride = opts.PolicyOverride
+		req.Explain = opts.Explain
 	}
 
 	var resp JobPlanResponse
@@ -467,6 +476,36 @@ func (j *Jobs) Dispatch(jobID string, meta map[string]string,
 	return &resp, wm, nil
 }
 
+// DispatchOptions is used to pass the parameters of a dispatch request.
+type DispatchOptions struct {
+	JobID            string
+	Meta             map[string]string
+	Payload          []byte
+	IdPrefixTemplate string
+
+	// ArrayCount is the number of indexed instances of the dispatched job to
+	// create. Each instance is passed its index through NOMAD_ARRAY_INDEX.
+	ArrayCount int
+}
+
+// DispatchOpts is used to dispatch a parameterized job with the given
+// options, including the number of instances of an array job.
+func (j *Jobs) DispatchOpts(opts *DispatchOptions, q *WriteOptions) (*JobDispatchResponse, *WriteMeta, error) {
+	var resp JobDispatchResponse
+	req := &JobDispatchRequest{
+		JobID:            opts.JobID,
+		Meta:             opts.Meta,
+		Payload:          opts.Payload,
+		IdPrefixTemplate: opts.IdPrefixTemplate,
+		ArrayCount:       opts.ArrayCount,
+	}
+	wm, err := j.client.write("/v1/job/"+url.PathEscape(opts.JobID)+"/dispatch", req, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, wm, nil
+}
+
 // Revert is used to revert the given job to the passed version. If
 // enforceVersion is set, the job is only reverted if the current version is at
 // the passed version.
@@ -520,15 +559,89 @@ type periodicForceResponse struct {
 
 // UpdateStrategy defines a task groups update strategy.
 type UpdateStrategy struct {
-	Stagger          *time.Duration `mapstructure:"stagger" hcl:"stagger,optional"`
-	MaxParallel      *int           `mapstructure:"max_parallel" hcl:"max_parallel,optional"`
-	HealthCheck      *string        `mapstructure:"health_check" hcl:"health_check,optional"`
-	MinHealthyTime   *time.Duration `mapstructure:"min_healthy_time" hcl:"min_healthy_time,optional"`
-	HealthyDeadline  *time.Duration `mapstructure:"healthy_deadline" hcl:"healthy_deadline,optional"`
-	ProgressDeadline *time.Duration `mapstructure:"progress_deadline" hcl:"progress_deadline,optional"`
-	Canary           *int           `mapstructure:"canary" hcl:"canary,optional"`
-	AutoRevert       *bool          `mapstructure:"auto_revert" hcl:"auto_revert,optional"`
-	AutoPromote      *bool          `mapstructure:"auto_promote" hcl:"auto_promote,optional"`
+	Stagger          *time.Duration  `mapstructure:"stagger" hcl:"stagger,optional"`
+	MaxParallel      *int            `mapstructure:"max_parallel" hcl:"max_parallel,optional"`
+	MaxSurge         *int            `mapstructure:"max_surge" hcl:"max_surge,optional"`
+	HealthCheck      *string         `mapstructure:"health_check" hcl:"health_check,optional"`
+	MinHealthyTime   *time.Duration  `mapstructure:"min_healthy_time" hcl:"min_healthy_time,optional"`
+	HealthyDeadline  *time.Duration  `mapstructure:"healthy_deadline" hcl:"healthy_deadline,optional"`
+	ProgressDeadline *time.Duration  `mapstructure:"progress_deadline" hcl:"progress_deadline,optional"`
+	Canary           *int            `mapstructure:"canary" hcl:"canary,optional"`
+	CanaryPercent    *int            `mapstructure:"canary_percent" hcl:"canary_percent,optional"`
+	AutoRevert       *bool           `mapstructure:"auto_revert" hcl:"auto_revert,optional"`
+	AutoPromote      *bool           `mapstructure:"auto_promote" hcl:"auto_promote,optional"`
+	Stages           []*UpdateStage  `mapstructure:"stage" hcl:"stage,block"`
+	Analysis         *CanaryAnalysis `mapstructure:"analysis" hcl:"analysis,block"`
+}
+
+// CanaryAnalysis compares the canaries of a deployment with the allocations of
+// the stable job version before they are promoted.
+type CanaryAnalysis struct {
+	MaxMemoryRatio *float64 `mapstructure:"max_memory_ratio" hcl:"max_memory_ratio,optional"`
+	MaxCPURatio    *float64 `mapstructure:"max_cpu_ratio" hcl:"max_cpu_ratio,optional"`
+	MaxRestarts    *int     `mapstructure:"max_restarts" hcl:"max_restarts,optional"`
+	MetricsURL     *string  `mapstructure:"metrics_url" hcl:"metrics_url,optional"`
+}
+
+func (a *CanaryAnalysis) Canonicalize() {
+	if a.MaxMemoryRatio == nil {
+		a.MaxMemoryRatio = pointerOf(0.0)
+	}
+	if a.MaxCPURatio == nil {
+		a.MaxCPURatio = pointerOf(0.0)
+	}
+	if a.MaxRestarts == nil {
+		a.MaxRestarts = pointerOf(0)
+	}
+	if a.MetricsURL == nil {
+		a.MetricsURL = pointerOf("")
+	}
+}
+
+func (a *CanaryAnalysis) Copy() *CanaryAnalysis {
+	if a == nil {
+		return nil
+	}
+	c := new(CanaryAnalysis)
+	if a.MaxMemoryRatio != nil {
+		c.MaxMemoryRatio = pointerOf(*a.MaxMemoryRatio)
+	}
+	if a.MaxCPURatio != nil {
+		c.MaxCPURatio = pointerOf(*a.MaxCPURatio)
+	}
+	if a.MaxRestarts != nil {
+		c.MaxRestarts = pointerOf(*a.MaxRestarts)
+	}
+	if a.MetricsURL != nil {
+		c.MetricsURL = pointerOf(*a.MetricsURL)
+	}
+	return c
+}
+
+// UpdateStage is a step of a staged rollout.
+type UpdateStage struct {
+	Percent int           `mapstructure:"percent" hcl:"percent,optional"`
+	Pause   time.Duration `mapstructure:"pause" hcl:"pause,optional"`
+}
+
+func (s *UpdateStage) Copy() *UpdateStage {
+	if s == nil {
+		return nil
+	}
+	c := new(UpdateStage)
+	*c = *s
+	return c
+}
+
+func copyUpdateStages(stages []*UpdateStage) []*UpdateStage {
+	if stages == nil {
+		return nil
+	}
+	c := make([]*UpdateStage, len(stages))
+	for i, stage := range stages {
+		c[i] = stage.Copy()
+	}
+	return c
 }
 
 // DefaultUpdateStrategy provides a baseline that can be used to upgrade
@@ -562,6 +675,10 @@ func (u *UpdateStrategy) Copy() *UpdateStrategy {
 		copy.MaxParallel = pointerOf(*u.MaxParallel)
 	}
 
+	if u.MaxSurge != nil {
+		copy.MaxSurge = pointerOf(*u.MaxSurge)
+	}
+
 	if u.HealthCheck != nil {
 		copy.HealthCheck = pointerOf(*u.HealthCheck)
 	}
@@ -586,10 +703,17 @@ func (u *UpdateStrategy) Copy() *UpdateStrategy {
 		copy.Canary = pointerOf(*u.Canary)
 	}
 
+	if u.CanaryPercent != nil {
+		copy.CanaryPercent = pointerOf(*u.CanaryPercent)
+	}
+
 	if u.AutoPromote != nil {
 		copy.AutoPromote = pointerOf(*u.AutoPromote)
 	}
 
+	copy.Stages = copyUpdateStages(u.Stages)
+	copy.Analysis = u.Analysis.Copy()
+
 	return copy
 }
 
@@ -606,6 +730,10 @@ func (u *UpdateStrategy) Merge(o *UpdateStrategy) {
 		u.MaxParallel = pointerOf(*o.MaxParallel)
 	}
 
+	if o.MaxSurge != nil {
+		u.MaxSurge = pointerOf(*o.MaxSurge)
+	}
+
 	if o.HealthCheck != nil {
 		u.HealthCheck = pointerOf(*o.HealthCheck)
 	}
@@ -630,9 +758,21 @@ func (u *UpdateStrategy) Merge(o *UpdateStrategy) {
 		u.Canary = pointerOf(*o.Canary)
 	}
 
+	if o.CanaryPercent != nil {
+		u.CanaryPercent = pointerOf(*o.CanaryPercent)
+	}
+
 	if o.AutoPromote != nil {
 		u.AutoPromote = pointerOf(*o.AutoPromote)
 	}
+
+	if len(o.Stages) != 0 {
+		u.Stages = copyUpdateStages(o.Stages)
+	}
+
+	if o.Analysis != nil {
+		u.Analysis = o.Analysis.Copy()
+	}
 }
 
 func (u *UpdateStrategy) Canonicalize() {
@@ -673,6 +813,10 @@ func (u *UpdateStrategy) Canonicalize() {
 	if u.AutoPromote == nil {
 		u.AutoPromote = d.AutoPromote
 	}
+
+	if u.Analysis != nil {
+		u.Analysis.Canonicalize()
+	}
 }
 
 // Empty returns whether the UpdateStrategy is empty or has user defined values.
@@ -689,6 +833,10 @@ func (u *UpdateStrategy) Empty() bool {
 		return false
 	}
 
+	if u.MaxSurge != nil && *u.MaxSurge != 0 {
+		return false
+	}
+
 	if u.HealthCheck != nil && *u.HealthCheck != "" {
 		return false
 	}
@@ -717,6 +865,18 @@ func (u *UpdateStrategy) Empty() bool {
 		return false
 	}
 
+	if u.CanaryPercent != nil && *u.CanaryPercent != 0 {
+		return false
+	}
+
+	if len(u.Stages) != 0 {
+		return false
+	}
+
+	if u.Analysis != nil {
+		return false
+	}
+
 	return true
 }
 
@@ -790,13 +950,26 @@ type MultiregionRegion struct {
 	Meta        map[string]string `hcl:"meta,block"`
 }
 
+// Scheduling is used to serialize the scheduler overrides of a job, task
+// group or namespace.
+type Scheduling struct {
+	Algorithm string `hcl:"algorithm,optional"`
+}
+
 // PeriodicConfig is for serializing periodic config for a job.
 type PeriodicConfig struct {
-	Enabled         *bool   `hcl:"enabled,optional"`
-	Spec            *string `hcl:"cron,optional"`
+	Enabled         *bool    `hcl:"enabled,optional"`
+	Spec            *string  `hcl:"cron,optional"`
+	Specs           []string `mapstructure:"crons" hcl:"crons,optional"`
 	SpecType        *string
-	ProhibitOverlap *bool   `mapstructure:"prohibit_overlap" hcl:"prohibit_overlap,optional"`
-	TimeZone        *string `mapstructure:"time_zone" hcl:"time_zone,optional"`
+	ProhibitOverlap *bool          `mapstructure:"prohibit_overlap" hcl:"prohibit_overlap,optional"`
+	TimeZone        *string        `mapstructure:"time_zone" hcl:"time_zone,optional"`
+	CatchupWindow   *time.Duration `mapstructure:"catchup_window" hcl:"catchup_window,optional"`
+	Jitter          *time.Duration `hcl:"jitter,optional"`
+
+	ConcurrencyPolicy      *string `mapstructure:"concurrency_policy" hcl:"concurrency_policy,optional"`
+	SuccessfulHistoryLimit *int    `mapstructure:"successful_history_limit" hcl:"successful_history_limit,optional"`
+	FailedHistoryLimit     *int    `mapstructure:"failed_history_limit" hcl:"failed_history_limit,optional"`
 }
 
 func (p *PeriodicConfig) Canonicalize() {
@@ -815,6 +988,21 @@ func (p *PeriodicConfig) Canonicalize() {
 	if p.TimeZone == nil || *p.TimeZone == "" {
 		p.TimeZone = pointerOf("UTC")
 	}
+	if p.CatchupWindow == nil {
+		p.CatchupWindow = pointerOf(time.Duration(0))
+	}
+	if p.Jitter == nil {
+		p.Jitter = pointerOf(time.Duration(0))
+	}
+	if p.ConcurrencyPolicy == nil {
+		p.ConcurrencyPolicy = pointerOf("")
+	}
+	if p.SuccessfulHistoryLimit == nil {
+		p.SuccessfulHistoryLimit = pointerOf(0)
+	}
+	if p.FailedHistoryLimit == nil {
+		p.FailedHistoryLimit = pointerOf(0)
+	}
 }
 
 // Next returns the closest time instant matching the spec that is after the
@@ -822,15 +1010,30 @@ func (p *PeriodicConfig) Canonicalize() {
 // returned. The `time.Location` of the returned value matches that of the
 // passed time.
 func (p *PeriodicConfig) Next(fromTime time.Time) (time.Time, error) {
-	if *p.SpecType == PeriodicSpecCron {
-		e, err := cronexpr.Parse(*p.Spec)
+	if *p.SpecType != PeriodicSpecCron {
+		return time.Time{}, nil
+	}
+
+	specs := p.Specs
+	if len(specs) == 0 {
+		specs = []string{*p.Spec}
+	}
+
+	var next time.Time
+	for _, spec := range specs {
+		e, err := cronexpr.Parse(spec)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed parsing cron expression %q: %v", spec, err)
+		}
+		t, err := cronParseNext(e, fromTime, spec)
 		if err != nil {
-			return time.Time{}, fmt.Errorf("failed parsing cron expression %q: %v", *p.Spec, err)
+			return time.Time{}, err
+		}
+		if !t.IsZero() && (next.IsZero() || t.Before(next)) {
+			next = t
 		}
-		return cronParseNext(e, fromTime, *p.Spec)
 	}
-
-	return time.Time{}, nil
+	return next, nil
 }
 
 // cronParseNext is a helper that parses the next time for the given expression
@@ -875,12 +1078,15 @@ type Job struct {
 	Priority         *int                    `hcl:"priority,optional"`
 	AllAtOnce        *bool                   `mapstructure:"all_at_once" hcl:"all_at_once,optional"`
 	Datacenters      []string                `hcl:"datacenters,optional"`
+	NodePool         *string                 `mapstructure:"node_pool" hcl:"node_pool,optional"`
 	Constraints      []*Constraint           `hcl:"constraint,block"`
 	Affinities       []*Affinity             `hcl:"affinity,block"`
+	AllocAffinities  []*AllocAffinity        `hcl:"alloc_affinity,block"`
 	TaskGroups       []*TaskGroup            `hcl:"group,block"`
 	Update           *UpdateStrategy         `hcl:"update,block"`
 	Multiregion      *Multiregion            `hcl:"multiregion,block"`
 	Spreads          []*Spread               `hcl:"spread,block"`
+	Scheduling       *Scheduling             `hcl:"scheduling,block"`
 	Periodic         *PeriodicConfig         `hcl:"periodic,block"`
 	ParameterizedJob *ParameterizedJobConfig `hcl:"parameterized,block"`
 	Reschedule       *ReschedulePolicy       `hcl:"reschedule,block"`
@@ -895,6 +1101,7 @@ type Job struct {
 	ParentID                 *string
 	Dispatched               bool
 	DispatchIdempotencyToken *string
+	ArrayCount               int
 	Payload                  []byte
 	ConsulNamespace          *string `mapstructure:"consul_namespace"`
 	VaultNamespace           *string `mapstructure:"vault_namespace"`
@@ -1013,6 +1220,9 @@ func (j *Job) Canonicalize() {
 	for _, a := range j.Affinities {
 		a.Canonicalize()
 	}
+	for _, a := range j.AllocAffinities {
+		a.Canonicalize()
+	}
 }
 
 // LookupTaskGroup finds a task group by name
@@ -1293,6 +1503,7 @@ type JobPlanRequest struct {
 	Job            *Job
 	Diff           bool
 	PolicyOverride bool
+	Explain        bool
 	WriteRequest
 }
 
@@ -1307,6 +1518,19 @@ type JobPlanResponse struct {
 	// Warnings contains any warnings about the given job. These may include
 	// deprecation warnings.
 	Warnings string
+
+	// Explanations describes how the node of each placement was chosen when
+	// the plan was requested with Explain. Task groups that failed to place
+	// are explained in the metrics of FailedTGAllocs instead.
+	Explanations []*PlacementExplanation
+}
+
+// PlacementExplanation describes how the node of a placement was chosen.
+type PlacementExplanation struct {
+	AllocName string
+	TaskGroup string
+	NodeID    string
+	Metrics   *AllocationMetric
 }
 
 type JobDiff struct {
@@ -1349,8 +1573,9 @@ type ObjectDiff struct {
 }
 
 type PlanAnnotations struct {
-	DesiredTGUpdates map[string]*DesiredUpdates
-	PreemptedAllocs  []*AllocationListStub
+	DesiredTGUpdates    map[string]*DesiredUpdates
+	PreemptedAllocs     []*AllocationListStub
+	SchedulerAlgorithms map[string]SchedulerAlgorithm
 }
 
 type DesiredUpdates struct {
@@ -1369,6 +1594,7 @@ type JobDispatchRequest struct {
 	Payload          []byte
 	Meta             map[string]string
 	IdPrefixTemplate string
+	ArrayCount       int
 }
 
 type JobDispatchResponse struct {
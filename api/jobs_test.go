@@ -838,6 +838,12 @@ func TestJobs_Canonicalize(t *testing.T) {
 					SpecType:        pointerOf(PeriodicSpecCron),
 					ProhibitOverlap: pointerOf(false),
 					TimeZone:        pointerOf("UTC"),
+					CatchupWindow:   pointerOf(time.Duration(0)),
+					Jitter:          pointerOf(time.Duration(0)),
+
+					ConcurrencyPolicy:      pointerOf(""),
+					SuccessfulHistoryLimit: pointerOf(0),
+					FailedHistoryLimit:     pointerOf(0),
 				},
 			},
 		},
@@ -1840,6 +1846,20 @@ func TestJobs_PeriodicForce(t *testing.T) {
 	t.Fatalf("evaluation %q missing", evalID)
 }
 
+func TestPeriodicConfig_Next_Crons(t *testing.T) {
+	testutil.Parallel(t)
+
+	from := time.Date(2009, time.November, 10, 23, 22, 30, 0, time.UTC)
+	p := &PeriodicConfig{
+		Specs: []string{"*/10 * * * *", "*/5 * * * *"},
+	}
+	p.Canonicalize()
+
+	next, err := p.Next(from)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2009, time.November, 10, 23, 25, 0, 0, time.UTC), next)
+}
+
 func TestJobs_Plan(t *testing.T) {
 	testutil.Parallel(t)
 	c, s := makeClient(t, nil, nil)
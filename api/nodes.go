@@ -538,6 +538,7 @@ type DrainMetadata struct {
 type Node struct {
 	ID                    string
 	Datacenter            string
+	NodePool              string
 	Name                  string
 	HTTPAddr              string
 	TLSEnabled            bool
@@ -573,6 +574,7 @@ type NodeResources struct {
 	Disk     NodeDiskResources
 	Networks []*NetworkResource
 	Devices  []*NodeDeviceResource
+	Custom   map[string]uint64
 
 	MinDynamicPort int
 	MaxDynamicPort int
@@ -908,6 +910,7 @@ type NodeListStub struct {
 	ID                    string
 	Attributes            map[string]string `json:",omitempty"`
 	Datacenter            string
+	NodePool              string
 	Name                  string
 	NodeClass             string
 	Version               string
@@ -138,6 +138,10 @@ type SchedulerConfiguration struct {
 	// until the configuration is updated and written to the Nomad servers.
 	PauseEvalBroker bool
 
+	// RebalanceConfig controls whether the leader periodically migrates
+	// allocations to reduce cluster fragmentation.
+	RebalanceConfig RebalanceConfig
+
 	// CreateIndex/ModifyIndex store the create/modify indexes of this configuration.
 	CreateIndex uint64
 	ModifyIndex uint64
@@ -179,6 +183,37 @@ type PreemptionConfig struct {
 	ServiceSchedulerEnabled  bool
 }
 
+// RebalanceConfig configures the periodic rebalancing of the cluster.
+type RebalanceConfig struct {
+	Enabled       bool
+	MaxMigrations int
+}
+
+// SchedulerRebalanceResponse is the response of a rebalance request.
+type SchedulerRebalanceResponse struct {
+	// Migrations are the allocations migrated, or that would be migrated on
+	// a dry run, to rebalance the cluster.
+	Migrations []*RebalanceMigration
+
+	// EvalIDs are the evaluations created for the jobs of the migrated
+	// allocations.
+	EvalIDs []string
+
+	WriteMeta
+}
+
+// RebalanceMigration describes an allocation migrated to rebalance the
+// cluster.
+type RebalanceMigration struct {
+	AllocID      string
+	AllocName    string
+	Namespace    string
+	JobID        string
+	TaskGroup    string
+	SourceNodeID string
+	TargetNodeID string
+}
+
 // SchedulerGetConfiguration is used to query the current Scheduler configuration.
 func (op *Operator) SchedulerGetConfiguration(q *QueryOptions) (*SchedulerConfigurationResponse, *QueryMeta, error) {
 	var resp SchedulerConfigurationResponse
@@ -212,6 +247,32 @@ func (op *Operator) SchedulerCASConfiguration(conf *SchedulerConfiguration, q *W
 	return &out, wm, nil
 }
 
+// SchedulerRebalancePlan is used to compute the allocation migrations that
+// would rebalance the cluster without applying them. A maxMigrations of zero
+// uses the limit of the rebalance configuration.
+func (op *Operator) SchedulerRebalancePlan(maxMigrations int, q *QueryOptions) (*SchedulerRebalanceResponse, *QueryMeta, error) {
+	var resp SchedulerRebalanceResponse
+	path := "/v1/operator/scheduler/rebalance?max_migrations=" + strconv.Itoa(maxMigrations)
+	qm, err := op.c.query(path, &resp, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resp, qm, nil
+}
+
+// SchedulerRebalance is used to migrate the allocations that rebalance the
+// cluster. A maxMigrations of zero uses the limit of the rebalance
+// configuration.
+func (op *Operator) SchedulerRebalance(maxMigrations int, q *WriteOptions) (*SchedulerRebalanceResponse, *WriteMeta, error) {
+	var out SchedulerRebalanceResponse
+	path := "/v1/operator/scheduler/rebalance?max_migrations=" + strconv.Itoa(maxMigrations)
+	wm, err := op.c.write(path, nil, &out, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &out, wm, nil
+}
+
 // Snapshot is used to capture a snapshot state of a running cluster.
 // The returned reader that must be consumed fully
 func (op *Operator) Snapshot(q *QueryOptions) (io.ReadCloser, error) {
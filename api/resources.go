@@ -9,11 +9,13 @@ import (
 type Resources struct {
 	CPU         *int               `hcl:"cpu,optional"`
 	Cores       *int               `hcl:"cores,optional"`
+	NUMA        *NUMAResource      `hcl:"numa,block"`
 	MemoryMB    *int               `mapstructure:"memory" hcl:"memory,optional"`
 	MemoryMaxMB *int               `mapstructure:"memory_max" hcl:"memory_max,optional"`
 	DiskMB      *int               `mapstructure:"disk" hcl:"disk,optional"`
 	Networks    []*NetworkResource `hcl:"network,block"`
 	Devices     []*RequestedDevice `hcl:"device,block"`
+	Custom      []*CustomResource  `hcl:"custom,block"`
 
 	// COMPAT(0.10)
 	// XXX Deprecated. Please do not use. The field will be removed in Nomad
@@ -44,9 +46,13 @@ func (r *Resources) Canonicalize() {
 	if r.MemoryMB == nil {
 		r.MemoryMB = defaultResources.MemoryMB
 	}
+	r.NUMA.Canonicalize()
 	for _, d := range r.Devices {
 		d.Canonicalize()
 	}
+	for _, c := range r.Custom {
+		c.Canonicalize()
+	}
 }
 
 // DefaultResources is a small resources object that contains the
@@ -96,6 +102,22 @@ func (r *Resources) Merge(other *Resources) {
 	}
 }
 
+// NUMAResource configures the NUMA placement of the cores reserved for a
+// task.
+type NUMAResource struct {
+	// Affinity is one of "none", "prefer" or "require".
+	Affinity string `hcl:"affinity,optional"`
+}
+
+func (n *NUMAResource) Canonicalize() {
+	if n == nil {
+		return
+	}
+	if n.Affinity == "" {
+		n.Affinity = "prefer"
+	}
+}
+
 type Port struct {
 	Label       string `hcl:",label"`
 	Value       int    `hcl:"static,optional"`
@@ -169,6 +191,10 @@ type NodeDeviceResource struct {
 	Instances []*NodeDevice
 
 	Attributes map[string]*Attribute
+
+	// Capacity marks the instances as shareable when set, and is the amount
+	// of each instance that can be split between tasks
+	Capacity uint64
 }
 
 func (r NodeDeviceResource) ID() string {
@@ -257,6 +283,10 @@ type RequestedDevice struct {
 	// Count is the number of requested devices
 	Count *uint64 `hcl:"count,optional"`
 
+	// Share is the amount of the capacity of each shareable device that is
+	// requested. When set, the devices may be shared with other tasks.
+	Share uint64 `hcl:"share,optional"`
+
 	// Constraints are a set of constraints to apply when selecting the device
 	// to use.
 	Constraints []*Constraint `hcl:"constraint,block"`
@@ -275,3 +305,19 @@ func (d *RequestedDevice) Canonicalize() {
 		a.Canonicalize()
 	}
 }
+
+// CustomResource is used to request a count of a custom resource declared in
+// the client configuration, such as license seats or hardware tokens.
+type CustomResource struct {
+	// Name is the name of the custom resource.
+	Name string `hcl:",label"`
+
+	// Count is the number of requested units of the resource.
+	Count *uint64 `hcl:"count,optional"`
+}
+
+func (c *CustomResource) Canonicalize() {
+	if c.Count == nil {
+		c.Count = pointerOf(uint64(1))
+	}
+}
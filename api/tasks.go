@@ -85,10 +85,13 @@ type AllocCheckStatuses map[string]AllocCheckStatus
 // RestartPolicy defines how the Nomad client restarts
 // tasks in a taskgroup when they fail
 type RestartPolicy struct {
-	Interval *time.Duration `hcl:"interval,optional"`
-	Attempts *int           `hcl:"attempts,optional"`
-	Delay    *time.Duration `hcl:"delay,optional"`
-	Mode     *string        `hcl:"mode,optional"`
+	Interval      *time.Duration `hcl:"interval,optional"`
+	Attempts      *int           `hcl:"attempts,optional"`
+	Delay         *time.Duration `hcl:"delay,optional"`
+	DelayFunction *string        `mapstructure:"delay_function" hcl:"delay_function,optional"`
+	MaxDelay      *time.Duration `mapstructure:"max_delay" hcl:"max_delay,optional"`
+	Mode          *string        `hcl:"mode,optional"`
+	OnExit        []*ExitRule    `mapstructure:"on_exit" hcl:"on_exit,block"`
 }
 
 func (r *RestartPolicy) Merge(rp *RestartPolicy) {
@@ -101,9 +104,45 @@ func (r *RestartPolicy) Merge(rp *RestartPolicy) {
 	if rp.Delay != nil {
 		r.Delay = rp.Delay
 	}
+	if rp.DelayFunction != nil {
+		r.DelayFunction = rp.DelayFunction
+	}
+	if rp.MaxDelay != nil {
+		r.MaxDelay = rp.MaxDelay
+	}
 	if rp.Mode != nil {
 		r.Mode = rp.Mode
 	}
+	if rp.OnExit != nil {
+		r.OnExit = rp.OnExit
+	}
+}
+
+// Canonicalize defaults the max delay of a policy that increases its delay to
+// the restart interval, or to the delay if that is longer.
+func (r *RestartPolicy) Canonicalize() {
+	if r.MaxDelay != nil || r.DelayFunction == nil {
+		return
+	}
+	switch *r.DelayFunction {
+	case "exponential", "fibonacci":
+		maxDelay := time.Duration(0)
+		if r.Interval != nil {
+			maxDelay = *r.Interval
+		}
+		if r.Delay != nil && *r.Delay > maxDelay {
+			maxDelay = *r.Delay
+		}
+		r.MaxDelay = pointerOf(maxDelay)
+	}
+}
+
+// ExitRule classifies the exits of a task by their exit code or the signal
+// that terminated the task. Action is one of "retry", "fail" or "success".
+type ExitRule struct {
+	ExitCodes []int    `mapstructure:"exit_codes" hcl:"exit_codes,optional"`
+	Signals   []string `hcl:"signals,optional"`
+	Action    string   `hcl:"action,optional"`
 }
 
 // Reschedule configures how Tasks are rescheduled  when they crash or fail.
@@ -127,6 +166,10 @@ type ReschedulePolicy struct {
 
 	// Unlimited allows rescheduling attempts until they succeed
 	Unlimited *bool `mapstructure:"unlimited" hcl:"unlimited,optional"`
+
+	// OnExit classifies the exits of failed tasks to override whether their
+	// allocation is rescheduled.
+	OnExit []*ExitRule `mapstructure:"on_exit" hcl:"on_exit,block"`
 }
 
 func (r *ReschedulePolicy) Merge(rp *ReschedulePolicy) {
@@ -151,6 +194,9 @@ func (r *ReschedulePolicy) Merge(rp *ReschedulePolicy) {
 	if rp.Unlimited != nil {
 		r.Unlimited = rp.Unlimited
 	}
+	if rp.OnExit != nil {
+		r.OnExit = rp.OnExit
+	}
 }
 
 func (r *ReschedulePolicy) Canonicalize(jobType string) {
@@ -198,6 +244,35 @@ func (a *Affinity) Canonicalize() {
 	}
 }
 
+// AllocAffinity is used to serialize job and task group allocation affinities
+type AllocAffinity struct {
+	Namespace string            `hcl:"namespace,optional"`
+	JobID     string            `mapstructure:"job_id" hcl:"job_id,optional"`
+	TaskGroup string            `mapstructure:"task_group" hcl:"task_group,optional"`
+	JobMeta   map[string]string `mapstructure:"job_meta" hcl:"job_meta,optional"`
+	Anti      bool              `hcl:"anti,optional"`
+	Required  bool              `hcl:"required,optional"`
+	Weight    *int8             `hcl:"weight,optional"`
+}
+
+func (a *AllocAffinity) Canonicalize() {
+	if a.Weight == nil {
+		a.Weight = pointerOf(int8(50))
+	}
+}
+
+// GroupDependency is used to serialize the task groups a task group depends on
+type GroupDependency struct {
+	Group     string  `hcl:"group,optional"`
+	Condition *string `hcl:"condition,optional"`
+}
+
+func (d *GroupDependency) Canonicalize() {
+	if d.Condition == nil {
+		d.Condition = pointerOf("healthy")
+	}
+}
+
 func NewDefaultReschedulePolicy(jobType string) *ReschedulePolicy {
 	var dp *ReschedulePolicy
 	switch jobType {
@@ -276,6 +351,8 @@ type Spread struct {
 	Attribute    string          `hcl:"attribute,optional"`
 	Weight       *int8           `hcl:"weight,optional"`
 	SpreadTarget []*SpreadTarget `hcl:"target,block"`
+	MaxSkew      int             `mapstructure:"max_skew" hcl:"max_skew,optional"`
+	Required     bool            `hcl:"required,optional"`
 }
 
 // SpreadTarget is used to serialize target allocation spread percentages
@@ -436,8 +513,12 @@ type TaskGroup struct {
 	Count                     *int                      `hcl:"count,optional"`
 	Constraints               []*Constraint             `hcl:"constraint,block"`
 	Affinities                []*Affinity               `hcl:"affinity,block"`
+	AllocAffinities           []*AllocAffinity          `hcl:"alloc_affinity,block"`
 	Tasks                     []*Task                   `hcl:"task,block"`
 	Spreads                   []*Spread                 `hcl:"spread,block"`
+	Scheduling                *Scheduling               `hcl:"scheduling,block"`
+	Gang                      *bool                     `hcl:"gang,optional"`
+	DependsOn                 []*GroupDependency        `mapstructure:"depends_on" hcl:"depends_on,block"`
 	Volumes                   map[string]*VolumeRequest `hcl:"volume,block"`
 	RestartPolicy             *RestartPolicy            `hcl:"restart,block"`
 	ReschedulePolicy          *ReschedulePolicy         `hcl:"reschedule,block"`
@@ -552,6 +633,7 @@ func (g *TaskGroup) Canonicalize(job *Job) {
 	if g.RestartPolicy != nil {
 		defaultRestartPolicy.Merge(g.RestartPolicy)
 	}
+	defaultRestartPolicy.Canonicalize()
 	g.RestartPolicy = defaultRestartPolicy
 
 	for _, t := range g.Tasks {
@@ -564,6 +646,12 @@ func (g *TaskGroup) Canonicalize(job *Job) {
 	for _, a := range g.Affinities {
 		a.Canonicalize()
 	}
+	for _, a := range g.AllocAffinities {
+		a.Canonicalize()
+	}
+	for _, d := range g.DependsOn {
+		d.Canonicalize()
+	}
 	for _, n := range g.Networks {
 		n.Canonicalize()
 	}
@@ -667,8 +755,10 @@ const (
 )
 
 type TaskLifecycle struct {
-	Hook    string `mapstructure:"hook" hcl:"hook,optional"`
-	Sidecar bool   `mapstructure:"sidecar" hcl:"sidecar,optional"`
+	Hook         string         `mapstructure:"hook" hcl:"hook,optional"`
+	Sidecar      bool           `mapstructure:"sidecar" hcl:"sidecar,optional"`
+	ReadyChecks  bool           `mapstructure:"ready_checks" hcl:"ready_checks,optional"`
+	ReadyTimeout *time.Duration `mapstructure:"ready_timeout" hcl:"ready_timeout,optional"`
 }
 
 // Determine if lifecycle has user-input values
@@ -676,6 +766,20 @@ func (l *TaskLifecycle) Empty() bool {
 	return l == nil || (l.Hook == "")
 }
 
+// TaskPrestop is a command executed inside a running task before it is sent
+// its kill signal.
+type TaskPrestop struct {
+	Command string         `mapstructure:"command" hcl:"command,optional"`
+	Args    []string       `mapstructure:"args" hcl:"args,optional"`
+	Timeout *time.Duration `mapstructure:"timeout" hcl:"timeout,optional"`
+}
+
+func (p *TaskPrestop) Canonicalize() {
+	if p.Timeout == nil {
+		p.Timeout = pointerOf(30 * time.Second)
+	}
+}
+
 // Task is a single process in a task group.
 type Task struct {
 	Name            string                 `hcl:"name,label"`
@@ -700,6 +804,7 @@ type Task struct {
 	CSIPluginConfig *TaskCSIPluginConfig   `mapstructure:"csi_plugin" json:",omitempty" hcl:"csi_plugin,block"`
 	Leader          bool                   `hcl:"leader,optional"`
 	ShutdownDelay   time.Duration          `mapstructure:"shutdown_delay" hcl:"shutdown_delay,optional"`
+	Prestop         *TaskPrestop           `hcl:"prestop,block"`
 	KillSignal      string                 `mapstructure:"kill_signal" hcl:"kill_signal,optional"`
 	Kind            string                 `hcl:"kind,optional"`
 	ScalingPolicies []*ScalingPolicy       `hcl:"scaling,block"`
@@ -737,8 +842,13 @@ func (t *Task) Canonicalize(tg *TaskGroup, job *Job) {
 	for _, vm := range t.VolumeMounts {
 		vm.Canonicalize()
 	}
+	if t.Prestop != nil {
+		t.Prestop.Canonicalize()
+	}
 	if t.Lifecycle.Empty() {
 		t.Lifecycle = nil
+	} else if t.Lifecycle.ReadyChecks && t.Lifecycle.ReadyTimeout == nil {
+		t.Lifecycle.ReadyTimeout = pointerOf(5 * time.Minute)
 	}
 	if t.CSIPluginConfig != nil {
 		t.CSIPluginConfig.Canonicalize()
@@ -749,6 +859,7 @@ func (t *Task) Canonicalize(tg *TaskGroup, job *Job) {
 		tgrp := &RestartPolicy{}
 		*tgrp = *tg.RestartPolicy
 		tgrp.Merge(t.RestartPolicy)
+		tgrp.Canonicalize()
 		t.RestartPolicy = tgrp
 	}
 }
@@ -547,6 +547,44 @@ func TestTaskGroup_Canonicalize_Update(t *testing.T) {
 	assert.Nil(t, tg.Update)
 }
 
+func TestTaskGroup_Canonicalize_RestartPolicy_MaxDelay(t *testing.T) {
+	testutil.Parallel(t)
+	job := &Job{
+		ID:   pointerOf("test"),
+		Type: pointerOf("service"),
+	}
+	job.Canonicalize()
+
+	// An increasing delay without a max delay is bounded by the interval
+	tg := &TaskGroup{
+		Name: pointerOf("foo"),
+		RestartPolicy: &RestartPolicy{
+			DelayFunction: pointerOf("exponential"),
+		},
+		Tasks: []*Task{
+			{Name: "inherit"},
+			{
+				Name: "override",
+				RestartPolicy: &RestartPolicy{
+					DelayFunction: pointerOf("fibonacci"),
+					MaxDelay:      pointerOf(time.Minute),
+				},
+			},
+		},
+	}
+	tg.Canonicalize(job)
+	require.Equal(t, 30*time.Minute, *tg.RestartPolicy.MaxDelay)
+	require.Equal(t, 30*time.Minute, *tg.Tasks[0].RestartPolicy.MaxDelay)
+	require.Equal(t, time.Minute, *tg.Tasks[1].RestartPolicy.MaxDelay)
+
+	// A constant delay doesn't get a max delay
+	tg = &TaskGroup{
+		Name: pointerOf("foo"),
+	}
+	tg.Canonicalize(job)
+	require.Nil(t, tg.RestartPolicy.MaxDelay)
+}
+
 func TestTaskGroup_Canonicalize_Scaling(t *testing.T) {
 	testutil.Parallel(t)
 	require := require.New(t)
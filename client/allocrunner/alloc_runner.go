@@ -293,6 +293,8 @@ func (ar *allocRunner) initTaskRunners(tasks []*structs.Task) error {
 			ShutdownDelayCtx:    ar.shutdownDelayCtx,
 			ServiceRegWrapper:   ar.serviceRegWrapper,
 			Getter:              ar.getter,
+			CheckStore:          ar.checkStore,
+			ReadinessUpdater:    ar,
 		}
 
 		if ar.cpusetManager != nil {
@@ -483,6 +485,13 @@ func (ar *allocRunner) TaskStateUpdated() {
 	}
 }
 
+// TaskReadinessUpdated is called by readiness gated task runners when the
+// checks of the task start or stop passing.
+func (ar *allocRunner) TaskReadinessUpdated(task string, ready bool) {
+	ar.taskCoordinator.SetTaskReady(task, ready)
+	ar.TaskStateUpdated()
+}
+
 // handleTaskStateUpdates must be run in goroutine as it monitors
 // taskStateUpdatedCh for task state update notifications and processes task
 // states.
@@ -75,8 +75,15 @@ func newAllocHealthWatcherHook(logger hclog.Logger, alloc *structs.Allocation, h
 	listener *cstructs.AllocListener, consul serviceregistration.Handler, checkStore checkstore.Shim) interfaces.RunnerHook {
 
 	// Neither deployments nor migrations care about the health of
-	// non-service jobs so never watch their health
-	if alloc.Job.Type != structs.JobTypeService {
+	// non-service jobs so never watch their health, unless they are system
+	// job allocations part of a deployment
+	switch alloc.Job.Type {
+	case structs.JobTypeService:
+	case structs.JobTypeSystem:
+		if alloc.DeploymentID == "" {
+			return noopAllocHealthWatcherHook{}
+		}
+	default:
 		return noopAllocHealthWatcherHook{}
 	}
 
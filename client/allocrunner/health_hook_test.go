@@ -412,6 +412,22 @@ func TestHealthHook_SystemNoop(t *testing.T) {
 	require.False(t, ok)
 }
 
+// TestHealthHook_SystemDeployment asserts that system job allocations part of
+// a deployment have their health watched.
+func TestHealthHook_SystemDeployment(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.SystemAlloc()
+	alloc.DeploymentID = uuid.Generate()
+
+	h := newAllocHealthWatcherHook(testlog.HCLogger(t), alloc, nil, nil, nil, nil)
+
+	_, ok := h.(noopAllocHealthWatcherHook)
+	require.False(t, ok)
+	_, ok = h.(interfaces.RunnerPrerunHook)
+	require.True(t, ok)
+}
+
 // TestHealthHook_BatchNoop asserts that batch jobs return the noop tracker.
 func TestHealthHook_BatchNoop(t *testing.T) {
 	ci.Parallel(t)
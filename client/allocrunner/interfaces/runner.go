@@ -27,6 +27,14 @@ type TaskStateHandler interface {
 	TaskStateUpdated()
 }
 
+// TaskReadinessHandler exposes a handler to be called when the checks of a
+// readiness gated task start or stop passing
+type TaskReadinessHandler interface {
+	// TaskReadinessUpdated is used to notify the alloc runner about the
+	// readiness of a task.
+	TaskReadinessUpdated(task string, ready bool)
+}
+
 // AllocStatsReporter gives access to the latest resource usage from the
 // allocation
 type AllocStatsReporter interface {
@@ -91,6 +91,14 @@ type Coordinator struct {
 
 	// gates store the gates that control each task lifecycle stage.
 	gates map[lifecycleStage]*Gate
+
+	// readinessGated are the prestart sidecar tasks that must report their
+	// checks as passing before main tasks are allowed to run.
+	readinessGated []string
+
+	// ready tracks which readiness gated tasks have passing checks. It must
+	// only be accessed while holding currentStateLock.
+	ready map[string]bool
 }
 
 // NewCoordinator returns a new Coordinator with all tasks initially blocked.
@@ -99,6 +107,13 @@ func NewCoordinator(logger hclog.Logger, tasks []*structs.Task, shutdownCh <-cha
 		logger:           logger.Named("task_coordinator"),
 		tasksByLifecycle: indexTasksByLifecycle(tasks),
 		gates:            make(map[lifecycleStage]*Gate),
+		ready:            make(map[string]bool),
+	}
+
+	for _, task := range tasks {
+		if task.IsReadinessGated() {
+			c.readinessGated = append(c.readinessGated, task.Name)
+		}
 	}
 
 	for lifecycle := range c.tasksByLifecycle {
@@ -115,6 +130,7 @@ func NewCoordinator(logger hclog.Logger, tasks []*structs.Task, shutdownCh <-cha
 func (c *Coordinator) Restart() {
 	c.currentStateLock.Lock()
 	defer c.currentStateLock.Unlock()
+	c.ready = make(map[string]bool)
 	c.enterStateLocked(coordinatorStateInit)
 }
 
@@ -125,9 +141,31 @@ func (c *Coordinator) Restore(states map[string]*structs.TaskState) {
 	// running, causing the Coordinator to be stuck waiting for them to be
 	// "pending".
 	c.enterStateLocked(coordinatorStatePrestart)
+
+	// Main tasks that already left the "pending" state were started after
+	// the readiness gated sidecars were ready, so don't block them again
+	// while the checks are re-evaluated.
+	for _, task := range c.tasksByLifecycle[lifecycleStageMain] {
+		if states[task] != nil && states[task].State != structs.TaskStatePending {
+			for _, gated := range c.readinessGated {
+				c.ready[gated] = true
+			}
+			break
+		}
+	}
+
 	c.TaskStateUpdated(states)
 }
 
+// SetTaskReady records whether the checks of a readiness gated task are
+// passing. Callers must call TaskStateUpdated afterwards for the change to be
+// reflected in the Coordinator state.
+func (c *Coordinator) SetTaskReady(task string, ready bool) {
+	c.currentStateLock.Lock()
+	defer c.currentStateLock.Unlock()
+	c.ready[task] = ready
+}
+
 // StartConditionForTask returns a channel that is unblocked when the task is
 // allowed to run.
 func (c *Coordinator) StartConditionForTask(task *structs.Task) <-chan struct{} {
@@ -287,6 +325,7 @@ func (c *Coordinator) isInitDone(states map[string]*structs.TaskState) bool {
 //   - all ephemeral prestart tasks are successful.
 //   - no ephemeral prestart task has failed.
 //   - all prestart sidecar tasks are running.
+//   - all readiness gated prestart sidecar tasks have passing checks.
 func (c *Coordinator) isPrestartDone(states map[string]*structs.TaskState) bool {
 	if !c.hasPrestart() {
 		return true
@@ -302,6 +341,11 @@ func (c *Coordinator) isPrestartDone(states map[string]*structs.TaskState) bool
 			return false
 		}
 	}
+	for _, task := range c.readinessGated {
+		if !c.ready[task] {
+			return false
+		}
+	}
 	return true
 }
 
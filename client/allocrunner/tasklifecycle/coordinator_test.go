@@ -356,6 +356,79 @@ func TestCoordinator_SidecarNeverStarts(t *testing.T) {
 	RequireTaskBlocked(t, coord, mainTask)
 }
 
+func TestCoordinator_SidecarReadyChecks(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+
+	alloc := mock.LifecycleAlloc()
+	tasks := alloc.Job.TaskGroups[0].Tasks
+
+	mainTask := tasks[0]
+	sideTask := tasks[1]
+	sideTask.Lifecycle.ReadyChecks = true
+
+	// Only use the tasks that we care about.
+	tasks = []*structs.Task{mainTask, sideTask}
+
+	shutdownCh := make(chan struct{})
+	defer close(shutdownCh)
+	coord := NewCoordinator(logger, tasks, shutdownCh)
+
+	states := map[string]*structs.TaskState{
+		sideTask.Name: {
+			State:  structs.TaskStatePending,
+			Failed: false,
+		},
+		mainTask.Name: {
+			State:  structs.TaskStatePending,
+			Failed: false,
+		},
+	}
+	coord.TaskStateUpdated(states)
+	RequireTaskAllowed(t, coord, sideTask)
+	RequireTaskBlocked(t, coord, mainTask)
+
+	// Sidecar is running but its checks are not passing yet, main is blocked.
+	states = map[string]*structs.TaskState{
+		sideTask.Name: {
+			State:  structs.TaskStateRunning,
+			Failed: false,
+		},
+		mainTask.Name: {
+			State:  structs.TaskStatePending,
+			Failed: false,
+		},
+	}
+	coord.TaskStateUpdated(states)
+	RequireTaskAllowed(t, coord, sideTask)
+	RequireTaskBlocked(t, coord, mainTask)
+
+	// Sidecar checks pass, main is allowed to run.
+	coord.SetTaskReady(sideTask.Name, true)
+	coord.TaskStateUpdated(states)
+	RequireTaskAllowed(t, coord, sideTask)
+	RequireTaskAllowed(t, coord, mainTask)
+
+	// Restarting the alloc requires the checks to pass again.
+	coord.Restart()
+	states = map[string]*structs.TaskState{
+		sideTask.Name: {
+			State:  structs.TaskStatePending,
+			Failed: false,
+		},
+		mainTask.Name: {
+			State:  structs.TaskStatePending,
+			Failed: false,
+		},
+	}
+	coord.TaskStateUpdated(states)
+	states[sideTask.Name] = &structs.TaskState{State: structs.TaskStateRunning}
+	coord.TaskStateUpdated(states)
+	RequireTaskAllowed(t, coord, sideTask)
+	RequireTaskBlocked(t, coord, mainTask)
+}
+
 func TestCoordinator_PoststartStartsAfterMain(t *testing.T) {
 	ci.Parallel(t)
 
@@ -446,6 +519,14 @@ func TestCoordinator_Restore(t *testing.T) {
 		Sidecar: true,
 	}
 
+	preReady := task.Copy()
+	preReady.Name = "pre_ready"
+	preReady.Lifecycle = &structs.TaskLifecycleConfig{
+		Hook:        structs.TaskLifecycleHookPrestart,
+		Sidecar:     true,
+		ReadyChecks: true,
+	}
+
 	main := task.Copy()
 	main.Name = "main"
 	main.Lifecycle = nil
@@ -507,6 +588,31 @@ func TestCoordinator_Restore(t *testing.T) {
 				RequireTaskAllowed(t, c, main)
 			},
 		},
+		{
+			name:  "prestart sidecar not ready",
+			tasks: []*structs.Task{preReady, main},
+			tasksState: map[string]*structs.TaskState{
+				preReady.Name: {State: structs.TaskStateRunning},
+				main.Name:     {State: structs.TaskStatePending},
+			},
+			testFn: func(t *testing.T, c *Coordinator) {
+				RequireTaskBlocked(t, c, main)
+
+				RequireTaskAllowed(t, c, preReady)
+			},
+		},
+		{
+			name:  "prestart sidecar ready main running",
+			tasks: []*structs.Task{preReady, main},
+			tasksState: map[string]*structs.TaskState{
+				preReady.Name: {State: structs.TaskStateRunning},
+				main.Name:     {State: structs.TaskStateRunning},
+			},
+			testFn: func(t *testing.T, c *Coordinator) {
+				RequireTaskAllowed(t, c, preReady)
+				RequireTaskAllowed(t, c, main)
+			},
+		},
 		{
 			name:  "main running",
 			tasks: []*structs.Task{main},
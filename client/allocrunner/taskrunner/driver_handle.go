@@ -62,6 +62,16 @@ func (h *DriverHandle) Signal(s string) error {
 	return h.driver.SignalTask(h.taskID, s)
 }
 
+// UpdateResources resizes the running task to the given resources. An error
+// is returned if the driver can't resize running tasks.
+func (h *DriverHandle) UpdateResources(resources *drivers.Resources) error {
+	ru, ok := h.driver.(drivers.DriverResourceUpdater)
+	if !ok {
+		return fmt.Errorf("driver does not support updating the resources of a running task")
+	}
+	return ru.UpdateTaskResources(h.taskID, resources)
+}
+
 // Exec is the handled used by client endpoint handler to invoke the appropriate task driver exec.
 func (h *DriverHandle) Exec(timeout time.Duration, cmd string, args []string) ([]byte, int, error) {
 	command := append([]string{cmd}, args...)
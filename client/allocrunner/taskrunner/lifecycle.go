@@ -103,6 +103,9 @@ func (tr *TaskRunner) restartImpl(ctx context.Context, event *structs.TaskEvent,
 	// Run the pre-kill hooks prior to restarting the task
 	tr.preKill()
 
+	// Give the task a chance to drain before it is killed
+	tr.prestop(handle)
+
 	// Grab a handle to the wait channel that will timeout with context cancelation
 	// _before_ killing the task.
 	waitCh, err := handle.WaitCh(ctx)
@@ -0,0 +1,201 @@
+package taskrunner
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	ti "github.com/hashicorp/nomad/client/allocrunner/taskrunner/interfaces"
+	"github.com/hashicorp/nomad/client/serviceregistration"
+	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	readinessHookName = "readiness"
+
+	// defaultReadinessCheckInterval is how often the checks of the task are
+	// looked up while waiting for them to pass.
+	defaultReadinessCheckInterval = 500 * time.Millisecond
+)
+
+var _ interfaces.TaskPoststartHook = &readinessHook{}
+var _ interfaces.TaskExitedHook = &readinessHook{}
+var _ interfaces.TaskStopHook = &readinessHook{}
+
+type readinessHookConfig struct {
+	alloc      *structs.Allocation
+	task       *structs.Task
+	consul     serviceregistration.Handler
+	checkStore checkstore.Shim
+	lifecycle  ti.TaskLifecycle
+	events     ti.EventEmitter
+	updater    interfaces.TaskReadinessHandler
+	logger     log.Logger
+}
+
+// readinessHook watches the service checks of a prestart sidecar task with
+// ready_checks set and reports the task as ready once all of them pass, which
+// allows the main tasks of the allocation to start. The task is killed and
+// fails if the checks are not passing within the lifecycle ready_timeout.
+type readinessHook struct {
+	alloc      *structs.Allocation
+	task       *structs.Task
+	consul     serviceregistration.Handler
+	checkStore checkstore.Shim
+	lifecycle  ti.TaskLifecycle
+	events     ti.EventEmitter
+	updater    interfaces.TaskReadinessHandler
+	logger     log.Logger
+
+	// checkInterval is how often the checks are looked up
+	checkInterval time.Duration
+
+	// cancel stops the watch started by Poststart
+	cancel context.CancelFunc
+	mu     sync.Mutex
+}
+
+func newReadinessHook(c readinessHookConfig) *readinessHook {
+	h := &readinessHook{
+		alloc:         c.alloc,
+		task:          c.task,
+		consul:        c.consul,
+		checkStore:    c.checkStore,
+		lifecycle:     c.lifecycle,
+		events:        c.events,
+		updater:       c.updater,
+		checkInterval: defaultReadinessCheckInterval,
+	}
+	h.logger = c.logger.Named(h.Name())
+	return h
+}
+
+func (*readinessHook) Name() string {
+	return readinessHookName
+}
+
+func (h *readinessHook) Poststart(_ context.Context, _ *interfaces.TaskPoststartRequest, _ *interfaces.TaskPoststartResponse) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.cancel != nil {
+		h.cancel()
+	}
+
+	// The watch outlives the Poststart call, so it uses its own context that
+	// is cancelled when the task exits.
+	ctx, cancel := context.WithCancel(context.Background())
+	h.cancel = cancel
+	go h.watch(ctx)
+	return nil
+}
+
+func (h *readinessHook) Exited(context.Context, *interfaces.TaskExitedRequest, *interfaces.TaskExitedResponse) error {
+	h.stop()
+	return nil
+}
+
+func (h *readinessHook) Stop(context.Context, *interfaces.TaskStopRequest, *interfaces.TaskStopResponse) error {
+	h.stop()
+	return nil
+}
+
+func (h *readinessHook) stop() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.cancel != nil {
+		h.cancel()
+		h.cancel = nil
+	}
+}
+
+// watch polls the checks of the task until they all pass, the ready timeout
+// is reached or ctx is cancelled.
+func (h *readinessHook) watch(ctx context.Context) {
+	var timeoutCh <-chan time.Time
+	timeout := h.task.Lifecycle.ReadyTimeout
+	if timeout > 0 {
+		timer, stop := helper.NewSafeTimer(timeout)
+		defer stop()
+		timeoutCh = timer.C
+	}
+
+	ticker, stop := helper.NewSafeTimer(h.checkInterval)
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-timeoutCh:
+			h.logger.Warn("task checks did not pass before ready_timeout", "ready_timeout", timeout)
+			event := structs.NewTaskEvent(structs.TaskKilling).
+				SetFailsTask().
+				SetDisplayMessage(fmt.Sprintf("Task checks not passing after ready_timeout of %v", timeout))
+			if err := h.lifecycle.Kill(context.Background(), event); err != nil {
+				h.logger.Error("failed to kill task", "error", err)
+			}
+			return
+
+		case <-ticker.C:
+			if h.checksPassing() {
+				h.logger.Debug("task checks passing, main tasks may start")
+				h.events.EmitEvent(structs.NewTaskEvent(structs.TaskHookMessage).
+					SetDisplayMessage("Task checks passing, main tasks may start"))
+				h.updater.TaskReadinessUpdated(h.task.Name, true)
+				return
+			}
+			ticker.Reset(h.checkInterval)
+		}
+	}
+}
+
+// checksPassing returns whether every check of the task services is
+// registered and passing.
+func (h *readinessHook) checksPassing() bool {
+	expected := 0
+	provider := structs.ServiceProviderConsul
+	for _, s := range h.task.Services {
+		expected += len(s.Checks)
+		if s.Provider != "" {
+			provider = s.Provider
+		}
+	}
+
+	passing := 0
+	switch provider {
+	case structs.ServiceProviderNomad:
+		for _, result := range h.checkStore.List(h.alloc.ID) {
+			if result.Task == h.task.Name && result.Status == structs.CheckSuccess {
+				passing++
+			}
+		}
+
+	default:
+		reg, err := h.consul.AllocRegistrations(h.alloc.ID)
+		if err != nil {
+			h.logger.Debug("failed to lookup task checks", "error", err)
+			return false
+		}
+		if reg == nil || reg.Tasks[h.task.Name] == nil {
+			return false
+		}
+		for _, sreg := range reg.Tasks[h.task.Name].Services {
+			for _, check := range sreg.Checks {
+				if check.Status == api.HealthPassing {
+					passing++
+				}
+			}
+		}
+	}
+
+	return passing >= expected
+}
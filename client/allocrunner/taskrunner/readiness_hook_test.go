@@ -0,0 +1,179 @@
+package taskrunner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/client/serviceregistration"
+	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
+	regMock "github.com/hashicorp/nomad/client/serviceregistration/mock"
+	"github.com/hashicorp/nomad/client/state"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+var _ interfaces.TaskReadinessHandler = (*mockReadinessUpdater)(nil)
+
+type mockReadinessUpdater struct {
+	readyCh chan string
+}
+
+func (m *mockReadinessUpdater) TaskReadinessUpdated(task string, ready bool) {
+	if ready {
+		m.readyCh <- task
+	}
+}
+
+type mockReadinessLifecycle struct {
+	killCh chan *structs.TaskEvent
+}
+
+func (m *mockReadinessLifecycle) Restart(context.Context, *structs.TaskEvent, bool) error {
+	return nil
+}
+
+func (m *mockReadinessLifecycle) Signal(*structs.TaskEvent, string) error {
+	return nil
+}
+
+func (m *mockReadinessLifecycle) Kill(_ context.Context, event *structs.TaskEvent) error {
+	m.killCh <- event
+	return nil
+}
+
+func (m *mockReadinessLifecycle) IsRunning() bool {
+	return true
+}
+
+// readinessTestAlloc returns an alloc with a readiness gated prestart sidecar
+// task using the given service provider.
+func readinessTestAlloc(provider string) (*structs.Allocation, *structs.Task) {
+	alloc := mock.Alloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Lifecycle = &structs.TaskLifecycleConfig{
+		Hook:         structs.TaskLifecycleHookPrestart,
+		Sidecar:      true,
+		ReadyChecks:  true,
+		ReadyTimeout: 200 * time.Millisecond,
+	}
+	task.Services = []*structs.Service{{
+		Name:     "proxy",
+		Provider: provider,
+		Checks: []*structs.ServiceCheck{{
+			Name:     "proxy-ready",
+			Type:     "http",
+			Path:     "/ready",
+			Interval: time.Second,
+			Timeout:  time.Second,
+		}},
+	}}
+	return alloc, task
+}
+
+func TestReadinessHook_NomadChecksPassing(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+	alloc, task := readinessTestAlloc(structs.ServiceProviderNomad)
+
+	checks := checkstore.NewStore(logger, state.NewMemDB(logger))
+	updater := &mockReadinessUpdater{readyCh: make(chan string, 1)}
+	lifecycle := &mockReadinessLifecycle{killCh: make(chan *structs.TaskEvent, 1)}
+	emitter := &mockEmitter{}
+
+	h := newReadinessHook(readinessHookConfig{
+		alloc:      alloc,
+		task:       task,
+		consul:     regMock.NewServiceRegistrationHandler(logger),
+		checkStore: checks,
+		lifecycle:  lifecycle,
+		events:     emitter,
+		updater:    updater,
+		logger:     logger,
+	})
+	h.checkInterval = 10 * time.Millisecond
+	h.task.Lifecycle.ReadyTimeout = 5 * time.Second
+
+	must.NoError(t, h.Poststart(context.Background(), nil, nil))
+	defer h.Stop(context.Background(), nil, nil)
+
+	// The task is not ready while the check is pending
+	must.NoError(t, checks.Set(alloc.ID, &structs.CheckQueryResult{
+		ID:     "abc123",
+		Mode:   structs.Healthiness,
+		Status: structs.CheckPending,
+		Task:   task.Name,
+	}))
+	select {
+	case <-updater.readyCh:
+		t.Fatal("task should not be ready")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	must.NoError(t, checks.Set(alloc.ID, &structs.CheckQueryResult{
+		ID:     "abc123",
+		Mode:   structs.Healthiness,
+		Status: structs.CheckSuccess,
+		Task:   task.Name,
+	}))
+	select {
+	case name := <-updater.readyCh:
+		must.Eq(t, task.Name, name)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for task to be ready")
+	}
+}
+
+func TestReadinessHook_ConsulChecksTimeout(t *testing.T) {
+	ci.Parallel(t)
+
+	logger := testlog.HCLogger(t)
+	alloc, task := readinessTestAlloc(structs.ServiceProviderConsul)
+
+	consul := regMock.NewServiceRegistrationHandler(logger)
+	consul.AllocRegistrationsFn = func(string) (*serviceregistration.AllocRegistration, error) {
+		return &serviceregistration.AllocRegistration{
+			Tasks: map[string]*serviceregistration.ServiceRegistrations{
+				task.Name: {
+					Services: map[string]*serviceregistration.ServiceRegistration{
+						"proxy": {
+							Checks: []*api.AgentCheck{{Status: api.HealthCritical}},
+						},
+					},
+				},
+			},
+		}, nil
+	}
+	updater := &mockReadinessUpdater{readyCh: make(chan string, 1)}
+	lifecycle := &mockReadinessLifecycle{killCh: make(chan *structs.TaskEvent, 1)}
+
+	h := newReadinessHook(readinessHookConfig{
+		alloc:     alloc,
+		task:      task,
+		consul:    consul,
+		lifecycle: lifecycle,
+		events:    &mockEmitter{},
+		updater:   updater,
+		logger:    logger,
+	})
+	h.checkInterval = 10 * time.Millisecond
+
+	must.NoError(t, h.Poststart(context.Background(), nil, nil))
+	defer h.Stop(context.Background(), nil, nil)
+
+	select {
+	case event := <-lifecycle.killCh:
+		must.True(t, event.FailsTask)
+		must.StrContains(t, event.DisplayMessage, "ready_timeout")
+	case <-updater.readyCh:
+		t.Fatal("task should not be ready")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for task to be killed")
+	}
+}
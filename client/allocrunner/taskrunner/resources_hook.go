@@ -0,0 +1,81 @@
+package taskrunner
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// resourcesHook resizes a running task in place when the cpu or memory
+// allocated to it is updated. If the task driver fails to apply the new
+// resources the task is restarted so that it starts with them.
+type resourcesHook struct {
+	tr     *TaskRunner
+	logger log.Logger
+	lock   sync.Mutex
+}
+
+func newResourcesHook(tr *TaskRunner, logger log.Logger) *resourcesHook {
+	h := &resourcesHook{
+		tr: tr,
+	}
+	h.logger = logger.Named(h.Name())
+	return h
+}
+
+func (*resourcesHook) Name() string {
+	return "resources"
+}
+
+func (h *resourcesHook) Update(ctx context.Context, req *interfaces.TaskUpdateRequest, _ *interfaces.TaskUpdateResponse) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if req.Alloc == nil || req.Alloc.AllocatedResources == nil {
+		return nil
+	}
+	updated, ok := req.Alloc.AllocatedResources.Tasks[h.tr.taskName]
+	if !ok {
+		return nil
+	}
+
+	current := h.tr.TaskResources()
+	if !resourcesResized(current, updated) {
+		return nil
+	}
+	h.tr.setTaskResources(updated)
+
+	// A task that isn't running yet picks up the new resources when its
+	// driver config is built.
+	handle := h.tr.getDriverHandle()
+	if handle == nil {
+		return nil
+	}
+
+	h.logger.Debug("resizing task",
+		"cpu", updated.Cpu.CpuShares,
+		"memory", updated.Memory.MemoryMB,
+		"memory_max", updated.Memory.MemoryMaxMB)
+
+	if err := handle.UpdateResources(h.tr.driverResources(updated)); err != nil {
+		h.logger.Warn("failed to resize task, restarting it", "error", err)
+		event := structs.NewTaskEvent(structs.TaskRestartSignal).
+			SetDisplayMessage("Restarting task to apply updated resources")
+		return h.tr.Restart(ctx, event, false)
+	}
+
+	return nil
+}
+
+// resourcesResized returns whether the cpu or memory resources of a task
+// differ between current and updated.
+func resourcesResized(current, updated *structs.AllocatedTaskResources) bool {
+	if current == nil || updated == nil {
+		return current != updated
+	}
+	return current.Cpu.CpuShares != updated.Cpu.CpuShares ||
+		current.Memory != updated.Memory
+}
@@ -0,0 +1,90 @@
+package taskrunner
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/client/allocrunner/interfaces"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+)
+
+// Statically assert the resources hook implements the expected interfaces
+var _ interfaces.TaskUpdateHook = (*resourcesHook)(nil)
+
+// resizedAlloc returns a copy of alloc whose task is given more cpu and
+// memory.
+func resizedAlloc(alloc *structs.Allocation, taskName string) *structs.Allocation {
+	update := alloc.Copy()
+	update.AllocModifyIndex++
+	tres := update.AllocatedResources.Tasks[taskName]
+	tres.Cpu.CpuShares += 100
+	tres.Memory.MemoryMB += 64
+	return update
+}
+
+func TestResourcesHook_Update(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "10s",
+	}
+
+	tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+	defer cleanup()
+	testWaitForTaskToStart(t, tr)
+
+	update := resizedAlloc(alloc, task.Name)
+	tr.Update(update)
+
+	expected := update.AllocatedResources.Tasks[task.Name]
+	testutil.WaitForResult(func() (bool, error) {
+		tres := tr.TaskResources()
+		if tres.Cpu.CpuShares != expected.Cpu.CpuShares || tres.Memory != expected.Memory {
+			return false, fmt.Errorf("expected resources to be updated, got %#v", tres)
+		}
+		return true, nil
+	}, func(err error) {
+		must.NoError(t, err)
+	})
+
+	// The task is resized without being restarted
+	ts := tr.TaskState()
+	must.Eq(t, structs.TaskStateRunning, ts.State)
+	must.Eq(t, 0, ts.Restarts)
+}
+
+func TestResourcesHook_Update_Restart(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for":                "10s",
+		"update_resources_error": "can't resize",
+	}
+
+	tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+	defer cleanup()
+	testWaitForTaskToStart(t, tr)
+
+	tr.Update(resizedAlloc(alloc, task.Name))
+
+	// The task is restarted when the driver fails to resize it
+	testutil.WaitForResult(func() (bool, error) {
+		ts := tr.TaskState()
+		if ts.Restarts != 1 {
+			return false, fmt.Errorf("expected task to be restarted once, got %d restarts", ts.Restarts)
+		}
+		return ts.State == structs.TaskStateRunning, fmt.Errorf("expected task to be running, got %v", ts.State)
+	}, func(err error) {
+		must.NoError(t, err)
+	})
+}
@@ -18,6 +18,7 @@ const (
 	ReasonUnrecoverableError = "Error was unrecoverable"
 	ReasonWithinPolicy       = "Restart within policy"
 	ReasonDelay              = "Exceeded allowed attempts, applying a delay"
+	ReasonExitFail           = "Exit classified as a failure by the restart policy"
 )
 
 func NewRestartTracker(policy *structs.RestartPolicy, jobType string, tlc *structs.TaskLifecycleConfig) *RestartTracker {
@@ -172,13 +173,20 @@ func (r *RestartTracker) GetState() (string, time.Duration) {
 		return structs.TaskRestarting, 0
 	}
 
+	// Hot path if the exit is classified as a failure that should not be
+	// retried
+	if r.exitAction() == structs.ExitActionFail {
+		r.reason = ReasonExitFail
+		return structs.TaskNotRestarting, 0
+	}
+
 	// Hot path if no attempts are expected
 	if r.policy.Attempts == 0 {
 		r.reason = ReasonNoRestartsAllowed
 
 		// If the task does not restart on a successful exit code and
 		// the exit code was successful: terminate.
-		if !r.onSuccess && r.exitRes != nil && r.exitRes.Successful() {
+		if !r.onSuccess && r.exitRes != nil && r.exitSuccessful() {
 			return structs.TaskTerminated, 0
 		}
 
@@ -211,7 +219,7 @@ func (r *RestartTracker) GetState() (string, time.Duration) {
 	} else if r.exitRes != nil {
 		// If the task started successfully and restart on success isn't specified,
 		// don't restart but don't mark as failed.
-		if r.exitRes.Successful() && !r.onSuccess {
+		if r.exitSuccessful() && !r.onSuccess {
 			r.reason = "Restart unnecessary as task terminated successfully"
 			return structs.TaskTerminated, 0
 		}
@@ -236,6 +244,42 @@ func (r *RestartTracker) GetState() (string, time.Duration) {
 	return structs.TaskRestarting, r.jitter()
 }
 
+// GetBackoff returns the current restart attempt within the interval and the
+// delay function used to back off restarts. The delay function is empty if
+// restarts are not progressively delayed.
+func (r *RestartTracker) GetBackoff() (int, string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	switch r.policy.DelayFunction {
+	case "exponential", "fibonacci":
+		return r.count, r.policy.DelayFunction
+	default:
+		return r.count, ""
+	}
+}
+
+// exitAction returns how the restart policy classifies the exit of the task,
+// or an empty string if the task has not exited or no rule applies.
+func (r *RestartTracker) exitAction() string {
+	if r.exitRes == nil || r.startErr != nil {
+		return ""
+	}
+	return r.policy.OnExit.Action(r.exitRes.ExitCode, r.exitRes.Signal)
+}
+
+// exitSuccessful returns whether the exit of the task is treated as
+// successful, taking the exit rules of the restart policy into account.
+func (r *RestartTracker) exitSuccessful() bool {
+	switch r.exitAction() {
+	case structs.ExitActionSuccess:
+		return true
+	case structs.ExitActionRetry:
+		return false
+	default:
+		return r.exitRes.Successful()
+	}
+}
+
 // getDelay returns the delay time to enter the next interval.
 func (r *RestartTracker) getDelay() time.Duration {
 	end := r.startTime.Add(r.policy.Interval)
@@ -243,10 +287,10 @@ func (r *RestartTracker) getDelay() time.Duration {
 	return end.Sub(now)
 }
 
-// jitter returns the delay time plus a jitter.
+// jitter returns the delay time for the current attempt plus a jitter.
 func (r *RestartTracker) jitter() time.Duration {
 	// Get the delay and ensure it is valid.
-	d := r.policy.Delay.Nanoseconds()
+	d := r.policy.BackoffDelay(r.count).Nanoseconds()
 	if d == 0 {
 		d = 1
 	}
@@ -2,6 +2,7 @@ package restarts
 
 import (
 	"fmt"
+	"syscall"
 	"testing"
 	"time"
 
@@ -121,6 +122,66 @@ func TestClient_RestartTracker_ZeroAttempts(t *testing.T) {
 	}
 }
 
+func TestClient_RestartTracker_Backoff(t *testing.T) {
+	ci.Parallel(t)
+	p := testPolicy(true, structs.RestartPolicyModeFail)
+	p.Attempts = 4
+	p.DelayFunction = "exponential"
+	p.MaxDelay = 3 * time.Second
+	rt := NewRestartTracker(p, structs.JobTypeService, nil)
+
+	for i, expected := range []time.Duration{1, 2, 3, 3} {
+		state, when := rt.SetExitResult(testExitResult(1)).GetState()
+		require.Equal(t, structs.TaskRestarting, state)
+		require.True(t, withinJitter(expected*time.Second, when),
+			"attempt %d: expected %v+jitter, got %v", i+1, expected*time.Second, when)
+
+		attempt, delayFunction := rt.GetBackoff()
+		require.Equal(t, i+1, attempt)
+		require.Equal(t, "exponential", delayFunction)
+	}
+
+	state, _ := rt.SetExitResult(testExitResult(1)).GetState()
+	require.Equal(t, structs.TaskNotRestarting, state)
+}
+
+func TestClient_RestartTracker_OnExit(t *testing.T) {
+	ci.Parallel(t)
+	p := testPolicy(true, structs.RestartPolicyModeDelay)
+	p.OnExit = structs.ExitRules{
+		{ExitCodes: []int{2}, Action: structs.ExitActionFail},
+		{ExitCodes: []int{3}, Signals: []string{"SIGTERM"}, Action: structs.ExitActionSuccess},
+		{ExitCodes: []int{0}, Action: structs.ExitActionRetry},
+	}
+
+	// Exits classified as failures are not restarted
+	rt := NewRestartTracker(p, structs.JobTypeService, nil)
+	state, _ := rt.SetExitResult(testExitResult(2)).GetState()
+	require.Equal(t, structs.TaskNotRestarting, state)
+	require.Equal(t, ReasonExitFail, rt.GetReason())
+
+	// Unclassified exits are restarted
+	rt = NewRestartTracker(p, structs.JobTypeBatch, nil)
+	state, _ = rt.SetExitResult(testExitResult(1)).GetState()
+	require.Equal(t, structs.TaskRestarting, state)
+
+	// Exits classified as successful terminate batch tasks
+	state, _ = rt.SetExitResult(testExitResult(3)).GetState()
+	require.Equal(t, structs.TaskTerminated, state)
+	state, _ = rt.SetExitResult(&drivers.ExitResult{ExitCode: 1, Signal: int(syscall.SIGTERM)}).GetState()
+	require.Equal(t, structs.TaskTerminated, state)
+
+	// Successful exits classified as retries restart batch tasks
+	state, _ = rt.SetExitResult(testExitResult(0)).GetState()
+	require.Equal(t, structs.TaskRestarting, state)
+
+	// Exits classified as successful terminate batch tasks without attempts
+	p.Attempts = 0
+	rt = NewRestartTracker(p, structs.JobTypeBatch, nil)
+	state, _ = rt.SetExitResult(testExitResult(3)).GetState()
+	require.Equal(t, structs.TaskTerminated, state)
+}
+
 func TestClient_RestartTracker_TaskKilled(t *testing.T) {
 	ci.Parallel(t)
 	p := testPolicy(true, structs.RestartPolicyModeFail)
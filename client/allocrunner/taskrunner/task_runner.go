@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/nomad/client/pluginmanager/csimanager"
 	"github.com/hashicorp/nomad/client/pluginmanager/drivermanager"
 	"github.com/hashicorp/nomad/client/serviceregistration"
+	"github.com/hashicorp/nomad/client/serviceregistration/checks/checkstore"
 	"github.com/hashicorp/nomad/client/serviceregistration/wrapper"
 	cstate "github.com/hashicorp/nomad/client/state"
 	cstructs "github.com/hashicorp/nomad/client/structs"
@@ -71,12 +72,16 @@ const (
 )
 
 type TaskRunner struct {
-	// allocID, taskName, taskLeader, and taskResources are immutable so these fields may
+	// allocID, taskName, and taskLeader are immutable so these fields may
 	// be accessed without locks
-	allocID       string
-	taskName      string
-	taskLeader    bool
-	taskResources *structs.AllocatedTaskResources
+	allocID    string
+	taskName   string
+	taskLeader bool
+
+	// taskResources are the resources allocated to the task. They change
+	// when the task is resized in place.
+	taskResources     *structs.AllocatedTaskResources
+	taskResourcesLock sync.RWMutex
 
 	alloc     *structs.Allocation
 	allocLock sync.Mutex
@@ -261,6 +266,13 @@ type TaskRunner struct {
 
 	// getter is an interface for retrieving artifacts.
 	getter cinterfaces.ArtifactGetter
+
+	// checkStore is used to lookup the status of Nomad service checks
+	checkStore checkstore.Shim
+
+	// readinessUpdater is used to report when the checks of a readiness
+	// gated task are passing
+	readinessUpdater interfaces.TaskReadinessHandler
 }
 
 type Config struct {
@@ -329,6 +341,13 @@ type Config struct {
 
 	// Getter is an interface for retrieving artifacts.
 	Getter cinterfaces.ArtifactGetter
+
+	// CheckStore is used to lookup the status of Nomad service checks
+	CheckStore checkstore.Shim
+
+	// ReadinessUpdater is used to report when the checks of a readiness
+	// gated task are passing
+	ReadinessUpdater interfaces.TaskReadinessHandler
 }
 
 func NewTaskRunner(config *Config) (*TaskRunner, error) {
@@ -388,6 +407,8 @@ func NewTaskRunner(config *Config) (*TaskRunner, error) {
 		shutdownDelayCtx:       config.ShutdownDelayCtx,
 		shutdownDelayCancelFn:  config.ShutdownDelayCancelFn,
 		serviceRegWrapper:      config.ServiceRegWrapper,
+		checkStore:             config.CheckStore,
+		readinessUpdater:       config.ReadinessUpdater,
 		getter:                 config.Getter,
 	}
 
@@ -833,7 +854,11 @@ func (tr *TaskRunner) shouldRestart() (bool, time.Duration) {
 		return false, 0
 	case structs.TaskRestarting:
 		tr.logger.Info("restarting task", "reason", reason, "delay", when)
-		tr.UpdateState(structs.TaskStatePending, structs.NewTaskEvent(structs.TaskRestarting).SetRestartDelay(when).SetRestartReason(reason))
+		event := structs.NewTaskEvent(structs.TaskRestarting).SetRestartDelay(when).SetRestartReason(reason)
+		if attempt, delayFunction := tr.restartTracker.GetBackoff(); delayFunction != "" && reason == restarts.ReasonWithinPolicy {
+			event.SetRestartBackoff(attempt, delayFunction)
+		}
+		tr.UpdateState(structs.TaskStatePending, event)
 		return true, when
 	default:
 		tr.logger.Error("restart tracker returned unknown state", "state", state)
@@ -1016,6 +1041,9 @@ func (tr *TaskRunner) handleKill(resultCh <-chan *drivers.ExitResult) *drivers.E
 		return nil
 	}
 
+	// Give the task a chance to drain before sending the kill signal
+	tr.prestop(handle)
+
 	// Kill the task using an exponential backoff in-case of failures.
 	result, killErr := tr.killTask(handle, resultCh)
 	if killErr != nil {
@@ -1053,6 +1081,46 @@ func (tr *TaskRunner) handleKill(resultCh <-chan *drivers.ExitResult) *drivers.E
 	}
 }
 
+// prestop executes the prestop command of the task, if any, inside the task
+// and records its output as a task event. Failures are only reported since
+// the task is killed regardless.
+func (tr *TaskRunner) prestop(handle *DriverHandle) {
+	prestop := tr.Task().Prestop
+	if prestop == nil {
+		return
+	}
+
+	taskEnv := tr.envBuilder.Build()
+	cmd := taskEnv.ReplaceEnv(prestop.Command)
+	args := taskEnv.ParseAndReplace(prestop.Args)
+
+	tr.logger.Debug("running prestop command", "command", cmd, "timeout", prestop.Timeout)
+	output, exitCode, err := handle.Exec(prestop.Timeout, cmd, args)
+
+	event := structs.NewTaskEvent(structs.TaskPrestopExecuted)
+	if err != nil {
+		tr.logger.Warn("failed to run prestop command", "error", err)
+		event.SetDriverError(err)
+	} else {
+		event.SetExitCode(exitCode).SetMessage(prestopOutput(output))
+	}
+	tr.EmitEvent(event)
+}
+
+// prestopOutputLimit is the maximum number of bytes of prestop command output
+// recorded in the task event.
+const prestopOutputLimit = 1024
+
+// prestopOutput returns the trailing output of a prestop command, which is
+// usually the most relevant part when a drain fails.
+func prestopOutput(output []byte) string {
+	out := strings.TrimSpace(string(output))
+	if len(out) > prestopOutputLimit {
+		out = "..." + out[len(out)-prestopOutputLimit:]
+	}
+	return out
+}
+
 // killTask kills the task handle. In the case that killing fails,
 // killTask will retry with an exponential backoff and will give up at a
 // given limit. Returns an error if the task could not be killed.
@@ -1093,6 +1161,33 @@ func (tr *TaskRunner) persistLocalState() error {
 	return tr.stateDB.PutTaskRunnerLocalState(tr.allocID, tr.taskName, tr.localState)
 }
 
+// driverResources builds the drivers.Resources of the task from its allocated
+// resources.
+func (tr *TaskRunner) driverResources(taskResources *structs.AllocatedTaskResources) *drivers.Resources {
+	ports := tr.Alloc().AllocatedResources.Shared.Ports
+
+	memoryLimit := taskResources.Memory.MemoryMB
+	if max := taskResources.Memory.MemoryMaxMB; max > memoryLimit {
+		memoryLimit = max
+	}
+
+	cpusetCpus := make([]string, len(taskResources.Cpu.ReservedCores))
+	for i, v := range taskResources.Cpu.ReservedCores {
+		cpusetCpus[i] = fmt.Sprintf("%d", v)
+	}
+
+	return &drivers.Resources{
+		NomadResources: taskResources,
+		LinuxResources: &drivers.LinuxResources{
+			MemoryLimitBytes: memoryLimit * 1024 * 1024,
+			CPUShares:        taskResources.Cpu.CpuShares,
+			CpusetCpus:       strings.Join(cpusetCpus, ","),
+			PercentTicks:     float64(taskResources.Cpu.CpuShares) / float64(tr.clientConfig.Node.NodeResources.Cpu.CpuShares),
+		},
+		Ports: &ports,
+	}
+}
+
 // buildTaskConfig builds a drivers.TaskConfig with an unique ID for the task.
 // The ID is unique for every invocation, it is built from the alloc ID, task
 // name and 8 random characters.
@@ -1100,8 +1195,6 @@ func (tr *TaskRunner) buildTaskConfig() *drivers.TaskConfig {
 	task := tr.Task()
 	alloc := tr.Alloc()
 	invocationid := uuid.Generate()[:8]
-	taskResources := tr.taskResources
-	ports := tr.Alloc().AllocatedResources.Shared.Ports
 	env := tr.envBuilder.Build()
 	tr.networkIsolationLock.Lock()
 	defer tr.networkIsolationLock.Unlock()
@@ -1119,35 +1212,16 @@ func (tr *TaskRunner) buildTaskConfig() *drivers.TaskConfig {
 		}
 	}
 
-	memoryLimit := taskResources.Memory.MemoryMB
-	if max := taskResources.Memory.MemoryMaxMB; max > memoryLimit {
-		memoryLimit = max
-	}
-
-	cpusetCpus := make([]string, len(taskResources.Cpu.ReservedCores))
-	for i, v := range taskResources.Cpu.ReservedCores {
-		cpusetCpus[i] = fmt.Sprintf("%d", v)
-	}
-
 	return &drivers.TaskConfig{
-		ID:            fmt.Sprintf("%s/%s/%s", alloc.ID, task.Name, invocationid),
-		Name:          task.Name,
-		JobName:       alloc.Job.Name,
-		JobID:         alloc.Job.ID,
-		TaskGroupName: alloc.TaskGroup,
-		Namespace:     alloc.Namespace,
-		NodeName:      alloc.NodeName,
-		NodeID:        alloc.NodeID,
-		Resources: &drivers.Resources{
-			NomadResources: taskResources,
-			LinuxResources: &drivers.LinuxResources{
-				MemoryLimitBytes: memoryLimit * 1024 * 1024,
-				CPUShares:        taskResources.Cpu.CpuShares,
-				CpusetCpus:       strings.Join(cpusetCpus, ","),
-				PercentTicks:     float64(taskResources.Cpu.CpuShares) / float64(tr.clientConfig.Node.NodeResources.Cpu.CpuShares),
-			},
-			Ports: &ports,
-		},
+		ID:               fmt.Sprintf("%s/%s/%s", alloc.ID, task.Name, invocationid),
+		Name:             task.Name,
+		JobName:          alloc.Job.Name,
+		JobID:            alloc.Job.ID,
+		TaskGroupName:    alloc.TaskGroup,
+		Namespace:        alloc.Namespace,
+		NodeName:         alloc.NodeName,
+		NodeID:           alloc.NodeID,
+		Resources:        tr.driverResources(tr.TaskResources()),
 		Devices:          tr.hookResources.getDevices(),
 		Mounts:           tr.hookResources.getMounts(),
 		Env:              env.Map(),
@@ -1455,7 +1529,7 @@ func (tr *TaskRunner) LatestResourceUsage() *cstructs.TaskResourceUsage {
 
 	// Look up device statistics lazily when fetched, as currently we do not emit any stats for them yet
 	if ru != nil && tr.deviceStatsReporter != nil {
-		deviceResources := tr.taskResources.Devices
+		deviceResources := tr.TaskResources().Devices
 		ru.ResourceUsage.DeviceStats = tr.deviceStatsReporter.LatestDeviceResourceStats(deviceResources)
 	}
 	return ru
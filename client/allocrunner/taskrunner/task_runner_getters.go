@@ -44,6 +44,21 @@ func (tr *TaskRunner) Task() *structs.Task {
 	return tr.task
 }
 
+// TaskResources returns the resources allocated to the task.
+func (tr *TaskRunner) TaskResources() *structs.AllocatedTaskResources {
+	tr.taskResourcesLock.RLock()
+	defer tr.taskResourcesLock.RUnlock()
+	return tr.taskResources
+}
+
+// setTaskResources updates the resources allocated to the task when it is
+// resized in place.
+func (tr *TaskRunner) setTaskResources(resources *structs.AllocatedTaskResources) {
+	tr.taskResourcesLock.Lock()
+	defer tr.taskResourcesLock.Unlock()
+	tr.taskResources = resources
+}
+
 func (tr *TaskRunner) TaskState() *structs.TaskState {
 	tr.stateLock.Lock()
 	defer tr.stateLock.Unlock()
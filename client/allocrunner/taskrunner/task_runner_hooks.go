@@ -67,6 +67,7 @@ func (tr *TaskRunner) initHooks() {
 		newVolumeHook(tr, hookLogger),
 		newArtifactHook(tr, tr.getter, hookLogger),
 		newStatsHook(tr, tr.clientConfig.StatsCollectionInterval, hookLogger),
+		newResourcesHook(tr, hookLogger),
 		newDeviceHook(tr.devicemanager, hookLogger),
 	}
 
@@ -166,6 +167,21 @@ func (tr *TaskRunner) initHooks() {
 		logger: hookLogger,
 	}))
 
+	// If the main tasks wait for the checks of this task to pass, add the
+	// readiness hook.
+	if task.IsReadinessGated() {
+		tr.runnerHooks = append(tr.runnerHooks, newReadinessHook(readinessHookConfig{
+			alloc:      tr.Alloc(),
+			task:       tr.Task(),
+			consul:     tr.consulServiceClient,
+			checkStore: tr.checkStore,
+			lifecycle:  tr,
+			events:     tr,
+			updater:    tr.readinessUpdater,
+			logger:     hookLogger,
+		}))
+	}
+
 	// If this task driver has remote capabilities, add the remote task
 	// hook.
 	if tr.driverCapabilities.RemoteTasks {
@@ -221,7 +237,7 @@ func (tr *TaskRunner) prestart() error {
 			Task:          tr.Task(),
 			TaskDir:       tr.taskDir,
 			TaskEnv:       tr.envBuilder.Build(),
-			TaskResources: tr.taskResources,
+			TaskResources: tr.TaskResources(),
 		}
 
 		origHookState := tr.hookState(name)
@@ -1033,6 +1033,44 @@ WAIT:
 	}
 }
 
+// TestTaskRunner_Prestop asserts the prestop command is executed inside the
+// task before it is killed and its output is recorded as a task event.
+func TestTaskRunner_Prestop(t *testing.T) {
+	ci.Parallel(t)
+
+	alloc := mock.BatchAlloc()
+	task := alloc.Job.TaskGroups[0].Tasks[0]
+	task.Driver = "mock_driver"
+	task.Config = map[string]interface{}{
+		"run_for": "1000s",
+	}
+	task.Prestop = &structs.TaskPrestop{
+		Command: "nginx",
+		Args:    []string{"-s", "${NOMAD_TASK_NAME}"},
+		Timeout: 5 * time.Second,
+	}
+
+	tr, _, cleanup := runTestTaskRunner(t, alloc, task.Name)
+	defer cleanup()
+
+	testWaitForTaskToStart(t, tr)
+
+	require.NoError(t, tr.Kill(context.Background(), structs.NewTaskEvent("test")))
+
+	state := tr.TaskState()
+	require.Equal(t, structs.TaskStateDead, state.State)
+
+	var prestop *structs.TaskEvent
+	for _, e := range state.Events {
+		if e.Type == structs.TaskPrestopExecuted {
+			prestop = e
+		}
+	}
+	require.NotNil(t, prestop, "missing prestop event: %#v", state.Events)
+	require.Equal(t, "0", prestop.Details["exit_code"])
+	require.Equal(t, fmt.Sprintf(`Exec(%q, ["nginx" "-s" %q])`, task.Name, task.Name), prestop.Message)
+}
+
 // TestTaskRunner_NoShutdownDelay asserts services are removed from
 // Consul and tasks are killed without waiting for ${shutdown_delay}
 // when the alloc has the NoShutdownDelay transition flag set.
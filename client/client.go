@@ -1466,6 +1466,9 @@ func (c *Client) setupNode() error {
 		node.NodeResources.MinDynamicPort = newConfig.MinDynamicPort
 		node.NodeResources.MaxDynamicPort = newConfig.MaxDynamicPort
 	}
+	if len(newConfig.CustomResources) != 0 {
+		node.NodeResources.Custom = newConfig.CustomResources.Copy()
+	}
 	if node.ReservedResources == nil {
 		node.ReservedResources = &structs.NodeReservedResources{}
 	}
@@ -206,7 +206,14 @@ func (i *instanceManager) Reserve(d *structs.AllocatedDeviceResource) (*device.C
 		return nil, err
 	}
 
-	// Send the reserve request
+	// Send the reserve request, passing the share of shared devices
+	if d.Share != 0 {
+		shared, ok := devicePlugin.(device.SharedDevicePlugin)
+		if !ok {
+			return nil, fmt.Errorf("device plugin doesn't support reserving shares of devices")
+		}
+		return shared.ReserveShare(d.DeviceIDs, d.Share)
+	}
 	return devicePlugin.Reserve(d.DeviceIDs)
 }
 
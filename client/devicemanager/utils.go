@@ -54,6 +54,7 @@ func convertDeviceGroup(d *device.DeviceGroup) *structs.NodeDeviceResource {
 		Name:       d.Name,
 		Instances:  convertDevices(d.Devices),
 		Attributes: psstructs.CopyMapStringAttribute(d.Attributes),
+		Capacity:   d.Capacity,
 	}
 }
 
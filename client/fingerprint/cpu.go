@@ -33,7 +33,7 @@ func NewCPUFingerprint(logger log.Logger) Fingerprint {
 
 func (f *CPUFingerprint) Fingerprint(req *FingerprintRequest, resp *FingerprintResponse) error {
 	cfg := req.Config
-	setResourcesCPU := func(totalCompute int, totalCores uint16, reservableCores []uint16) {
+	setResourcesCPU := func(totalCompute int, totalCores uint16, reservableCores []uint16, numaNodes []*structs.NodeNUMANode) {
 		// COMPAT(0.10): Remove in 0.10
 		resp.Resources = &structs.Resources{
 			CPU: totalCompute,
@@ -44,6 +44,7 @@ func (f *CPUFingerprint) Fingerprint(req *FingerprintRequest, resp *FingerprintR
 				CpuShares:          int64(totalCompute),
 				TotalCpuCores:      totalCores,
 				ReservableCpuCores: reservableCores,
+				NUMANodes:          numaNodes,
 			},
 		}
 	}
@@ -83,6 +84,19 @@ func (f *CPUFingerprint) Fingerprint(req *FingerprintRequest, resp *FingerprintR
 	}
 	resp.AddAttribute("cpu.reservablecores", strconv.Itoa(len(reservableCores)))
 
+	numaNodes, err := f.deriveNUMANodes()
+	if err != nil {
+		f.logger.Warn("failed to detect NUMA topology", "error", err)
+	} else if len(numaNodes) > 0 {
+		sockets := make(map[uint8]struct{})
+		for _, numaNode := range numaNodes {
+			sockets[numaNode.Socket] = struct{}{}
+		}
+		resp.AddAttribute("cpu.numanodes", strconv.Itoa(len(numaNodes)))
+		resp.AddAttribute("cpu.sockets", strconv.Itoa(len(sockets)))
+		f.logger.Debug("detected NUMA topology", "numa_nodes", len(numaNodes), "sockets", len(sockets))
+	}
+
 	tt := int(stats.TotalTicksAvailable())
 	if cfg.CpuCompute > 0 {
 		f.logger.Debug("using user specified cpu compute", "cpu_compute", cfg.CpuCompute)
@@ -99,7 +113,7 @@ func (f *CPUFingerprint) Fingerprint(req *FingerprintRequest, resp *FingerprintR
 	}
 
 	resp.AddAttribute("cpu.totalcompute", fmt.Sprintf("%d", tt))
-	setResourcesCPU(tt, uint16(numCores), reservableCores)
+	setResourcesCPU(tt, uint16(numCores), reservableCores, numaNodes)
 	resp.Detected = true
 
 	return nil
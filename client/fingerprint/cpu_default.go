@@ -3,6 +3,14 @@
 
 package fingerprint
 
+import (
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
 func (f *CPUFingerprint) deriveReservableCores(req *FingerprintRequest) ([]uint16, error) {
 	return nil, nil
 }
+
+func (f *CPUFingerprint) deriveNUMANodes() ([]*structs.NodeNUMANode, error) {
+	return nil, nil
+}
@@ -1,7 +1,23 @@
 package fingerprint
 
 import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/hashicorp/nomad/client/lib/cgutil"
+	"github.com/hashicorp/nomad/lib/cpuset"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+const (
+	// sysfsRoot is the root of the sysfs filesystem used to detect the NUMA
+	// topology of the node.
+	sysfsRoot = "/sys"
 )
 
 func (f *CPUFingerprint) deriveReservableCores(req *FingerprintRequest) ([]uint16, error) {
@@ -10,3 +26,93 @@ func (f *CPUFingerprint) deriveReservableCores(req *FingerprintRequest) ([]uint1
 	// We may assume the hierarchy is already setup.
 	return cgutil.GetCPUsFromCgroup(req.Config.CgroupParent)
 }
+
+func (f *CPUFingerprint) deriveNUMANodes() ([]*structs.NodeNUMANode, error) {
+	return numaNodesFromSysfs(sysfsRoot)
+}
+
+// numaNodesFromSysfs reads the NUMA topology of the node from the sysfs
+// filesystem mounted at root. Each NUMA node is reported with its local cores,
+// local memory and the physical socket its cores belong to.
+func numaNodesFromSysfs(root string) ([]*structs.NodeNUMANode, error) {
+	nodeDirs, err := filepath.Glob(filepath.Join(root, "devices", "system", "node", "node[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+
+	numaNodes := make([]*structs.NodeNUMANode, 0, len(nodeDirs))
+	for _, dir := range nodeDirs {
+		id, err := strconv.ParseUint(strings.TrimPrefix(filepath.Base(dir), "node"), 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse NUMA node ID of %q: %v", dir, err)
+		}
+
+		rawCPUs, err := os.ReadFile(filepath.Join(dir, "cpulist"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cores of NUMA node %d: %v", id, err)
+		}
+		cores, err := cpuset.Parse(strings.TrimSpace(string(rawCPUs)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse cores of NUMA node %d: %v", id, err)
+		}
+
+		// NUMA nodes without cores, such as memory-only nodes, can't be
+		// used for core placement.
+		if cores.Size() == 0 {
+			continue
+		}
+
+		memoryMB, err := numaNodeMemoryMB(filepath.Join(dir, "meminfo"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read memory of NUMA node %d: %v", id, err)
+		}
+
+		numaNode := &structs.NodeNUMANode{
+			ID:       uint8(id),
+			Cores:    cores.ToSlice(),
+			MemoryMB: memoryMB,
+		}
+
+		// All cores of a NUMA node belong to the same socket, so use the first
+		// one to detect it. Not all platforms report it, so ignore errors.
+		socketFile := filepath.Join(root, "devices", "system", "cpu",
+			fmt.Sprintf("cpu%d", numaNode.Cores[0]), "topology", "physical_package_id")
+		if raw, err := os.ReadFile(socketFile); err == nil {
+			if socket, err := strconv.ParseUint(strings.TrimSpace(string(raw)), 10, 8); err == nil {
+				numaNode.Socket = uint8(socket)
+			}
+		}
+
+		numaNodes = append(numaNodes, numaNode)
+	}
+
+	sort.Slice(numaNodes, func(i, j int) bool { return numaNodes[i].ID < numaNodes[j].ID })
+	return numaNodes, nil
+}
+
+// numaNodeMemoryMB returns the total memory of a NUMA node from its meminfo
+// file, which has lines in the format "Node 0 MemTotal: 32768 kB".
+func numaNodeMemoryMB(path string) (int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || fields[2] != "MemTotal:" {
+			continue
+		}
+		kb, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		return kb / 1024, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("MemTotal not found")
+}
@@ -0,0 +1,47 @@
+package fingerprint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestCPUFingerprint_numaNodesFromSysfs(t *testing.T) {
+	ci.Parallel(t)
+
+	root := t.TempDir()
+	writeFile := func(path, content string) {
+		path = filepath.Join(root, path)
+		must.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		must.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	writeFile("devices/system/node/node0/cpulist", "0-3\n")
+	writeFile("devices/system/node/node0/meminfo", "Node 0 MemTotal:       2097152 kB\nNode 0 MemFree:        1048576 kB\n")
+	writeFile("devices/system/node/node1/cpulist", "4-5,7\n")
+	writeFile("devices/system/node/node1/meminfo", "Node 1 MemTotal:       1048576 kB\n")
+	writeFile("devices/system/cpu/cpu4/topology/physical_package_id", "1\n")
+
+	// Memory-only NUMA nodes are ignored.
+	writeFile("devices/system/node/node2/cpulist", "\n")
+	writeFile("devices/system/node/node2/meminfo", "Node 2 MemTotal:       1048576 kB\n")
+
+	numaNodes, err := numaNodesFromSysfs(root)
+	must.NoError(t, err)
+	must.Eq(t, []*structs.NodeNUMANode{
+		{ID: 0, Socket: 0, Cores: []uint16{0, 1, 2, 3}, MemoryMB: 2048},
+		{ID: 1, Socket: 1, Cores: []uint16{4, 5, 7}, MemoryMB: 1024},
+	}, numaNodes)
+}
+
+func TestCPUFingerprint_numaNodesFromSysfs_missing(t *testing.T) {
+	ci.Parallel(t)
+
+	numaNodes, err := numaNodesFromSysfs(t.TempDir())
+	must.NoError(t, err)
+	must.Len(t, 0, numaNodes)
+}
@@ -3,9 +3,12 @@
 package cgutil
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/nomad/helper/uuid"
@@ -64,6 +67,37 @@ func CgroupScope(allocID, task string) string {
 	return fmt.Sprintf("%s.%s.scope", allocID, task)
 }
 
+// UpdateTaskResources edits the memory limits and cpu weight of the cgroup of
+// a running task, so that the task is resized without being restarted. The
+// cgroup may be given with or without the cgroup root. A memoryMax of zero
+// removes the memory limit.
+//
+// Only supported in v2, as the v1 cgroups of tasks are not tracked by Nomad.
+func UpdateTaskResources(cgroup string, memoryMax, memoryLow int64, cpuShares uint64) error {
+	if !UseV2 {
+		return errors.New("updating task resources requires cgroups v2")
+	}
+
+	ed := &editor{fromRoot: strings.TrimPrefix(cgroup, CgroupRoot)}
+
+	max := "max"
+	if memoryMax > 0 {
+		max = strconv.FormatInt(memoryMax, 10)
+	}
+	if err := ed.write("memory.max", max); err != nil {
+		return fmt.Errorf("failed to set memory limit: %w", err)
+	}
+	if err := ed.write("memory.low", strconv.FormatInt(memoryLow, 10)); err != nil {
+		return fmt.Errorf("failed to set memory reservation: %w", err)
+	}
+
+	weight := cgroups.ConvertCPUSharesToCgroupV2Value(cpuShares)
+	if err := ed.write("cpu.weight", strconv.FormatUint(weight, 10)); err != nil {
+		return fmt.Errorf("failed to set cpu weight: %w", err)
+	}
+	return nil
+}
+
 // ConfigureBasicCgroups will initialize a cgroup and modify config to contain
 // a reference to its path.
 //
@@ -127,3 +127,37 @@ func TestUtil_CopyCpuset(t *testing.T) {
 		require.Equal(t, "0-1", strings.TrimSpace(value))
 	})
 }
+
+func TestUtil_UpdateTaskResources(t *testing.T) {
+	ci.Parallel(t)
+
+	t.Run("v2", func(t *testing.T) {
+		testutil.CgroupsCompatibleV2(t)
+		name := uuid.Short() + ".scope"
+		create(t, name)
+		defer cleanup(t, name)
+
+		err := UpdateTaskResources(filepath.Join(CgroupRoot, name), 256*1024*1024, 128*1024*1024, 1024)
+		require.NoError(t, err)
+
+		ed := &editor{fromRoot: name}
+		max, readErr := ed.read("memory.max")
+		require.NoError(t, readErr)
+		require.Equal(t, "268435456", max)
+
+		low, readErr := ed.read("memory.low")
+		require.NoError(t, readErr)
+		require.Equal(t, "134217728", low)
+
+		weight, readErr := ed.read("cpu.weight")
+		require.NoError(t, readErr)
+		require.Equal(t, "39", weight)
+
+		// A memory max of zero removes the memory limit
+		err = UpdateTaskResources(filepath.Join(CgroupRoot, name), 0, 0, 1024)
+		require.NoError(t, err)
+		max, readErr = ed.read("memory.max")
+		require.NoError(t, readErr)
+		require.Equal(t, "max", max)
+	})
+}
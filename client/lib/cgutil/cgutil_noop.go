@@ -3,6 +3,8 @@
 package cgutil
 
 import (
+	"errors"
+
 	"github.com/hashicorp/go-hclog"
 )
 
@@ -40,3 +42,8 @@ func GetCPUsFromCgroup(string) ([]uint16, error) {
 func CgroupScope(allocID, task string) string {
 	return ""
 }
+
+// UpdateTaskResources is not supported on non-Linux operating systems.
+func UpdateTaskResources(string, int64, int64, uint64) error {
+	return errors.New("updating task resources is not supported on this platform")
+}
@@ -57,7 +57,10 @@ type TaskCgroupInfo struct {
 	CgroupPath         string
 	RelativeCgroupPath string
 	Cpuset             cpuset.CPUSet
-	Error              error
+	// Mems is the set of NUMA nodes the task memory is bound to. It's empty
+	// when the task uses the memory nodes of its parent cgroup.
+	Mems  cpuset.CPUSet
+	Error error
 }
 
 // SplitPath determines the parent and cgroup from p.
@@ -112,10 +112,15 @@ func (c *cpusetManagerV1) AddAlloc(alloc *structs.Allocation) {
 		if taskCpuset.Size() > 0 {
 			cgroupPath, relativeCgroupPath = c.getCgroupPathsForTask(alloc.ID, task)
 		}
+		mems, err := taskNUMAMems(alloc, task, taskCpuset)
+		if err != nil {
+			c.logger.Warn("failed to detect NUMA nodes of task cores; using parent cpuset.mems", "alloc_id", alloc.ID, "task", task, "error", err)
+		}
 		allocInfo[task] = &TaskCgroupInfo{
 			CgroupPath:         cgroupPath,
 			RelativeCgroupPath: relativeCgroupPath,
 			Cpuset:             taskCpuset,
+			Mems:               mems,
 		}
 	}
 	c.mu.Lock()
@@ -256,15 +261,20 @@ func (c *cpusetManagerV1) reconcileCpusets() {
 			continue
 		}
 
-		// copy cpuset.mems from parent
-		_, parentMems, err := getCpusetSubsystemSettingsV1(filepath.Dir(info.CgroupPath))
-		if err != nil {
-			c.logger.Error("failed to read parent cgroup settings for task", "path", info.CgroupPath, "error", err)
-			info.Error = err
-			continue
+		// bind memory to the NUMA nodes of the task cores, or copy
+		// cpuset.mems from parent
+		mems := info.Mems.String()
+		if info.Mems.Size() == 0 {
+			_, parentMems, err := getCpusetSubsystemSettingsV1(filepath.Dir(info.CgroupPath))
+			if err != nil {
+				c.logger.Error("failed to read parent cgroup settings for task", "path", info.CgroupPath, "error", err)
+				info.Error = err
+				continue
+			}
+			mems = parentMems
 		}
-		if err := cgroups.WriteFile(info.CgroupPath, "cpuset.mems", parentMems); err != nil {
-			c.logger.Error("failed to write cgroup cpuset.mems setting for task", "path", info.CgroupPath, "mems", parentMems, "error", err)
+		if err := cgroups.WriteFile(info.CgroupPath, "cpuset.mems", mems); err != nil {
+			c.logger.Error("failed to write cgroup cpuset.mems setting for task", "path", info.CgroupPath, "mems", mems, "error", err)
 			info.Error = err
 			continue
 		}
@@ -52,6 +52,7 @@ type cpusetManagerV2 struct {
 	pool      cpuset.CPUSet              // pool of cores being shared among all tasks
 	sharing   map[identity]nothing       // sharing tasks using cores only from the pool
 	isolating map[identity]cpuset.CPUSet // isolating tasks using cores from the pool + reserved cores
+	mems      map[identity]cpuset.CPUSet // NUMA nodes the memory of isolating tasks is bound to
 }
 
 func NewCpusetManagerV2(parent string, reservable []uint16, logger hclog.Logger) CpusetManager {
@@ -83,6 +84,7 @@ func NewCpusetManagerV2(parent string, reservable []uint16, logger hclog.Logger)
 		logger:    logger,
 		sharing:   make(map[identity]nothing),
 		isolating: make(map[identity]cpuset.CPUSet),
+		mems:      make(map[identity]cpuset.CPUSet),
 	}
 }
 
@@ -131,7 +133,15 @@ func (c *cpusetManagerV2) AddAlloc(alloc *structs.Allocation) {
 	for task, resources := range alloc.AllocatedResources.Tasks {
 		id := makeID(alloc.ID, task)
 		if len(resources.Cpu.ReservedCores) > 0 {
-			c.isolating[id] = cpuset.New(resources.Cpu.ReservedCores...)
+			cores := cpuset.New(resources.Cpu.ReservedCores...)
+			c.isolating[id] = cores
+			mems, err := taskNUMAMems(alloc, task, cores)
+			if err != nil {
+				c.logger.Warn("failed to detect NUMA nodes of task cores; using parent cpuset.mems", "id", id, "error", err)
+			}
+			if mems.Size() > 0 {
+				c.mems[id] = mems
+			}
 		} else {
 			c.sharing[id] = present
 		}
@@ -164,6 +174,7 @@ func (c *cpusetManagerV2) RemoveAlloc(allocID string) {
 	for id := range c.isolating {
 		if strings.HasPrefix(string(id), allocID) {
 			delete(c.isolating, id)
+			delete(c.mems, id)
 		}
 	}
 
@@ -221,11 +232,11 @@ func (c *cpusetManagerV2) recalculate() {
 // must be called while holding c.lock
 func (c *cpusetManagerV2) reconcile() {
 	for id := range c.sharing {
-		c.write(id, c.pool)
+		c.write(id, c.pool, cpuset.New())
 	}
 
 	for id, set := range c.isolating {
-		c.write(id, c.pool.Union(set))
+		c.write(id, c.pool.Union(set), c.mems[id])
 	}
 }
 
@@ -303,8 +314,9 @@ func (c *cpusetManagerV2) remove(path string) {
 	}
 }
 
-// write does the actual write of cpuset set for cgroup id
-func (c *cpusetManagerV2) write(id identity, set cpuset.CPUSet) {
+// write does the actual write of cpuset set for cgroup id, binding its memory
+// to the NUMA nodes in mems unless mems is empty
+func (c *cpusetManagerV2) write(id identity, set, mems cpuset.CPUSet) {
 	path := c.pathOf(id)
 
 	// make a manager for the cgroup
@@ -321,9 +333,13 @@ func (c *cpusetManagerV2) write(id identity, set cpuset.CPUSet) {
 	}
 
 	// set the cpuset value for the cgroup
-	if err = m.Set(&configs.Resources{
+	resources := &configs.Resources{
 		CpusetCpus: set.String(),
-	}); err != nil {
+	}
+	if mems.Size() > 0 {
+		resources.CpusetMems = mems.String()
+	}
+	if err = m.Set(resources); err != nil {
 		c.logger.Error("failed to set cgroup", "path", path, "error", err)
 		return
 	}
@@ -0,0 +1,71 @@
+//go:build linux
+
+package cgutil
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/nomad/lib/cpuset"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// sysfsNodeRoot is the sysfs directory where the kernel lists the NUMA nodes
+// of the host.
+var sysfsNodeRoot = "/sys/devices/system/node"
+
+// taskNUMAMems returns the NUMA nodes the memory of a task must be bound to.
+// Only tasks reserving cores with a NUMA affinity are bound, to the NUMA
+// nodes local to their reserved cores. An empty set means the task uses the
+// memory nodes of its parent cgroup.
+func taskNUMAMems(alloc *structs.Allocation, task string, cores cpuset.CPUSet) (cpuset.CPUSet, error) {
+	if cores.Size() == 0 || alloc.Job == nil {
+		return cpuset.New(), nil
+	}
+
+	tg := alloc.Job.LookupTaskGroup(alloc.TaskGroup)
+	if tg == nil {
+		return cpuset.New(), nil
+	}
+	t := tg.LookupTask(task)
+	if t == nil || t.Resources == nil || t.Resources.NUMA == nil ||
+		t.Resources.NUMA.Affinity == structs.NUMAAffinityNone {
+		return cpuset.New(), nil
+	}
+
+	return numaMemsFor(sysfsNodeRoot, cores)
+}
+
+// numaMemsFor returns the IDs of the NUMA nodes listed under root whose cores
+// overlap with cores. Hosts without NUMA nodes in sysfs return an empty set.
+func numaMemsFor(root string, cores cpuset.CPUSet) (cpuset.CPUSet, error) {
+	nodeDirs, err := filepath.Glob(filepath.Join(root, "node[0-9]*"))
+	if err != nil {
+		return cpuset.New(), err
+	}
+
+	mems := make([]uint16, 0, len(nodeDirs))
+	for _, dir := range nodeDirs {
+		id, err := strconv.ParseUint(strings.TrimPrefix(filepath.Base(dir), "node"), 10, 16)
+		if err != nil {
+			return cpuset.New(), fmt.Errorf("failed to parse NUMA node ID of %q: %v", dir, err)
+		}
+
+		raw, err := os.ReadFile(filepath.Join(dir, "cpulist"))
+		if err != nil {
+			return cpuset.New(), fmt.Errorf("failed to read cores of NUMA node %d: %v", id, err)
+		}
+		nodeCores, err := cpuset.Parse(strings.TrimSpace(string(raw)))
+		if err != nil {
+			return cpuset.New(), fmt.Errorf("failed to parse cores of NUMA node %d: %v", id, err)
+		}
+
+		if nodeCores.ContainsAny(cores) {
+			mems = append(mems, uint16(id))
+		}
+	}
+	return cpuset.New(mems...), nil
+}
@@ -0,0 +1,41 @@
+//go:build linux
+
+package cgutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/lib/cpuset"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNUMAMemsFor(t *testing.T) {
+	ci.Parallel(t)
+
+	root := t.TempDir()
+	for node, cpulist := range map[string]string{
+		"node0": "0-3\n",
+		"node1": "4-7\n",
+		"node2": "\n", // memory-only NUMA node
+	} {
+		dir := filepath.Join(root, node)
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "cpulist"), []byte(cpulist), 0o644))
+	}
+
+	mems, err := numaMemsFor(root, cpuset.New(1, 2))
+	require.NoError(t, err)
+	require.Equal(t, "0", mems.String())
+
+	mems, err = numaMemsFor(root, cpuset.New(3, 4))
+	require.NoError(t, err)
+	require.Equal(t, "0-1", mems.String())
+
+	// hosts without a NUMA topology in sysfs don't bind memory
+	mems, err = numaMemsFor(t.TempDir(), cpuset.New(1, 2))
+	require.NoError(t, err)
+	require.Zero(t, mems.Size())
+}
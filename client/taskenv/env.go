@@ -73,6 +73,10 @@ const (
 	// AllocIndex is the environment variable for passing the allocation index.
 	AllocIndex = "NOMAD_ALLOC_INDEX"
 
+	// ArrayIndex is the environment variable for passing the index of the
+	// instance of an array dispatched job.
+	ArrayIndex = "NOMAD_ARRAY_INDEX"
+
 	// Datacenter is the environment variable for passing the datacenter in which the alloc is running.
 	Datacenter = "NOMAD_DC"
 
@@ -412,6 +416,7 @@ type Builder struct {
 	memMaxLimit      int64
 	taskName         string
 	allocIndex       int
+	arrayIndex       int
 	datacenter       string
 	cgroupParent     string
 	namespace        string
@@ -468,9 +473,10 @@ func NewBuilder(node *structs.Node, alloc *structs.Allocation, task *structs.Tas
 // NewEmptyBuilder creates a new environment builder.
 func NewEmptyBuilder() *Builder {
 	return &Builder{
-		mu:       &sync.RWMutex{},
-		hookEnvs: map[string]map[string]string{},
-		envvars:  make(map[string]string),
+		mu:         &sync.RWMutex{},
+		hookEnvs:   map[string]map[string]string{},
+		envvars:    make(map[string]string),
+		arrayIndex: -1,
 	}
 }
 
@@ -521,6 +527,9 @@ func (b *Builder) buildEnv(allocDir, localDir, secretsDir string,
 	if b.allocIndex != -1 {
 		envMap[AllocIndex] = strconv.Itoa(b.allocIndex)
 	}
+	if b.arrayIndex != -1 {
+		envMap[ArrayIndex] = strconv.Itoa(b.arrayIndex)
+	}
 	if b.taskName != "" {
 		envMap[TaskName] = b.taskName
 	}
@@ -717,6 +726,7 @@ func (b *Builder) setAlloc(alloc *structs.Allocation) *Builder {
 	b.allocName = alloc.Name
 	b.groupName = alloc.TaskGroup
 	b.allocIndex = int(alloc.Index())
+	b.arrayIndex = alloc.ArrayIndex()
 	b.jobID = alloc.Job.ID
 	b.jobName = alloc.Job.Name
 	b.jobParentID = alloc.Job.ParentID
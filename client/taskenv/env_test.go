@@ -538,6 +538,24 @@ func TestEnvironment_Envvars(t *testing.T) {
 	}
 }
 
+func TestEnvironment_ArrayIndex(t *testing.T) {
+	ci.Parallel(t)
+
+	n := mock.Node()
+	a := mock.Alloc()
+	task := a.Job.TaskGroups[0].Tasks[0]
+
+	// Jobs not dispatched as arrays don't have an index
+	env := NewBuilder(n, a, task, "global").Build().All()
+	require.NotContains(t, env, ArrayIndex)
+
+	a.Job.ArrayCount = 3
+	a.Name = fmt.Sprintf("%s.%s[%d]", a.JobID, a.TaskGroup, 7)
+	env = NewBuilder(n, a, task, "global").Build().All()
+	require.Equal(t, "1", env[ArrayIndex])
+	require.Equal(t, "7", env[AllocIndex])
+}
+
 // TestEnvironment_HookVars asserts hook env vars are LWW and deletes of later
 // writes allow earlier hook's values to be visible.
 func TestEnvironment_HookVars(t *testing.T) {
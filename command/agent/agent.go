@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	golog "log"
 	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -34,6 +35,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/nomad/structs/config"
 	"github.com/hashicorp/raft"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -474,6 +476,15 @@ func convertServerConfig(agentConfig *Config) (*nomad.Config, error) {
 		return nil, fmt.Errorf("deploy_query_rate_limit must be greater than 0")
 	}
 
+	// Set the metrics endpoints canary analyses may query
+	for _, allowed := range agentConfig.Server.CanaryMetricsAllowlist {
+		u, err := url.Parse(allowed)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("canary_metrics_allowlist entry %q must be an absolute URL", allowed)
+		}
+	}
+	conf.CanaryMetricsAllowlist = slices.Clone(agentConfig.Server.CanaryMetricsAllowlist)
+
 	// Set plan rejection tracker configuration.
 	if planRejectConf := agentConfig.Server.PlanRejectionTracker; planRejectConf != nil {
 		if planRejectConf.Enabled != nil {
@@ -670,6 +681,7 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 	conf.Node.Name = agentConfig.NodeName
 	conf.Node.Meta = agentConfig.Client.Meta
 	conf.Node.NodeClass = agentConfig.Client.NodeClass
+	conf.Node.NodePool = agentConfig.Client.NodePool
 
 	// Set up the HTTP advertise address
 	conf.Node.HTTPAddr = agentConfig.AdvertiseAddrs.HTTP
@@ -752,6 +764,17 @@ func convertClientConfig(agentConfig *Config) (*clientconfig.Config, error) {
 		conf.HostNetworks[hn.Name] = hn
 	}
 	conf.BindWildcardDefaultHostNetwork = agentConfig.Client.BindWildcardDefaultHostNetwork
+	if agentConfig.Client.Resources != nil {
+		for _, c := range agentConfig.Client.Resources.Custom {
+			if c.Count < 0 {
+				return nil, fmt.Errorf("invalid count for custom resource %q: %d", c.Name, c.Count)
+			}
+		}
+	}
+	conf.CustomResources = agentConfig.Client.Resources.CustomResources()
+	if err := conf.CustomResources.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid custom resources: %v", err)
+	}
 
 	conf.CgroupParent = cgutil.GetCgroupParent(agentConfig.Client.CgroupParent)
 	if agentConfig.Client.ReserveableCores != "" {
@@ -444,6 +444,28 @@ func TestAgent_ServerConfig_PlanRejectionTracker(t *testing.T) {
 	}
 }
 
+func TestAgent_ServerConfig_CanaryMetricsAllowlist(t *testing.T) {
+	ci.Parallel(t)
+
+	conf := DevConfig(nil)
+	require.NoError(t, conf.normalizeAddrs())
+
+	// Metrics queries are rejected by default
+	serverConf, err := convertServerConfig(conf)
+	require.NoError(t, err)
+	require.Empty(t, serverConf.CanaryMetricsAllowlist)
+
+	conf.Server.CanaryMetricsAllowlist = []string{"https://metrics.example.com/canary"}
+	serverConf, err = convertServerConfig(conf)
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://metrics.example.com/canary"}, serverConf.CanaryMetricsAllowlist)
+
+	conf.Server.CanaryMetricsAllowlist = []string{"metrics.example.com"}
+	_, err = convertServerConfig(conf)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must be an absolute URL")
+}
+
 func TestAgent_ServerConfig_RaftMultiplier_Ok(t *testing.T) {
 	ci.Parallel(t)
 
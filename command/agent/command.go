@@ -103,6 +103,7 @@ func (c *Command) readConfig() *Config {
 	flags.StringVar(&cmdConfig.Client.StateDir, "state-dir", "", "")
 	flags.StringVar(&cmdConfig.Client.AllocDir, "alloc-dir", "", "")
 	flags.StringVar(&cmdConfig.Client.NodeClass, "node-class", "", "")
+	flags.StringVar(&cmdConfig.Client.NodePool, "node-pool", "", "")
 	flags.StringVar(&servers, "servers", "", "")
 	flags.Var((*flaghelper.StringFlag)(&meta), "meta", "")
 	flags.StringVar(&cmdConfig.Client.NetworkInterface, "network-interface", "", "")
@@ -365,6 +366,17 @@ func (c *Command) IsValidConfig(config, cmdConfig *Config) bool {
 				return false
 			}
 		}
+
+		if pool := config.Client.NodePool; pool != "" {
+			if !structs.ValidNodePoolName(pool) {
+				c.Ui.Error(fmt.Sprintf("Invalid node pool: %q", pool))
+				return false
+			}
+			if pool == structs.NodePoolAll {
+				c.Ui.Error(fmt.Sprintf("Node pool %q is reserved and cannot be used by clients", pool))
+				return false
+			}
+		}
 	}
 
 	if err := config.Server.DefaultSchedulerConfig.Validate(); err != nil {
@@ -611,6 +623,7 @@ func (c *Command) AutocompleteFlags() complete.Flags {
 		"-state-dir":                     complete.PredictDirs("*"),
 		"-alloc-dir":                     complete.PredictDirs("*"),
 		"-node-class":                    complete.PredictAnything,
+		"-node-pool":                     complete.PredictAnything,
 		"-servers":                       complete.PredictAnything,
 		"-meta":                          complete.PredictAnything,
 		"-config":                        configFilePredictor,
@@ -1383,6 +1396,10 @@ Client Options:
     Mark this node as a member of a node-class. This can be used to label
     similar node types.
 
+  -node-pool
+    Register this node in the given node pool. Jobs can only be placed on
+    nodes of the node pool they target. Defaults to the "default" node pool.
+
   -meta
     User specified metadata to associated with the node. Each instance of -meta
     parses a single KEY=VALUE pair. Repeat the meta flag for each key/value pair
@@ -201,6 +201,10 @@ type ClientConfig struct {
 	// NodeClass is used to group the node by class
 	NodeClass string `hcl:"node_class"`
 
+	// NodePool defines the node pool in which the client is registered. If
+	// not set, the client is placed in the default node pool.
+	NodePool string `hcl:"node_pool"`
+
 	// Options is used for configuration of nomad internals,
 	// like fingerprinters and drivers. The format is:
 	//
@@ -313,6 +317,9 @@ type ClientConfig struct {
 	// if the host uses multiple interfaces
 	HostNetworks []*structs.ClientHostNetworkConfig `hcl:"host_network"`
 
+	// Resources describes the operator declared resources of the host
+	Resources *ClientResourcesConfig `hcl:"resources"`
+
 	// BindWildcardDefaultHostNetwork toggles if when there are no host networks,
 	// should the port mapping rules match the default network address (false) or
 	// matching any destination address (true). Defaults to true
@@ -351,6 +358,7 @@ func (c *ClientConfig) Copy() *ClientConfig {
 	nc.ServerJoin = c.ServerJoin.Copy()
 	nc.HostVolumes = helper.CopySlice(c.HostVolumes)
 	nc.HostNetworks = helper.CopySlice(c.HostNetworks)
+	nc.Resources = c.Resources.Copy()
 	nc.NomadServiceDiscovery = pointer.Copy(c.NomadServiceDiscovery)
 	nc.Artifact = c.Artifact.Copy()
 	nc.ExtraKeysHCL = slices.Clone(c.ExtraKeysHCL)
@@ -608,6 +616,10 @@ type ServerConfig struct {
 	// DeploymentWatcher to throttle the amount of simultaneously deployments
 	DeploymentQueryRateLimit float64 `hcl:"deploy_query_rate_limit"`
 
+	// CanaryMetricsAllowlist is the list of URL prefixes the canary analysis
+	// of deployments is allowed to query metrics from.
+	CanaryMetricsAllowlist []string `hcl:"canary_metrics_allowlist"`
+
 	// RaftBoltConfig configures boltdb as used by raft.
 	RaftBoltConfig *RaftBoltConfig `hcl:"raft_boltdb"`
 }
@@ -630,6 +642,7 @@ func (s *ServerConfig) Copy() *ServerConfig {
 	ns.EventBufferSize = pointer.Copy(s.EventBufferSize)
 	ns.licenseAdditionalPublicKeys = slices.Clone(s.licenseAdditionalPublicKeys)
 	ns.ExtraKeysHCL = slices.Clone(s.ExtraKeysHCL)
+	ns.CanaryMetricsAllowlist = slices.Clone(s.CanaryMetricsAllowlist)
 	ns.Search = s.Search.Copy()
 	ns.RaftBoltConfig = s.RaftBoltConfig.Copy()
 	return &ns
@@ -1062,6 +1075,76 @@ func (r *Resources) Copy() *Resources {
 	return &nr
 }
 
+// ClientResourcesConfig is the resources configuration of a client.
+type ClientResourcesConfig struct {
+	// Custom are countable resources of the host that are not fingerprinted,
+	// such as license seats or hardware tokens.
+	Custom []*CustomResourceConfig `hcl:"custom"`
+
+	// ExtraKeysHCL is used by hcl to surface unexpected keys
+	ExtraKeysHCL []string `hcl:",unusedKeys" json:"-"`
+}
+
+// CustomResourceConfig declares the count of a custom resource.
+type CustomResourceConfig struct {
+	Name  string `hcl:",key"`
+	Count int    `hcl:"count"`
+}
+
+func (r *ClientResourcesConfig) Copy() *ClientResourcesConfig {
+	if r == nil {
+		return nil
+	}
+
+	nr := *r
+	nr.Custom = helper.CopySlice(r.Custom)
+	nr.ExtraKeysHCL = slices.Clone(r.ExtraKeysHCL)
+	return &nr
+}
+
+// Merge merges two resources configurations. Custom resources declared in
+// both are taken from b.
+func (r *ClientResourcesConfig) Merge(b *ClientResourcesConfig) *ClientResourcesConfig {
+	if r == nil {
+		return b.Copy()
+	}
+
+	result := r.Copy()
+	for _, custom := range b.Custom {
+		idx := slices.IndexFunc(result.Custom, func(c *CustomResourceConfig) bool {
+			return c.Name == custom.Name
+		})
+		if idx == -1 {
+			result.Custom = append(result.Custom, custom.Copy())
+		} else {
+			result.Custom[idx] = custom.Copy()
+		}
+	}
+	return result
+}
+
+// CustomResources returns the declared custom resources mapped by name.
+func (r *ClientResourcesConfig) CustomResources() structs.CustomResources {
+	if r == nil || len(r.Custom) == 0 {
+		return nil
+	}
+
+	custom := make(structs.CustomResources, len(r.Custom))
+	for _, c := range r.Custom {
+		custom[c.Name] = uint64(c.Count)
+	}
+	return custom
+}
+
+func (c *CustomResourceConfig) Copy() *CustomResourceConfig {
+	if c == nil {
+		return nil
+	}
+
+	nc := *c
+	return &nc
+}
+
 // devModeConfig holds the config for the -dev and -dev-connect flags
 type devModeConfig struct {
 	// mode flags are set at the command line via -dev and -dev-connect
@@ -1921,6 +2004,10 @@ func (s *ServerConfig) Merge(b *ServerConfig) *ServerConfig {
 		result.DeploymentQueryRateLimit = b.DeploymentQueryRateLimit
 	}
 
+	if len(b.CanaryMetricsAllowlist) != 0 {
+		result.CanaryMetricsAllowlist = slices.Clone(b.CanaryMetricsAllowlist)
+	}
+
 	if b.Search != nil {
 		result.Search = &Search{FuzzyEnabled: b.Search.FuzzyEnabled}
 		if b.Search.LimitQuery > 0 {
@@ -1972,6 +2059,9 @@ func (a *ClientConfig) Merge(b *ClientConfig) *ClientConfig {
 	if b.NodeClass != "" {
 		result.NodeClass = b.NodeClass
 	}
+	if b.NodePool != "" {
+		result.NodePool = b.NodePool
+	}
 	if b.NetworkInterface != "" {
 		result.NetworkInterface = b.NetworkInterface
 	}
@@ -2095,6 +2185,10 @@ func (a *ClientConfig) Merge(b *ClientConfig) *ClientConfig {
 		result.HostNetworks = append(result.HostNetworks, b.HostNetworks...)
 	}
 
+	if b.Resources != nil {
+		result.Resources = result.Resources.Merge(b.Resources)
+	}
+
 	if b.BindWildcardDefaultHostNetwork {
 		result.BindWildcardDefaultHostNetwork = true
 	}
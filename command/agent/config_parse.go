@@ -233,6 +233,14 @@ func extraKeys(c *Config) error {
 		helper.RemoveEqualFold(&c.Client.ExtraKeysHCL, "host_network")
 	}
 
+	// Remove custom resource extra keys
+	if c.Client.Resources != nil {
+		for _, cr := range c.Client.Resources.Custom {
+			helper.RemoveEqualFold(&c.Client.Resources.ExtraKeysHCL, cr.Name)
+			helper.RemoveEqualFold(&c.Client.Resources.ExtraKeysHCL, "custom")
+		}
+	}
+
 	// Remove AuditConfig extra keys
 	for _, f := range c.Audit.Filters {
 		helper.RemoveEqualFold(&c.Audit.ExtraKeysHCL, f.Name)
@@ -45,6 +45,7 @@ var basicConfig = &Config{
 		AllocDir:  "/tmp/alloc",
 		Servers:   []string{"a.b.c:80", "127.0.0.1:1234"},
 		NodeClass: "linux-medium-64bit",
+		NodePool:  "dev",
 		ServerJoin: &ServerJoin{
 			RetryJoin:        []string{"1.1.1.1", "2.2.2.2"},
 			RetryInterval:    time.Duration(15) * time.Second,
@@ -87,6 +88,11 @@ var basicConfig = &Config{
 		HostVolumes: []*structs.ClientHostVolumeConfig{
 			{Name: "tmp", Path: "/tmp"},
 		},
+		Resources: &ClientResourcesConfig{
+			Custom: []*CustomResourceConfig{
+				{Name: "license_seat", Count: 4},
+			},
+		},
 		CNIPath:             "/tmp/cni_path",
 		BridgeNetworkName:   "custom_bridge_name",
 		BridgeNetworkSubnet: "custom_bridge_subnet",
@@ -169,6 +169,7 @@ func (s *HTTPServer) jobPlan(resp http.ResponseWriter, req *http.Request,
 		Job:            sJob,
 		Diff:           args.Diff,
 		PolicyOverride: args.PolicyOverride,
+		Explain:        args.Explain,
 		WriteRequest:   *writeReq,
 	}
 
@@ -909,22 +910,27 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 	job.Canonicalize()
 
 	j := &structs.Job{
-		Stop:           *job.Stop,
-		Region:         *job.Region,
-		Namespace:      *job.Namespace,
-		ID:             *job.ID,
-		Name:           *job.Name,
-		Type:           *job.Type,
-		Priority:       *job.Priority,
-		AllAtOnce:      *job.AllAtOnce,
-		Datacenters:    job.Datacenters,
-		Payload:        job.Payload,
-		Meta:           job.Meta,
-		ConsulToken:    *job.ConsulToken,
-		VaultToken:     *job.VaultToken,
-		VaultNamespace: *job.VaultNamespace,
-		Constraints:    ApiConstraintsToStructs(job.Constraints),
-		Affinities:     ApiAffinitiesToStructs(job.Affinities),
+		Stop:            *job.Stop,
+		Region:          *job.Region,
+		Namespace:       *job.Namespace,
+		ID:              *job.ID,
+		Name:            *job.Name,
+		Type:            *job.Type,
+		Priority:        *job.Priority,
+		AllAtOnce:       *job.AllAtOnce,
+		Datacenters:     job.Datacenters,
+		Payload:         job.Payload,
+		Meta:            job.Meta,
+		ConsulToken:     *job.ConsulToken,
+		VaultToken:      *job.VaultToken,
+		VaultNamespace:  *job.VaultNamespace,
+		Constraints:     ApiConstraintsToStructs(job.Constraints),
+		Affinities:      ApiAffinitiesToStructs(job.Affinities),
+		AllocAffinities: ApiAllocAffinitiesToStructs(job.AllocAffinities),
+	}
+
+	if job.NodePool != nil {
+		j.NodePool = *job.NodePool
 	}
 
 	// Update has been pushed into the task groups. stagger and max_parallel are
@@ -948,9 +954,12 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 		}
 	}
 
+	j.Scheduling = ApiSchedulingToStructs(job.Scheduling)
+
 	if job.Periodic != nil {
 		j.Periodic = &structs.PeriodicConfig{
 			Enabled:         *job.Periodic.Enabled,
+			Specs:           slices.Clone(job.Periodic.Specs),
 			SpecType:        *job.Periodic.SpecType,
 			ProhibitOverlap: *job.Periodic.ProhibitOverlap,
 			TimeZone:        *job.Periodic.TimeZone,
@@ -959,6 +968,21 @@ func ApiJobToStructJob(job *api.Job) *structs.Job {
 		if job.Periodic.Spec != nil {
 			j.Periodic.Spec = *job.Periodic.Spec
 		}
+		if job.Periodic.CatchupWindow != nil {
+			j.Periodic.CatchupWindow = *job.Periodic.CatchupWindow
+		}
+		if job.Periodic.Jitter != nil {
+			j.Periodic.Jitter = *job.Periodic.Jitter
+		}
+		if job.Periodic.ConcurrencyPolicy != nil {
+			j.Periodic.ConcurrencyPolicy = *job.Periodic.ConcurrencyPolicy
+		}
+		if job.Periodic.SuccessfulHistoryLimit != nil {
+			j.Periodic.SuccessfulHistoryLimit = *job.Periodic.SuccessfulHistoryLimit
+		}
+		if job.Periodic.FailedHistoryLimit != nil {
+			j.Periodic.FailedHistoryLimit = *job.Periodic.FailedHistoryLimit
+		}
 	}
 
 	if job.ParameterizedJob != nil {
@@ -1004,16 +1028,13 @@ func ApiTgToStructsTG(job *structs.Job, taskGroup *api.TaskGroup, tg *structs.Ta
 	tg.Meta = taskGroup.Meta
 	tg.Constraints = ApiConstraintsToStructs(taskGroup.Constraints)
 	tg.Affinities = ApiAffinitiesToStructs(taskGroup.Affinities)
+	tg.AllocAffinities = ApiAllocAffinitiesToStructs(taskGroup.AllocAffinities)
+	tg.DependsOn = ApiGroupDependenciesToStructs(taskGroup.DependsOn)
 	tg.Networks = ApiNetworkResourceToStructs(taskGroup.Networks)
 	tg.Services = ApiServicesToStructs(taskGroup.Services, true)
 	tg.Consul = apiConsulToStructs(taskGroup.Consul)
 
-	tg.RestartPolicy = &structs.RestartPolicy{
-		Attempts: *taskGroup.RestartPolicy.Attempts,
-		Interval: *taskGroup.RestartPolicy.Interval,
-		Delay:    *taskGroup.RestartPolicy.Delay,
-		Mode:     *taskGroup.RestartPolicy.Mode,
-	}
+	tg.RestartPolicy = ApiRestartPolicyToStructs(taskGroup.RestartPolicy)
 
 	if taskGroup.ShutdownDelay != nil {
 		tg.ShutdownDelay = taskGroup.ShutdownDelay
@@ -1035,6 +1056,7 @@ func ApiTgToStructsTG(job *structs.Job, taskGroup *api.TaskGroup, tg *structs.Ta
 			DelayFunction: *taskGroup.ReschedulePolicy.DelayFunction,
 			MaxDelay:      *taskGroup.ReschedulePolicy.MaxDelay,
 			Unlimited:     *taskGroup.ReschedulePolicy.Unlimited,
+			OnExit:        ApiExitRulesToStructs(taskGroup.ReschedulePolicy.OnExit),
 		}
 	}
 
@@ -1064,6 +1086,12 @@ func ApiTgToStructsTG(job *structs.Job, taskGroup *api.TaskGroup, tg *structs.Ta
 		}
 	}
 
+	tg.Scheduling = ApiSchedulingToStructs(taskGroup.Scheduling)
+
+	if taskGroup.Gang != nil {
+		tg.Gang = *taskGroup.Gang
+	}
+
 	if len(taskGroup.Volumes) > 0 {
 		tg.Volumes = map[string]*structs.VolumeRequest{}
 		for k, v := range taskGroup.Volumes {
@@ -1105,6 +1133,22 @@ func ApiTgToStructsTG(job *structs.Job, taskGroup *api.TaskGroup, tg *structs.Ta
 			Canary:           *taskGroup.Update.Canary,
 		}
 
+		for _, stage := range taskGroup.Update.Stages {
+			tg.Update.Stages = append(tg.Update.Stages, &structs.UpdateStage{
+				Percent: stage.Percent,
+				Pause:   stage.Pause,
+			})
+		}
+
+		if a := taskGroup.Update.Analysis; a != nil {
+			tg.Update.Analysis = &structs.CanaryAnalysis{
+				MaxMemoryRatio: *a.MaxMemoryRatio,
+				MaxCPURatio:    *a.MaxCPURatio,
+				MaxRestarts:    *a.MaxRestarts,
+				MetricsURL:     *a.MetricsURL,
+			}
+		}
+
 		// boolPtr fields may be nil, others will have pointers to default values via Canonicalize
 		if taskGroup.Update.AutoRevert != nil {
 			tg.Update.AutoRevert = *taskGroup.Update.AutoRevert
@@ -1113,6 +1157,14 @@ func ApiTgToStructsTG(job *structs.Job, taskGroup *api.TaskGroup, tg *structs.Ta
 		if taskGroup.Update.AutoPromote != nil {
 			tg.Update.AutoPromote = *taskGroup.Update.AutoPromote
 		}
+
+		if taskGroup.Update.CanaryPercent != nil {
+			tg.Update.CanaryPercent = *taskGroup.Update.CanaryPercent
+		}
+
+		if taskGroup.Update.MaxSurge != nil {
+			tg.Update.MaxSurge = *taskGroup.Update.MaxSurge
+		}
 	}
 
 	if len(taskGroup.Tasks) > 0 {
@@ -1145,6 +1197,7 @@ func ApiTaskToStructsTask(job *structs.Job, group *structs.TaskGroup,
 	structsTask.Meta = apiTask.Meta
 	structsTask.KillTimeout = *apiTask.KillTimeout
 	structsTask.ShutdownDelay = apiTask.ShutdownDelay
+	structsTask.Prestop = apiTaskPrestopToStructs(apiTask.Prestop)
 	structsTask.KillSignal = apiTask.KillSignal
 	structsTask.Kind = structs.TaskKind(apiTask.Kind)
 	structsTask.Constraints = ApiConstraintsToStructs(apiTask.Constraints)
@@ -1152,12 +1205,7 @@ func ApiTaskToStructsTask(job *structs.Job, group *structs.TaskGroup,
 	structsTask.CSIPluginConfig = ApiCSIPluginConfigToStructsCSIPluginConfig(apiTask.CSIPluginConfig)
 
 	if apiTask.RestartPolicy != nil {
-		structsTask.RestartPolicy = &structs.RestartPolicy{
-			Attempts: *apiTask.RestartPolicy.Attempts,
-			Interval: *apiTask.RestartPolicy.Interval,
-			Delay:    *apiTask.RestartPolicy.Delay,
-			Mode:     *apiTask.RestartPolicy.Mode,
-		}
+		structsTask.RestartPolicy = ApiRestartPolicyToStructs(apiTask.RestartPolicy)
 	}
 
 	if len(apiTask.VolumeMounts) > 0 {
@@ -1250,8 +1298,12 @@ func ApiTaskToStructsTask(job *structs.Job, group *structs.TaskGroup,
 
 	if apiTask.Lifecycle != nil {
 		structsTask.Lifecycle = &structs.TaskLifecycleConfig{
-			Hook:    apiTask.Lifecycle.Hook,
-			Sidecar: apiTask.Lifecycle.Sidecar,
+			Hook:        apiTask.Lifecycle.Hook,
+			Sidecar:     apiTask.Lifecycle.Sidecar,
+			ReadyChecks: apiTask.Lifecycle.ReadyChecks,
+		}
+		if apiTask.Lifecycle.ReadyTimeout != nil {
+			structsTask.Lifecycle.ReadyTimeout = *apiTask.Lifecycle.ReadyTimeout
 		}
 	}
 }
@@ -1310,6 +1362,12 @@ func ApiResourcesToStructs(in *api.Resources) *structs.Resources {
 		out.Cores = *in.Cores
 	}
 
+	if in.NUMA != nil {
+		out.NUMA = &structs.NUMA{
+			Affinity: in.NUMA.Affinity,
+		}
+	}
+
 	if in.MemoryMaxMB != nil {
 		out.MemoryMaxMB = *in.MemoryMaxMB
 	}
@@ -1329,12 +1387,56 @@ func ApiResourcesToStructs(in *api.Resources) *structs.Resources {
 			out.Devices = append(out.Devices, &structs.RequestedDevice{
 				Name:        d.Name,
 				Count:       *d.Count,
+				Share:       d.Share,
 				Constraints: ApiConstraintsToStructs(d.Constraints),
 				Affinities:  ApiAffinitiesToStructs(d.Affinities),
 			})
 		}
 	}
 
+	if len(in.Custom) > 0 {
+		out.Custom = make(structs.CustomResources, len(in.Custom))
+		for _, c := range in.Custom {
+			out.Custom[c.Name] += *c.Count
+		}
+	}
+
+	return out
+}
+
+func ApiRestartPolicyToStructs(in *api.RestartPolicy) *structs.RestartPolicy {
+	out := &structs.RestartPolicy{
+		Attempts: *in.Attempts,
+		Interval: *in.Interval,
+		Delay:    *in.Delay,
+		Mode:     *in.Mode,
+		OnExit:   ApiExitRulesToStructs(in.OnExit),
+	}
+
+	if in.DelayFunction != nil {
+		out.DelayFunction = *in.DelayFunction
+	}
+
+	if in.MaxDelay != nil {
+		out.MaxDelay = *in.MaxDelay
+	}
+
+	return out
+}
+
+func ApiExitRulesToStructs(in []*api.ExitRule) structs.ExitRules {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make(structs.ExitRules, len(in))
+	for i, r := range in {
+		out[i] = &structs.ExitRule{
+			ExitCodes: slices.Clone(r.ExitCodes),
+			Signals:   slices.Clone(r.Signals),
+			Action:    r.Action,
+		}
+	}
 	return out
 }
 
@@ -1725,6 +1827,17 @@ func apiConnectSidecarTaskToStructs(in *api.SidecarTask) *structs.SidecarTask {
 	}
 }
 
+func apiTaskPrestopToStructs(in *api.TaskPrestop) *structs.TaskPrestop {
+	if in == nil {
+		return nil
+	}
+	return &structs.TaskPrestop{
+		Command: in.Command,
+		Args:    slices.Clone(in.Args),
+		Timeout: *in.Timeout,
+	}
+}
+
 func apiConsulToStructs(in *api.Consul) *structs.Consul {
 	if in == nil {
 		return nil
@@ -1798,10 +1911,53 @@ func ApiAffinityToStructs(a1 *api.Affinity) *structs.Affinity {
 	}
 }
 
+func ApiAllocAffinitiesToStructs(in []*api.AllocAffinity) []*structs.AllocAffinity {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]*structs.AllocAffinity, len(in))
+	for i, a := range in {
+		out[i] = &structs.AllocAffinity{
+			Namespace: a.Namespace,
+			JobID:     a.JobID,
+			TaskGroup: a.TaskGroup,
+			JobMeta:   maps.Clone(a.JobMeta),
+			Anti:      a.Anti,
+			Required:  a.Required,
+		}
+		if a.Weight != nil {
+			out[i].Weight = *a.Weight
+		}
+	}
+
+	return out
+}
+
+func ApiGroupDependenciesToStructs(in []*api.GroupDependency) []*structs.GroupDependency {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]*structs.GroupDependency, len(in))
+	for i, d := range in {
+		out[i] = &structs.GroupDependency{
+			Group: d.Group,
+		}
+		if d.Condition != nil {
+			out[i].Condition = *d.Condition
+		}
+	}
+
+	return out
+}
+
 func ApiSpreadToStructs(a1 *api.Spread) *structs.Spread {
 	ret := &structs.Spread{}
 	ret.Attribute = a1.Attribute
 	ret.Weight = *a1.Weight
+	ret.MaxSkew = a1.MaxSkew
+	ret.Required = a1.Required
 	if a1.SpreadTarget != nil {
 		ret.SpreadTarget = make([]*structs.SpreadTarget, len(a1.SpreadTarget))
 		for i, st := range a1.SpreadTarget {
@@ -1814,6 +1970,15 @@ func ApiSpreadToStructs(a1 *api.Spread) *structs.Spread {
 	return ret
 }
 
+func ApiSchedulingToStructs(a1 *api.Scheduling) *structs.Scheduling {
+	if a1 == nil {
+		return nil
+	}
+	return &structs.Scheduling{
+		Algorithm: structs.SchedulerAlgorithm(a1.Algorithm),
+	}
+}
+
 // validateEvalPriorityOpt ensures the supplied evaluation priority override
 // value is within acceptable bounds.
 func validateEvalPriorityOpt(priority int) HTTPCodedError {
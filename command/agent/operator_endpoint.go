@@ -267,6 +267,10 @@ func (s *HTTPServer) schedulerUpdateConfig(resp http.ResponseWriter, req *http.R
 			SysBatchSchedulerEnabled: conf.PreemptionConfig.SysBatchSchedulerEnabled,
 			BatchSchedulerEnabled:    conf.PreemptionConfig.BatchSchedulerEnabled,
 			ServiceSchedulerEnabled:  conf.PreemptionConfig.ServiceSchedulerEnabled},
+		RebalanceConfig: structs.RebalanceConfig{
+			Enabled:       conf.RebalanceConfig.Enabled,
+			MaxMigrations: conf.RebalanceConfig.MaxMigrations,
+		},
 	}
 
 	if err := args.Config.Validate(); err != nil {
@@ -292,6 +296,36 @@ func (s *HTTPServer) schedulerUpdateConfig(resp http.ResponseWriter, req *http.R
 	return reply, nil
 }
 
+// OperatorSchedulerRebalance is used to compute the allocation migrations
+// that rebalance the cluster. GET requests are dry runs, PUT and POST requests
+// apply the migrations.
+func (s *HTTPServer) OperatorSchedulerRebalance(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.SchedulerRebalanceRequest
+	switch req.Method {
+	case "GET":
+		args.DryRun = true
+	case "PUT", "POST":
+	default:
+		return nil, CodedError(405, ErrInvalidMethod)
+	}
+	s.parseWriteRequest(req, &args.WriteRequest)
+
+	if raw := req.URL.Query().Get("max_migrations"); raw != "" {
+		maxMigrations, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, CodedError(http.StatusBadRequest, fmt.Sprintf("Error parsing max_migrations value: %v", err))
+		}
+		args.MaxMigrations = maxMigrations
+	}
+
+	var reply structs.SchedulerRebalanceResponse
+	if err := s.agent.RPC("Operator.SchedulerRebalance", &args, &reply); err != nil {
+		return nil, err
+	}
+	setIndex(resp, reply.Index)
+	return reply, nil
+}
+
 func (s *HTTPServer) SnapshotRequest(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	switch req.Method {
 	case "GET":
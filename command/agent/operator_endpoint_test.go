@@ -301,6 +301,10 @@ func TestOperator_SchedulerSetConfiguration(t *testing.T) {
   "PreemptionConfig": {
     "SystemSchedulerEnabled": true,
     "ServiceSchedulerEnabled": true
+  },
+  "RebalanceConfig": {
+    "Enabled": true,
+    "MaxMigrations": 5
   }
 }`))
 		req, _ := http.NewRequest("PUT", "/v1/operator/scheduler/configuration", body)
@@ -327,6 +331,32 @@ func TestOperator_SchedulerSetConfiguration(t *testing.T) {
 		require.True(t, reply.SchedulerConfig.PreemptionConfig.ServiceSchedulerEnabled)
 		require.True(t, reply.SchedulerConfig.MemoryOversubscriptionEnabled)
 		require.True(t, reply.SchedulerConfig.PauseEvalBroker)
+		require.True(t, reply.SchedulerConfig.RebalanceConfig.Enabled)
+		require.Equal(t, 5, reply.SchedulerConfig.RebalanceConfig.MaxMigrations)
+	})
+}
+
+func TestOperator_SchedulerRebalance(t *testing.T) {
+	ci.Parallel(t)
+	httpTest(t, nil, func(s *TestAgent) {
+		req, _ := http.NewRequest("GET", "/v1/operator/scheduler/rebalance?max_migrations=3", nil)
+		resp := httptest.NewRecorder()
+		obj, err := s.Server.OperatorSchedulerRebalance(resp, req)
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.Code)
+		out, ok := obj.(structs.SchedulerRebalanceResponse)
+		require.True(t, ok)
+		require.Empty(t, out.Migrations)
+		require.NotEmpty(t, resp.Header().Get("X-Nomad-Index"))
+
+		req, _ = http.NewRequest("PUT", "/v1/operator/scheduler/rebalance?max_migrations=invalid", nil)
+		_, err = s.Server.OperatorSchedulerRebalance(httptest.NewRecorder(), req)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Error parsing max_migrations value")
+
+		req, _ = http.NewRequest("DELETE", "/v1/operator/scheduler/rebalance", nil)
+		_, err = s.Server.OperatorSchedulerRebalance(httptest.NewRecorder(), req)
+		require.EqualError(t, err, ErrInvalidMethod)
 	})
 }
 
@@ -42,6 +42,7 @@ client {
   alloc_dir  = "/tmp/alloc"
   servers    = ["a.b.c:80", "127.0.0.1:1234"]
   node_class = "linux-medium-64bit"
+  node_pool  = "dev"
 
   meta {
     foo = "bar"
@@ -96,6 +97,12 @@ client {
     path = "/tmp"
   }
 
+  resources {
+    custom "license_seat" {
+      count = 4
+    }
+  }
+
   cni_path              = "/tmp/cni_path"
   bridge_network_name   = "custom_bridge_name"
   bridge_network_subnet = "custom_bridge_subnet"
@@ -438,6 +438,9 @@ func (c *AllocStatusCommand) outputTaskStatus(state *api.TaskState) {
 		fmt.Sprintf("Finished At|%s", formatTaskTimes(state.FinishedAt)),
 		fmt.Sprintf("Total Restarts|%d", state.Restarts),
 		fmt.Sprintf("Last Restart|%s", formatTaskTimes(state.LastRestart))}
+	if backoff := formatRestartBackoff(state); backoff != "" {
+		basic = append(basic, fmt.Sprintf("Restart Backoff|%s", backoff))
+	}
 
 	c.Ui.Output("Task Events:")
 	c.Ui.Output(formatKV(basic))
@@ -460,6 +463,22 @@ func (c *AllocStatusCommand) outputTaskStatus(state *api.TaskState) {
 	c.Ui.Output(formatList(events))
 }
 
+// formatRestartBackoff returns a description of the back-off of a task that is
+// waiting to be restarted, or an empty string if the task is not backing off.
+func formatRestartBackoff(state *api.TaskState) string {
+	if len(state.Events) == 0 {
+		return ""
+	}
+	event := state.Events[len(state.Events)-1]
+	if event.Type != api.TaskRestarting || event.Details["delay_function"] == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s, attempt %s, delay %v",
+		event.Details["delay_function"],
+		event.Details["restart_attempt"],
+		time.Duration(event.StartDelay))
+}
+
 func buildDisplayMessage(event *api.TaskEvent) string {
 	// Build up the description based on the event type.
 	var desc string
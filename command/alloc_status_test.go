@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/command/agent"
 	"github.com/hashicorp/nomad/helper/uuid"
@@ -481,3 +482,25 @@ func TestAllocStatusCommand_NSD_Checks(t *testing.T) {
 	must.RegexMatch(t, regexp.MustCompile(`Service\s+Task\s+Name\s+Mode\s+Status`), out)
 	must.RegexMatch(t, regexp.MustCompile(`service1\s+\(group\)\s+check1\s+healthiness\s+(pending|failure)`), out)
 }
+
+func TestAllocStatusCommand_FormatRestartBackoff(t *testing.T) {
+	ci.Parallel(t)
+
+	state := &api.TaskState{}
+	must.Eq(t, "", formatRestartBackoff(state))
+
+	state.Events = []*api.TaskEvent{
+		{
+			Type:       api.TaskRestarting,
+			StartDelay: int64(4 * time.Second),
+			Details: map[string]string{
+				"delay_function":  "exponential",
+				"restart_attempt": "3",
+			},
+		},
+	}
+	must.Eq(t, "exponential, attempt 3, delay 4s", formatRestartBackoff(state))
+
+	state.Events = append(state.Events, &api.TaskEvent{Type: api.TaskStarted})
+	must.Eq(t, "", formatRestartBackoff(state))
+}
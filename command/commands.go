@@ -545,6 +545,31 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"node pool": func() (cli.Command, error) {
+			return &NodePoolCommand{
+				Meta: meta,
+			}, nil
+		},
+		"node pool apply": func() (cli.Command, error) {
+			return &NodePoolApplyCommand{
+				Meta: meta,
+			}, nil
+		},
+		"node pool delete": func() (cli.Command, error) {
+			return &NodePoolDeleteCommand{
+				Meta: meta,
+			}, nil
+		},
+		"node pool info": func() (cli.Command, error) {
+			return &NodePoolInfoCommand{
+				Meta: meta,
+			}, nil
+		},
+		"node pool list": func() (cli.Command, error) {
+			return &NodePoolListCommand{
+				Meta: meta,
+			}, nil
+		},
 		"node-status": func() (cli.Command, error) {
 			return &NodeStatusCommand{
 				Meta: meta,
@@ -684,6 +709,16 @@ func Commands(metaPtr *Meta, agentUi cli.Ui) map[string]cli.CommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"operator scheduler rebalance": func() (cli.Command, error) {
+			return &OperatorSchedulerRebalanceCommand{
+				Meta: meta,
+			}, nil
+		},
+		"operator scheduler simulate": func() (cli.Command, error) {
+			return &OperatorSchedulerSimulateCommand{
+				Meta: meta,
+			}, nil
+		},
 		"operator root keyring": func() (cli.Command, error) {
 			return &OperatorRootKeyringCommand{
 				Meta: meta,
@@ -26,6 +26,9 @@ Usage: nomad deployment promote [options] <deployment id>
   the job can be failed forward by submitting a new version or failed backwards by
   reverting to an older version using the "nomad job revert" command.
 
+  Task groups rolled out in stages are advanced to their next stage each time
+  they are promoted, once the allocations of the current stage are healthy.
+
   When ACLs are enabled, this command requires a token with the 'submit-job'
   and 'read-job' capabilities for the deployment's namespace.
 
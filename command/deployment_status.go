@@ -598,7 +598,7 @@ func formatMultiregionDeployment(regions map[string]*api.Deployment, uuidLength
 
 func formatDeploymentGroups(d *api.Deployment, uuidLength int) string {
 	// Detect if we need to add these columns
-	var canaries, autorevert, progressDeadline bool
+	var canaries, autorevert, progressDeadline, stages bool
 	tgNames := make([]string, 0, len(d.TaskGroups))
 	for name, state := range d.TaskGroups {
 		tgNames = append(tgNames, name)
@@ -611,6 +611,9 @@ func formatDeploymentGroups(d *api.Deployment, uuidLength int) string {
 		if state.ProgressDeadline != 0 {
 			progressDeadline = true
 		}
+		if len(state.Stages) > 0 {
+			stages = true
+		}
 	}
 
 	// Sort the task group names to get a reliable ordering
@@ -624,6 +627,9 @@ func formatDeploymentGroups(d *api.Deployment, uuidLength int) string {
 	if canaries {
 		rowString += "Promoted|"
 	}
+	if stages {
+		rowString += "Stage|"
+	}
 	rowString += "Desired|"
 	if canaries {
 		rowString += "Canaries|"
@@ -649,6 +655,13 @@ func formatDeploymentGroups(d *api.Deployment, uuidLength int) string {
 				row += fmt.Sprintf("%v|", "N/A")
 			}
 		}
+		if stages {
+			if len(state.Stages) > 0 && state.Stage < len(state.Stages) {
+				row += fmt.Sprintf("%d/%d (%d%%)|", state.Stage+1, len(state.Stages), state.Stages[state.Stage].Percent)
+			} else {
+				row += fmt.Sprintf("%v|", "N/A")
+			}
+		}
 		row += fmt.Sprintf("%d|", state.DesiredTotal)
 		if canaries {
 			row += fmt.Sprintf("%d|", state.DesiredCanaries)
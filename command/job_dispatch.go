@@ -48,6 +48,14 @@ Dispatch Options:
     once to inject multiple metadata key/value pairs. Arbitrary keys are not
     allowed. The parameterized job must allow the key to be merged.
 
+  -count <n>
+    Dispatches an array job with the given number of indexed instances. Each
+    task group runs once per instance, and each instance is passed its index
+    from 0 to (n - 1) through the NOMAD_ARRAY_INDEX environment variable. The
+    progress of the instances is reported by "nomad job status". An array job
+    can have at most 10000 instances and 10000 allocations across all of its
+    task groups.
+
   -detach
     Return immediately instead of entering monitor mode. After job dispatch,
     the evaluation ID will be printed to the screen, which can be used to
@@ -74,6 +82,7 @@ func (c *JobDispatchCommand) AutocompleteFlags() complete.Flags {
 	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
 		complete.Flags{
 			"-meta":              complete.PredictAnything,
+			"-count":             complete.PredictAnything,
 			"-detach":            complete.PredictNothing,
 			"-idempotency-token": complete.PredictAnything,
 			"-verbose":           complete.PredictNothing,
@@ -111,6 +120,7 @@ func (c *JobDispatchCommand) Run(args []string) int {
 	var idempotencyToken string
 	var meta []string
 	var idPrefixTemplate string
+	var count int
 
 	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
 	flags.Usage = func() { c.Ui.Output(c.Help()) }
@@ -119,6 +129,7 @@ func (c *JobDispatchCommand) Run(args []string) int {
 	flags.StringVar(&idempotencyToken, "idempotency-token", "", "")
 	flags.Var((*flaghelper.StringFlag)(&meta), "meta", "")
 	flags.StringVar(&idPrefixTemplate, "id-prefix-template", "", "")
+	flags.IntVar(&count, "count", 0, "")
 
 	if err := flags.Parse(args); err != nil {
 		return 1
@@ -179,7 +190,14 @@ func (c *JobDispatchCommand) Run(args []string) int {
 	w := &api.WriteOptions{
 		IdempotencyToken: idempotencyToken,
 	}
-	resp, _, err := client.Jobs().Dispatch(job, metaMap, payload, idPrefixTemplate, w)
+	opts := &api.DispatchOptions{
+		JobID:            job,
+		Meta:             metaMap,
+		Payload:          payload,
+		IdPrefixTemplate: idPrefixTemplate,
+		ArrayCount:       count,
+	}
+	resp, _, err := client.Jobs().DispatchOpts(opts, w)
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Failed to dispatch job: %s", err))
 		return 1
@@ -81,6 +81,10 @@ Plan Options:
     Determines whether the diff between the remote job and planned job is shown.
     Defaults to true.
 
+  -explain
+    Show, for every placement, why each node considered was filtered or how it
+    was scored by each scorer.
+
   -json
     Parses the job file as JSON. If the outer object has a Job field, such as
     from "nomad job inspect" or "nomad run -output", the value of the field is
@@ -133,6 +137,7 @@ func (c *JobPlanCommand) AutocompleteFlags() complete.Flags {
 	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
 		complete.Flags{
 			"-diff":            complete.PredictNothing,
+			"-explain":         complete.PredictNothing,
 			"-policy-override": complete.PredictNothing,
 			"-verbose":         complete.PredictNothing,
 			"-json":            complete.PredictNothing,
@@ -155,12 +160,13 @@ func (c *JobPlanCommand) AutocompleteArgs() complete.Predictor {
 
 func (c *JobPlanCommand) Name() string { return "job plan" }
 func (c *JobPlanCommand) Run(args []string) int {
-	var diff, policyOverride, verbose bool
+	var diff, policyOverride, verbose, explain bool
 	var vaultToken, vaultNamespace string
 
 	flagSet := c.Meta.FlagSet(c.Name(), FlagSetClient)
 	flagSet.Usage = func() { c.Ui.Output(c.Help()) }
 	flagSet.BoolVar(&diff, "diff", true, "")
+	flagSet.BoolVar(&explain, "explain", false, "")
 	flagSet.BoolVar(&policyOverride, "policy-override", false, "")
 	flagSet.BoolVar(&verbose, "verbose", false, "")
 	flagSet.BoolVar(&c.JobGetter.JSON, "json", false, "")
@@ -241,6 +247,9 @@ func (c *JobPlanCommand) Run(args []string) int {
 	if policyOverride {
 		opts.PolicyOverride = true
 	}
+	if explain {
+		opts.Explain = true
+	}
 
 	if job.IsMultiregion() {
 		return c.multiregionPlan(client, job, opts, diff, verbose)
@@ -324,9 +333,87 @@ func (c *JobPlanCommand) outputPlannedJob(job *api.Job, resp *api.JobPlanRespons
 		c.addPreemptions(resp)
 	}
 
+	// Print the placement explanations if they were requested
+	if out := formatPlacementExplanations(resp); out != "" {
+		c.Ui.Output(c.Colorize().Color("[bold]Placement explanations:[reset]"))
+		c.Ui.Output(out)
+	}
+
 	return getExitCode(resp)
 }
 
+// formatPlacementExplanations returns the explanation of every node
+// considered for each placement and placement failure of the plan.
+func formatPlacementExplanations(resp *api.JobPlanResponse) string {
+	var out []string
+	for _, e := range resp.Explanations {
+		out = append(out, fmt.Sprintf("Allocation %q placed on node %q:\n%s",
+			e.AllocName, limit(e.NodeID, shortId), formatNodeExplanations(e.Metrics)))
+	}
+
+	tgs := make([]string, 0, len(resp.FailedTGAllocs))
+	for tg, metrics := range resp.FailedTGAllocs {
+		if len(metrics.NodeExplanations) > 0 {
+			tgs = append(tgs, tg)
+		}
+	}
+	sort.Strings(tgs)
+	for _, tg := range tgs {
+		out = append(out, fmt.Sprintf("Task Group %q failed to place:\n%s",
+			tg, formatNodeExplanations(resp.FailedTGAllocs[tg])))
+	}
+	return strings.Join(out, "\n\n")
+}
+
+// formatNodeExplanations returns a table of the node explanations of the
+// metrics. Scored nodes are listed first by descending score, followed by the
+// nodes that were filtered or exhausted.
+func formatNodeExplanations(metrics *api.AllocationMetric) string {
+	explanations := make([]*api.NodeExplanation, 0, len(metrics.NodeExplanations))
+	for _, e := range metrics.NodeExplanations {
+		explanations = append(explanations, e)
+	}
+	scored := func(e *api.NodeExplanation) bool {
+		return e.Filtered == "" && e.Exhausted == ""
+	}
+	sort.Slice(explanations, func(i, j int) bool {
+		a, b := explanations[i], explanations[j]
+		if scored(a) != scored(b) {
+			return scored(a)
+		}
+		if a.NormScore != b.NormScore {
+			return a.NormScore > b.NormScore
+		}
+		return a.NodeID < b.NodeID
+	})
+
+	rows := make([]string, 0, len(explanations)+1)
+	rows = append(rows, "Node ID|Node Name|Result|Details")
+	for _, e := range explanations {
+		var result, details string
+		switch {
+		case e.Filtered != "":
+			result, details = "filtered", e.Filtered
+		case e.Exhausted != "":
+			result, details = "exhausted", e.Exhausted
+		default:
+			result = fmt.Sprintf("score %.3g", e.NormScore)
+			scorers := make([]string, 0, len(e.Scores))
+			for name := range e.Scores {
+				scorers = append(scorers, name)
+			}
+			sort.Strings(scorers)
+			for i, name := range scorers {
+				scorers[i] = fmt.Sprintf("%s=%.3g", name, e.Scores[name])
+			}
+			details = strings.Join(scorers, ", ")
+		}
+		rows = append(rows, fmt.Sprintf("%s|%s|%s|%s",
+			limit(e.NodeID, shortId), e.NodeName, result, details))
+	}
+	return formatList(rows)
+}
+
 // addPreemptions shows details about preempted allocations
 func (c *JobPlanCommand) addPreemptions(resp *api.JobPlanResponse) {
 	c.Ui.Output(c.Colorize().Color("[bold][yellow]Preemptions:\n[reset]"))
@@ -452,6 +539,10 @@ func formatDryRun(resp *api.JobPlanResponse, job *api.Job) string {
 		out += fmt.Sprintf("[green]- Rolling update, next evaluation will be in %s.\n", rolling.Wait)
 	}
 
+	if resp.Annotations != nil && len(resp.Annotations.SchedulerAlgorithms) > 0 {
+		out += formatSchedulerAlgorithms(resp.Annotations.SchedulerAlgorithms)
+	}
+
 	if next := resp.NextPeriodicLaunch; !next.IsZero() && !job.IsParameterized() {
 		loc, err := job.Periodic.GetLocation()
 		if err != nil {
@@ -467,6 +558,34 @@ func formatDryRun(resp *api.JobPlanResponse, job *api.Job) string {
 	return out
 }
 
+// formatSchedulerAlgorithms produces a string listing the effective scheduler
+// algorithm of each task group. A single line is used when every task group
+// uses the same algorithm.
+func formatSchedulerAlgorithms(algorithms map[string]api.SchedulerAlgorithm) string {
+	tgs := make([]string, 0, len(algorithms))
+	for tg := range algorithms {
+		tgs = append(tgs, tg)
+	}
+	sort.Strings(tgs)
+
+	same := true
+	for _, tg := range tgs {
+		if algorithms[tg] != algorithms[tgs[0]] {
+			same = false
+			break
+		}
+	}
+	if same {
+		return fmt.Sprintf("[green]- Scheduler algorithm: %s.\n", algorithms[tgs[0]])
+	}
+
+	out := "[green]- Scheduler algorithms:\n"
+	for _, tg := range tgs {
+		out += fmt.Sprintf("%sTask Group %q: %s\n", strings.Repeat(" ", 2), tg, algorithms[tg])
+	}
+	return out
+}
+
 // formatJobDiff produces an annotated diff of the job. If verbose mode is
 // set, added or deleted task groups and tasks are expanded.
 func formatJobDiff(job *api.JobDiff, verbose bool) string {
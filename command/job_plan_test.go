@@ -344,3 +344,104 @@ func TestPlanCommad_JSON(t *testing.T) {
 	require.Equal(t, 255, code)
 	require.Contains(t, ui.ErrorWriter.String(), "Error during plan: Put")
 }
+
+func TestPlanCommand_formatPlacementExplanations(t *testing.T) {
+	ci.Parallel(t)
+
+	resp := &api.JobPlanResponse{
+		Explanations: []*api.PlacementExplanation{
+			{
+				AllocName: "example.web[0]",
+				TaskGroup: "web",
+				NodeID:    "aaaaaaaa-1111",
+				Metrics: &api.AllocationMetric{
+					NodeExplanations: map[string]*api.NodeExplanation{
+						"aaaaaaaa-1111": {
+							NodeID:    "aaaaaaaa-1111",
+							NodeName:  "one",
+							Scores:    map[string]float64{"binpack": 0.5, "node-affinity": 1},
+							NormScore: 0.75,
+						},
+						"bbbbbbbb-2222": {
+							NodeID:   "bbbbbbbb-2222",
+							NodeName: "two",
+							Filtered: "${attr.kernel.name} = linux",
+						},
+						"cccccccc-3333": {
+							NodeID:    "cccccccc-3333",
+							NodeName:  "three",
+							Exhausted: "memory",
+						},
+					},
+				},
+			},
+		},
+		FailedTGAllocs: map[string]*api.AllocationMetric{
+			"db": {
+				NodeExplanations: map[string]*api.NodeExplanation{
+					"cccccccc-3333": {
+						NodeID:    "cccccccc-3333",
+						NodeName:  "three",
+						Exhausted: "cpu",
+					},
+				},
+			},
+		},
+	}
+
+	out := formatPlacementExplanations(resp)
+	must.StrContains(t, out, `Allocation "example.web[0]" placed on node "aaaaaaaa":`)
+	must.StrContains(t, out, "aaaaaaaa  one        score 0.75  binpack=0.5, node-affinity=1")
+	must.StrContains(t, out, "bbbbbbbb  two        filtered    ${attr.kernel.name} = linux")
+	must.StrContains(t, out, "cccccccc  three      exhausted   memory")
+	must.StrContains(t, out, `Task Group "db" failed to place:`)
+
+	// Scored nodes are listed before filtered ones
+	must.Less(t, strings.Index(out, "bbbbbbbb"), strings.Index(out, "aaaaaaaa  one"))
+
+	must.Eq(t, "", formatPlacementExplanations(&api.JobPlanResponse{}))
+}
+
+func TestPlanCommand_formatSchedulerAlgorithms(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name       string
+		algorithms map[string]api.SchedulerAlgorithm
+		expected   string
+	}{
+		{
+			name: "single algorithm",
+			algorithms: map[string]api.SchedulerAlgorithm{
+				"web": api.SchedulerAlgorithmSpread,
+				"db":  api.SchedulerAlgorithmSpread,
+			},
+			expected: "[green]- Scheduler algorithm: spread.\n",
+		},
+		{
+			name: "per group",
+			algorithms: map[string]api.SchedulerAlgorithm{
+				"web": api.SchedulerAlgorithmSpread,
+				"db":  api.SchedulerAlgorithmBinpack,
+			},
+			expected: "[green]- Scheduler algorithms:\n" +
+				"  Task Group \"db\": binpack\n" +
+				"  Task Group \"web\": spread\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			must.Eq(t, tc.expected, formatSchedulerAlgorithms(tc.algorithms))
+		})
+	}
+
+	// The algorithms are part of the dry run output
+	resp := &api.JobPlanResponse{
+		Annotations: &api.PlanAnnotations{
+			SchedulerAlgorithms: map[string]api.SchedulerAlgorithm{"web": api.SchedulerAlgorithmBinpack},
+		},
+	}
+	out := formatDryRun(resp, &api.Job{})
+	must.StrContains(t, out, "[green]- Scheduler algorithm: binpack.")
+}
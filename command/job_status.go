@@ -3,6 +3,7 @@ package command
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -353,11 +354,21 @@ func (c *JobStatusCommand) outputJobInfo(client *api.Client, job *api.Job) error
 		return err
 	}
 
+	// Output the progress of the instances of array jobs
+	if job.ArrayCount > 0 {
+		c.Ui.Output(c.Colorize().Color("\n[bold]Array Summary[reset]"))
+		c.Ui.Output(formatArraySummary(job, jobAllocs))
+	}
+
 	// Determine latest evaluation with failures whose follow up hasn't
 	// completed, this is done while formatting
 	var latestFailedPlacement *api.Evaluation
 	blockedEval := false
 
+	// Determine the latest processed evaluation to report the task groups
+	// still waiting for the groups they depend on
+	var latestComplete *api.Evaluation
+
 	// Format the evals
 	evals := make([]string, len(jobEvals)+1)
 	evals[0] = "ID|Priority|Triggered By|Status|Placement Failures"
@@ -375,6 +386,11 @@ func (c *JobStatusCommand) outputJobInfo(client *api.Client, job *api.Job) error
 			blockedEval = true
 		}
 
+		if eval.Status == "complete" &&
+			(latestComplete == nil || latestComplete.CreateIndex < eval.CreateIndex) {
+			latestComplete = eval
+		}
+
 		if len(eval.FailedTGAllocs) == 0 {
 			// Skip evals without failures
 			continue
@@ -394,6 +410,8 @@ func (c *JobStatusCommand) outputJobInfo(client *api.Client, job *api.Job) error
 		c.outputFailedPlacements(latestFailedPlacement)
 	}
 
+	c.outputDeferredPlacements(latestComplete)
+
 	c.outputReschedulingEvals(client, job, jobAllocs, c.length)
 
 	if latestDeployment != nil {
@@ -574,6 +592,82 @@ func (c *JobStatusCommand) outputJobSummary(client *api.Client, job *api.Job) er
 	return nil
 }
 
+// formatArraySummary returns the number of instances of an array job by
+// status. An instance is failed if any of its allocations failed, complete
+// once all of them completed, running if any of them is running and pending
+// otherwise. Only the latest allocation of each name is considered so that
+// rescheduled allocations replace the ones they follow.
+func formatArraySummary(job *api.Job, stubs []*api.AllocationListStub) string {
+	latest := make(map[string]*api.AllocationListStub, len(stubs))
+	for _, stub := range stubs {
+		if prev, ok := latest[stub.Name]; !ok || stub.CreateIndex > prev.CreateIndex {
+			latest[stub.Name] = stub
+		}
+	}
+
+	// Each instance runs an equal share of every task group
+	perInstance := 0
+	for _, tg := range job.TaskGroups {
+		if tg.Count != nil {
+			perInstance += *tg.Count / job.ArrayCount
+		}
+	}
+
+	type instance struct {
+		running, complete, failed int
+	}
+	instances := make([]instance, job.ArrayCount)
+	for name, stub := range latest {
+		idx, ok := allocNameIndex(name)
+		if !ok {
+			continue
+		}
+		inst := &instances[idx%job.ArrayCount]
+		switch stub.ClientStatus {
+		case api.AllocClientStatusRunning:
+			inst.running++
+		case api.AllocClientStatusComplete:
+			inst.complete++
+		case api.AllocClientStatusFailed, api.AllocClientStatusLost:
+			inst.failed++
+		}
+	}
+
+	var pending, running, complete, failed int
+	for _, inst := range instances {
+		switch {
+		case inst.failed > 0:
+			failed++
+		case inst.complete >= perInstance:
+			complete++
+		case inst.running > 0:
+			running++
+		default:
+			pending++
+		}
+	}
+
+	summary := []string{
+		"Instances|Pending|Running|Complete|Failed",
+		fmt.Sprintf("%d|%d|%d|%d|%d", job.ArrayCount, pending, running, complete, failed),
+	}
+	return formatList(summary)
+}
+
+// allocNameIndex returns the index of an allocation given its name, which is
+// of the form "<job>.<group>[<index>]".
+func allocNameIndex(name string) (int, bool) {
+	start := strings.LastIndex(name, "[")
+	if start == -1 || !strings.HasSuffix(name, "]") {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(name[start+1 : len(name)-1])
+	if err != nil || idx < 0 {
+		return 0, false
+	}
+	return idx, true
+}
+
 // outputReschedulingEvals displays eval IDs and time for any
 // delayed evaluations by task group
 func (c *JobStatusCommand) outputReschedulingEvals(client *api.Client, job *api.Job, allocListStubs []*api.AllocationListStub, uuidLength int) error {
@@ -667,6 +761,32 @@ func (c *JobStatusCommand) outputFailedPlacements(failedEval *api.Evaluation) {
 	}
 }
 
+func (c *JobStatusCommand) outputDeferredPlacements(eval *api.Evaluation) {
+	if eval == nil || len(eval.DeferredTGAllocs) == 0 {
+		return
+	}
+
+	c.Ui.Output(c.Colorize().Color("\n[bold]Deferred Placements[reset]"))
+	c.Ui.Output(formatDeferredPlacements(eval.DeferredTGAllocs))
+}
+
+// formatDeferredPlacements lists the task groups whose placements wait for
+// the groups they depend on, along with the reason they are waiting.
+func formatDeferredPlacements(deferred map[string]string) string {
+	groups := make([]string, 0, len(deferred))
+	for tg := range deferred {
+		groups = append(groups, tg)
+	}
+	sort.Strings(groups)
+
+	out := make([]string, len(groups)+1)
+	out[0] = "Task Group|Reason"
+	for i, tg := range groups {
+		out[i+1] = fmt.Sprintf("%s|%s", tg, deferred[tg])
+	}
+	return formatList(out)
+}
+
 // list general information about a list of jobs
 func createStatusListOutput(jobs []*api.JobListStub, displayNS bool) string {
 	out := make([]string, len(jobs)+1)
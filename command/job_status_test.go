@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	"github.com/hashicorp/nomad/testutil"
@@ -392,6 +393,77 @@ func TestJobStatusCommand_RescheduleEvals(t *testing.T) {
 	require.Contains(out, e.ID[:8])
 }
 
+func TestJobStatusCommand_DeferredPlacements(t *testing.T) {
+	ci.Parallel(t)
+	srv, _, url := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	ui := cli.NewMockUi()
+	cmd := &JobStatusCommand{Meta: Meta{Ui: ui, flagAddress: url}}
+
+	require := require.New(t)
+	state := srv.Agent.Server().State()
+
+	// Create a job whose latest evaluation deferred the placements of a
+	// group waiting for another one
+	j := mock.Job()
+	require.Nil(state.UpsertJob(structs.MsgTypeTestSetup, 900, j))
+
+	e := mock.Eval()
+	e.JobID = j.ID
+	e.Status = structs.EvalStatusComplete
+	e.DeferredTGAllocs = map[string]string{
+		"api": `waiting for task group "migrate" to be complete (0/1)`,
+	}
+	require.Nil(state.UpsertEvals(structs.MsgTypeTestSetup, 902, []*structs.Evaluation{e}))
+
+	if code := cmd.Run([]string{"-address=" + url, j.ID}); code != 0 {
+		t.Fatalf("expected exit 0, got: %d", code)
+	}
+	out := ui.OutputWriter.String()
+	require.Contains(out, "Deferred Placements")
+	require.Contains(out, `waiting for task group "migrate" to be complete (0/1)`)
+}
+
+func TestJobStatusCommand_ArraySummary(t *testing.T) {
+	ci.Parallel(t)
+
+	job := &api.Job{
+		ArrayCount: 4,
+		TaskGroups: []*api.TaskGroup{
+			{Name: pointer.Of("web"), Count: pointer.Of(8)},
+		},
+	}
+
+	stub := func(idx int, status string, createIndex uint64) *api.AllocationListStub {
+		return &api.AllocationListStub{
+			Name:         fmt.Sprintf("example.web[%d]", idx),
+			ClientStatus: status,
+			CreateIndex:  createIndex,
+		}
+	}
+	stubs := []*api.AllocationListStub{
+		// Instance 0 is complete
+		stub(0, api.AllocClientStatusComplete, 10),
+		stub(4, api.AllocClientStatusComplete, 10),
+
+		// Instance 1 is running with one allocation still pending
+		stub(1, api.AllocClientStatusRunning, 10),
+		stub(5, api.AllocClientStatusPending, 10),
+
+		// Instance 2 failed
+		stub(2, api.AllocClientStatusComplete, 10),
+		stub(6, api.AllocClientStatusFailed, 10),
+
+		// Instance 3 is pending as its failed allocation was rescheduled
+		stub(3, api.AllocClientStatusFailed, 10),
+		stub(3, api.AllocClientStatusPending, 11),
+	}
+
+	out := formatArraySummary(job, stubs)
+	require.Regexp(t, `4\s+1\s+1\s+1\s+1`, out)
+}
+
 func waitForSuccess(ui cli.Ui, client *api.Client, length int, t *testing.T, evalId string) int {
 	mon := newMonitor(ui, client, length)
 	monErr := mon.monitor(evalId)
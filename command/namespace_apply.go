@@ -220,6 +220,8 @@ func parseNamespaceSpecImpl(result *api.Namespace, list *ast.ObjectList) error {
 	}
 
 	delete(m, "capabilities")
+	delete(m, "node_pool_config")
+	delete(m, "scheduling")
 	delete(m, "meta")
 
 	// Decode the rest
@@ -243,6 +245,38 @@ func parseNamespaceSpecImpl(result *api.Namespace, list *ast.ObjectList) error {
 		}
 	}
 
+	npObj := list.Filter("node_pool_config")
+	if len(npObj.Items) > 0 {
+		for _, o := range npObj.Elem().Items {
+			ot, ok := o.Val.(*ast.ObjectType)
+			if !ok {
+				break
+			}
+			var npConfig *api.NamespaceNodePoolConfiguration
+			if err := hcl.DecodeObject(&npConfig, ot.List); err != nil {
+				return err
+			}
+			result.NodePoolConfiguration = npConfig
+			break
+		}
+	}
+
+	sObj := list.Filter("scheduling")
+	if len(sObj.Items) > 0 {
+		for _, o := range sObj.Elem().Items {
+			ot, ok := o.Val.(*ast.ObjectType)
+			if !ok {
+				break
+			}
+			var scheduling *api.Scheduling
+			if err := hcl.DecodeObject(&scheduling, ot.List); err != nil {
+				return err
+			}
+			result.Scheduling = scheduling
+			break
+		}
+	}
+
 	if metaO := list.Filter("meta"); len(metaO.Items) > 0 {
 		for _, o := range metaO.Elem().Items {
 			var m map[string]interface{}
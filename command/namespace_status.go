@@ -132,12 +132,34 @@ func formatNamespaceBasics(ns *api.Namespace) string {
 			disabled_drivers = strings.Join(ns.Capabilities.DisabledTaskDrivers, ",")
 		}
 	}
+	node_pool := api.NodePoolDefault
+	allowed_pools := "*"
+	if ns.NodePoolConfiguration != nil {
+		if ns.NodePoolConfiguration.Default != "" {
+			node_pool = ns.NodePoolConfiguration.Default
+		}
+		if len(ns.NodePoolConfiguration.Allowed) != 0 {
+			allowed_pools = strings.Join(ns.NodePoolConfiguration.Allowed, ",")
+		}
+	}
+	algorithm := "<cluster default>"
+	if ns.Scheduling != nil && ns.Scheduling.Algorithm != "" {
+		algorithm = ns.Scheduling.Algorithm
+	}
+	evalWeight := 1
+	if ns.EvalWeight > 0 {
+		evalWeight = ns.EvalWeight
+	}
 	basic := []string{
 		fmt.Sprintf("Name|%s", ns.Name),
 		fmt.Sprintf("Description|%s", ns.Description),
 		fmt.Sprintf("Quota|%s", ns.Quota),
 		fmt.Sprintf("EnabledDrivers|%s", enabled_drivers),
 		fmt.Sprintf("DisabledDrivers|%s", disabled_drivers),
+		fmt.Sprintf("NodePool|%s", node_pool),
+		fmt.Sprintf("AllowedNodePools|%s", allowed_pools),
+		fmt.Sprintf("SchedulerAlgorithm|%s", algorithm),
+		fmt.Sprintf("EvalWeight|%d", evalWeight),
 	}
 
 	return formatKV(basic)
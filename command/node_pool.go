@@ -0,0 +1,79 @@
+package command
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+type NodePoolCommand struct {
+	Meta
+}
+
+func (c *NodePoolCommand) Help() string {
+	helpText := `
+Usage: nomad node pool <subcommand> [options] [args]
+
+  This command groups subcommands for interacting with node pools. Node pools
+  are used to partition the clients in a cluster so that jobs can be
+  restricted to a subset of them. Reading node pools requires node read
+  access and writing them requires a management token. Access to node pools
+  is not scoped by ACL policies; use the node pool configuration of
+  namespaces to restrict the pools their jobs may use.
+
+  Create or update a node pool from a specification file:
+
+      $ nomad node pool apply <path>
+
+  List all node pools:
+
+      $ nomad node pool list
+
+  Examine a node pool:
+
+      $ nomad node pool info <name>
+
+  Delete a node pool:
+
+      $ nomad node pool delete <name>
+
+  Please see the individual subcommand help for detailed usage information.
+`
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *NodePoolCommand) Synopsis() string {
+	return "Interact with node pools"
+}
+
+func (c *NodePoolCommand) Name() string { return "node pool" }
+
+func (c *NodePoolCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+// NodePoolPredictor returns a node pool predictor that can optionally filter
+// specific node pools.
+func NodePoolPredictor(factory ApiClientFactory, filter map[string]struct{}) complete.Predictor {
+	return complete.PredictFunc(func(a complete.Args) []string {
+		client, err := factory()
+		if err != nil {
+			return nil
+		}
+
+		pools, _, err := client.NodePools().PrefixList(a.Last, nil)
+		if err != nil {
+			return []string{}
+		}
+
+		names := make([]string, 0, len(pools))
+		for _, pool := range pools {
+			if _, ok := filter[pool.Name]; !ok {
+				names = append(names, pool.Name)
+			}
+		}
+		return names
+	})
+}
@@ -0,0 +1,203 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
+	"github.com/hashicorp/nomad/api"
+	"github.com/mitchellh/mapstructure"
+	"github.com/posener/complete"
+)
+
+type NodePoolApplyCommand struct {
+	Meta
+}
+
+func (c *NodePoolApplyCommand) Help() string {
+	helpText := `
+Usage: nomad node pool apply [options] <input>
+
+  Apply is used to create or update a node pool. The specification file will
+  be read from stdin by specifying "-", otherwise a path to the file is
+  expected.
+
+  If ACLs are enabled, this command requires a management ACL token.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+Apply Options:
+
+  -json
+    Parse the input as a JSON node pool specification.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *NodePoolApplyCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+		})
+}
+
+func (c *NodePoolApplyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictOr(
+		complete.PredictFiles("*.hcl"),
+		complete.PredictFiles("*.json"),
+	)
+}
+
+func (c *NodePoolApplyCommand) Synopsis() string {
+	return "Create or update a node pool"
+}
+
+func (c *NodePoolApplyCommand) Name() string { return "node pool apply" }
+
+func (c *NodePoolApplyCommand) Run(args []string) int {
+	var jsonInput bool
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&jsonInput, "json", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we get exactly one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <input>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	// Read the file contents
+	file := args[0]
+	var rawPool []byte
+	var err error
+	if file == "-" {
+		rawPool, err = ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to read stdin: %v", err))
+			return 1
+		}
+	} else {
+		rawPool, err = ioutil.ReadFile(file)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to read file: %v", err))
+			return 1
+		}
+	}
+
+	var pool *api.NodePool
+	if jsonInput {
+		var jsonSpec api.NodePool
+		dec := json.NewDecoder(bytes.NewBuffer(rawPool))
+		if err := dec.Decode(&jsonSpec); err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to parse node pool: %v", err))
+			return 1
+		}
+		pool = &jsonSpec
+	} else {
+		pool, err = parseNodePoolSpec(rawPool)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error parsing node pool specification: %s", err))
+			return 1
+		}
+	}
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	_, err = client.NodePools().Register(pool, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error applying node pool: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Successfully applied node pool %q!", pool.Name))
+	return 0
+}
+
+// parseNodePoolSpec is used to parse the node pool specification from HCL
+func parseNodePoolSpec(input []byte) (*api.NodePool, error) {
+	root, err := hcl.ParseBytes(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Top-level item should be a list
+	list, ok := root.Node.(*ast.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("error parsing: root should be an object")
+	}
+
+	matches := list.Filter("node_pool")
+	if len(matches.Items) == 0 {
+		return nil, fmt.Errorf("'node_pool' stanza not found")
+	}
+	if len(matches.Items) > 1 {
+		return nil, fmt.Errorf("only one 'node_pool' stanza allowed per file")
+	}
+
+	item := matches.Items[0]
+	if len(item.Keys) != 1 {
+		return nil, fmt.Errorf("'node_pool' stanza requires a name")
+	}
+	obj, ok := item.Val.(*ast.ObjectType)
+	if !ok {
+		return nil, fmt.Errorf("'node_pool' should be an object")
+	}
+
+	spec := &api.NodePool{
+		Name: item.Keys[0].Token.Value().(string),
+	}
+	if err := parseNodePoolSpecImpl(spec, obj.List); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// parseNodePoolSpecImpl parses the node pool body taking as input the AST tree
+func parseNodePoolSpecImpl(result *api.NodePool, list *ast.ObjectList) error {
+	// Decode the full thing into a map[string]interface for ease
+	var m map[string]interface{}
+	if err := hcl.DecodeObject(&m, list); err != nil {
+		return err
+	}
+
+	delete(m, "meta")
+
+	// Decode the rest
+	if err := mapstructure.WeakDecode(m, result); err != nil {
+		return err
+	}
+
+	if metaO := list.Filter("meta"); len(metaO.Items) > 0 {
+		for _, o := range metaO.Elem().Items {
+			var m map[string]interface{}
+			if err := hcl.DecodeObject(&m, o.Val); err != nil {
+				return err
+			}
+			if err := mapstructure.WeakDecode(m, &result.Meta); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
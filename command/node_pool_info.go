@@ -0,0 +1,153 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/posener/complete"
+)
+
+type NodePoolInfoCommand struct {
+	Meta
+}
+
+func (c *NodePoolInfoCommand) Help() string {
+	helpText := `
+Usage: nomad node pool info [options] <node-pool>
+
+  Info is used to fetch information about an existing node pool.
+
+  If ACLs are enabled, this command requires a token with the 'node:read'
+  capability.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+Info Options:
+
+  -json
+    Output the node pool in its JSON format.
+
+  -t
+    Format and display the node pool using a Go template.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *NodePoolInfoCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-json": complete.PredictNothing,
+			"-t":    complete.PredictAnything,
+		})
+}
+
+func (c *NodePoolInfoCommand) AutocompleteArgs() complete.Predictor {
+	return NodePoolPredictor(c.Meta.Client, nil)
+}
+
+func (c *NodePoolInfoCommand) Synopsis() string {
+	return "Fetch information about an existing node pool"
+}
+
+func (c *NodePoolInfoCommand) Name() string { return "node pool info" }
+
+func (c *NodePoolInfoCommand) Run(args []string) int {
+	var json bool
+	var tmpl string
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&json, "json", false, "")
+	flags.StringVar(&tmpl, "t", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got one argument
+	args = flags.Args()
+	if l := len(args); l != 1 {
+		c.Ui.Error("This command takes one argument: <node-pool>")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	name := args[0]
+
+	// Get the HTTP client
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Do a prefix lookup
+	pool, possible, err := getNodePool(client.NodePools(), name)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error retrieving node pool: %s", err))
+		return 1
+	}
+
+	if len(possible) != 0 {
+		c.Ui.Error(fmt.Sprintf("Prefix matched multiple node pools\n\n%s", formatNodePools(possible)))
+		return 1
+	}
+
+	if json || len(tmpl) > 0 {
+		out, err := Format(json, tmpl, pool)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
+	basic := []string{
+		fmt.Sprintf("Name|%s", pool.Name),
+		fmt.Sprintf("Description|%s", pool.Description),
+	}
+	c.Ui.Output(formatKV(basic))
+
+	if len(pool.Meta) > 0 {
+		c.Ui.Output(c.Colorize().Color("\n[bold]Metadata[reset]"))
+		var meta []string
+		for k := range pool.Meta {
+			meta = append(meta, fmt.Sprintf("%s|%s", k, pool.Meta[k]))
+		}
+		sort.Strings(meta)
+		c.Ui.Output(formatKV(meta))
+	}
+
+	return 0
+}
+
+func getNodePool(client *api.NodePools, name string) (match *api.NodePool, possible []*api.NodePool, err error) {
+	// Do a prefix lookup
+	pools, _, err := client.PrefixList(name, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := len(pools)
+	switch {
+	case l == 0:
+		return nil, nil, fmt.Errorf("Node pool %q matched no node pools", name)
+	case l == 1:
+		return pools[0], nil, nil
+	default:
+		// search for an exact match in the returned node pools
+		for _, pool := range pools {
+			if pool.Name == name {
+				return pool, nil, nil
+			}
+		}
+		// if not found, return the fuzzy matches.
+		return nil, pools, nil
+	}
+}
@@ -479,6 +479,7 @@ func (c *NodeStatusCommand) formatNode(client *api.Client, node *api.Node) int {
 		fmt.Sprintf("Name|%s", node.Name),
 		fmt.Sprintf("Class|%s", node.NodeClass),
 		fmt.Sprintf("DC|%s", node.Datacenter),
+		fmt.Sprintf("Node Pool|%s", node.NodePool),
 		fmt.Sprintf("Drain|%v", formatDrain(node)),
 		fmt.Sprintf("Eligibility|%s", node.SchedulingEligibility),
 		fmt.Sprintf("Status|%s", node.Status),
@@ -542,6 +543,11 @@ func (c *NodeStatusCommand) formatNode(client *api.Client, node *api.Node) int {
 	c.Ui.Output(c.Colorize().Color("\n[bold]Allocated Resources[reset]"))
 	c.Ui.Output(formatList(allocatedResources))
 
+	if c.verbose && node.NodeResources != nil && len(node.NodeResources.Custom) > 0 {
+		c.Ui.Output(c.Colorize().Color("\n[bold]Custom Resources[reset]"))
+		c.Ui.Output(formatList(getCustomResources(runningAllocs, node)))
+	}
+
 	actualResources, err := getActualResources(client, runningAllocs, node)
 	if err == nil {
 		c.Ui.Output(c.Colorize().Color("\n[bold]Allocation Resource Utilization[reset]"))
@@ -932,6 +938,35 @@ func getAllocatedResources(client *api.Client, runningAllocs []*api.Allocation,
 	return resources
 }
 
+// getCustomResources returns the usage of the custom resources of the node.
+func getCustomResources(runningAllocs []*api.Allocation, node *api.Node) []string {
+	used := make(map[string]uint64)
+	for _, alloc := range runningAllocs {
+		if alloc.AllocatedResources == nil {
+			continue
+		}
+		for _, tr := range alloc.AllocatedResources.Tasks {
+			for name, count := range tr.Custom {
+				used[name] += count
+			}
+		}
+	}
+
+	names := make([]string, 0, len(node.NodeResources.Custom))
+	for name := range node.NodeResources.Custom {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resources := make([]string, 0, len(names)+1)
+	resources = append(resources, "Name|Allocated")
+	for _, name := range names {
+		resources = append(resources, fmt.Sprintf("%s|%d/%d",
+			name, used[name], node.NodeResources.Custom[name]))
+	}
+	return resources
+}
+
 // computeNodeTotalResources returns the total allocatable resources (resources
 // minus reserved)
 func computeNodeTotalResources(node *api.Node) api.Resources {
@@ -294,3 +294,34 @@ func TestNodeStatusCommand_FormatDrain(t *testing.T) {
 	node.DrainStrategy.IgnoreSystemJobs = true
 	assert.Equal("true; 1970-01-01T00:00:01Z deadline; ignoring system jobs", formatDrain(node))
 }
+
+func TestNodeStatusCommand_CustomResources(t *testing.T) {
+	ci.Parallel(t)
+	assert := assert.New(t)
+
+	node := &api.Node{
+		NodeResources: &api.NodeResources{
+			Custom: map[string]uint64{
+				"license_seat": 4,
+				"build_slot":   2,
+			},
+		},
+	}
+	allocs := []*api.Allocation{
+		{
+			AllocatedResources: &api.AllocatedResources{
+				Tasks: map[string]*api.AllocatedTaskResources{
+					"web":     {Custom: map[string]uint64{"license_seat": 1}},
+					"sidecar": {Custom: map[string]uint64{"license_seat": 2}},
+				},
+			},
+		},
+		{},
+	}
+
+	assert.Equal([]string{
+		"Name|Allocated",
+		"build_slot|0/2",
+		"license_seat|3/4",
+	}, getCustomResources(allocs, node))
+}
@@ -28,6 +28,14 @@ Usage: nomad operator scheduler <subcommand> [options]
 
       $ nomad operator scheduler set-config -scheduler-algorithm=spread
 
+  Report the allocations that would be migrated to reduce fragmentation:
+
+      $ nomad operator scheduler rebalance -dry-run
+
+  Simulate scheduling a job against a snapshot of the cluster state:
+
+      $ nomad operator scheduler simulate backup.snap example.nomad
+
   Please see the individual subcommand help for detailed usage information.
 `
 	return strings.TrimSpace(helpText)
@@ -0,0 +1,165 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+// Ensure OperatorSchedulerRebalanceCommand satisfies the cli.Command interface.
+var _ cli.Command = &OperatorSchedulerRebalanceCommand{}
+
+type OperatorSchedulerRebalanceCommand struct {
+	Meta
+}
+
+func (c *OperatorSchedulerRebalanceCommand) Help() string {
+	helpText := `
+Usage: nomad operator scheduler rebalance [options]
+
+  Migrates allocations to reduce cluster fragmentation. When the cluster uses
+  the binpack scheduler algorithm, the allocations of lightly used nodes are
+  moved onto fuller nodes so that large task groups find room to be placed.
+  When the cluster uses the spread algorithm, allocations are moved off of the
+  most used nodes onto the least used ones, such as nodes that recently joined
+  the cluster.
+
+  Only running service allocations whose task group has a migrate block are
+  migrated, and no more allocations of a task group are migrated at once than
+  its migrate max_parallel allows. The scheduler places the replacement
+  allocations on the target nodes reported, unless a target node can no
+  longer run its replacement by the time it is placed.
+
+  The leader runs the same rebalancing periodically when it is enabled in the
+  scheduler configuration with 'nomad operator scheduler set-config
+  -rebalance-enabled=true'.
+
+  If ACLs are enabled, this command requires a token with the 'operator:write'
+  capability, or the 'operator:read' capability when using -dry-run.
+
+General Options:
+
+  ` + generalOptionsUsage(usageOptsDefault|usageOptsNoNamespace) + `
+
+Scheduler Rebalance Options:
+
+  -dry-run
+    Report the allocations that would be migrated without migrating them.
+
+  -max-migrations=<n>
+    The maximum number of allocations to migrate. Defaults to the limit set in
+    the rebalance scheduler configuration.
+
+  -verbose
+    Display full information.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *OperatorSchedulerRebalanceCommand) Synopsis() string {
+	return "Migrate allocations to reduce cluster fragmentation"
+}
+
+func (c *OperatorSchedulerRebalanceCommand) AutocompleteFlags() complete.Flags {
+	return mergeAutocompleteFlags(c.Meta.AutocompleteFlags(FlagSetClient),
+		complete.Flags{
+			"-dry-run":        complete.PredictNothing,
+			"-max-migrations": complete.PredictAnything,
+			"-verbose":        complete.PredictNothing,
+		})
+}
+
+func (c *OperatorSchedulerRebalanceCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *OperatorSchedulerRebalanceCommand) Name() string { return "operator scheduler rebalance" }
+
+func (c *OperatorSchedulerRebalanceCommand) Run(args []string) int {
+	var dryRun, verbose bool
+	var maxMigrations int
+
+	flags := c.Meta.FlagSet(c.Name(), FlagSetClient)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.BoolVar(&dryRun, "dry-run", false, "")
+	flags.IntVar(&maxMigrations, "max-migrations", 0, "")
+	flags.BoolVar(&verbose, "verbose", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got no arguments.
+	if len(flags.Args()) != 0 {
+		c.Ui.Error("This command takes no arguments")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	if maxMigrations < 0 {
+		c.Ui.Error("The -max-migrations flag must not be negative")
+		return 1
+	}
+
+	// Truncate the id unless full length is requested
+	length := shortId
+	if verbose {
+		length = fullId
+	}
+
+	client, err := c.Meta.Client()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	var resp *api.SchedulerRebalanceResponse
+	if dryRun {
+		resp, _, err = client.Operator().SchedulerRebalancePlan(maxMigrations, nil)
+	} else {
+		resp, _, err = client.Operator().SchedulerRebalance(maxMigrations, nil)
+	}
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error rebalancing the cluster: %s", err))
+		return 1
+	}
+
+	if len(resp.Migrations) == 0 {
+		c.Ui.Output("No allocations to migrate")
+		return 0
+	}
+
+	if dryRun {
+		c.Ui.Output(c.Colorize().Color("[bold]Allocations that would be migrated[reset]"))
+	} else {
+		c.Ui.Output(c.Colorize().Color("[bold]Migrated allocations[reset]"))
+	}
+	c.Ui.Output(formatRebalanceMigrations(resp.Migrations, length))
+
+	if !dryRun {
+		c.Ui.Output("")
+		for _, evalID := range resp.EvalIDs {
+			c.Ui.Output(fmt.Sprintf("Created evaluation %q", limit(evalID, length)))
+		}
+	}
+	return 0
+}
+
+// formatRebalanceMigrations returns a table of the rebalance migrations.
+func formatRebalanceMigrations(migrations []*api.RebalanceMigration, length int) string {
+	rows := make([]string, 0, len(migrations)+1)
+	rows = append(rows, "Alloc ID|Alloc Name|Namespace|Source Node|Target Node")
+	for _, m := range migrations {
+		rows = append(rows, fmt.Sprintf("%s|%s|%s|%s|%s",
+			limit(m.AllocID, length),
+			m.AllocName,
+			m.Namespace,
+			limit(m.SourceNodeID, length),
+			limit(m.TargetNodeID, length),
+		))
+	}
+	return formatList(rows)
+}
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/mitchellh/cli"
+	"github.com/shoenig/test/must"
+)
+
+func TestOperatorSchedulerRebalanceCommand_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &OperatorSchedulerRebalanceCommand{}
+}
+
+func TestOperatorSchedulerRebalanceCommand_Run(t *testing.T) {
+	ci.Parallel(t)
+
+	srv, _, addr := testServer(t, false, nil)
+	defer srv.Shutdown()
+
+	ui := cli.NewMockUi()
+	cmd := &OperatorSchedulerRebalanceCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on arguments and a negative limit.
+	must.One(t, cmd.Run([]string{"-address=" + addr, "extra"}))
+	must.StrContains(t, ui.ErrorWriter.String(), "This command takes no arguments")
+	ui.ErrorWriter.Reset()
+
+	must.One(t, cmd.Run([]string{"-address=" + addr, "-max-migrations=-1"}))
+	must.StrContains(t, ui.ErrorWriter.String(), "must not be negative")
+	ui.ErrorWriter.Reset()
+
+	// A cluster without allocations has nothing to migrate.
+	must.Zero(t, cmd.Run([]string{"-address=" + addr, "-dry-run"}))
+	must.StrContains(t, ui.OutputWriter.String(), "No allocations to migrate")
+	ui.OutputWriter.Reset()
+
+	must.Zero(t, cmd.Run([]string{"-address=" + addr}))
+	must.StrContains(t, ui.OutputWriter.String(), "No allocations to migrate")
+}
+
+func TestOperatorSchedulerRebalanceCommand_formatRebalanceMigrations(t *testing.T) {
+	ci.Parallel(t)
+
+	out := formatRebalanceMigrations([]*api.RebalanceMigration{{
+		AllocID:      "0d2d8b9a-4c1f-0e3f-3d6c-2c1a4a4c1f11",
+		AllocName:    "example.web[0]",
+		Namespace:    "default",
+		SourceNodeID: "7b0c3d3f-8e2a-2a4e-6f3e-5f0a4f2e9c2d",
+		TargetNodeID: "f2b0a4c1-1f9d-7c3e-0a5b-9c2d8e3f4a5b",
+	}}, shortId)
+
+	must.StrContains(t, out, "Alloc ID  Alloc Name      Namespace  Source Node  Target Node")
+	must.StrContains(t, out, "0d2d8b9a  example.web[0]  default    7b0c3d3f     f2b0a4c1")
+}
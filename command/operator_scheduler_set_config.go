@@ -2,6 +2,7 @@ package command
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/nomad/api"
@@ -28,6 +29,8 @@ type OperatorSchedulerSetConfig struct {
 	preemptServiceScheduler  flagHelper.BoolValue
 	preemptSysBatchScheduler flagHelper.BoolValue
 	preemptSystemScheduler   flagHelper.BoolValue
+	rebalanceEnabled         flagHelper.BoolValue
+	rebalanceMaxMigrations   string
 }
 
 func (o *OperatorSchedulerSetConfig) AutocompleteFlags() complete.Flags {
@@ -45,6 +48,8 @@ func (o *OperatorSchedulerSetConfig) AutocompleteFlags() complete.Flags {
 			"-preempt-service-scheduler":  complete.PredictSet("true", "false"),
 			"-preempt-sysbatch-scheduler": complete.PredictSet("true", "false"),
 			"-preempt-system-scheduler":   complete.PredictSet("true", "false"),
+			"-rebalance-enabled":          complete.PredictSet("true", "false"),
+			"-rebalance-max-migrations":   complete.PredictAnything,
 		},
 	)
 }
@@ -69,6 +74,8 @@ func (o *OperatorSchedulerSetConfig) Run(args []string) int {
 	flags.Var(&o.preemptServiceScheduler, "preempt-service-scheduler", "")
 	flags.Var(&o.preemptSysBatchScheduler, "preempt-sysbatch-scheduler", "")
 	flags.Var(&o.preemptSystemScheduler, "preempt-system-scheduler", "")
+	flags.Var(&o.rebalanceEnabled, "rebalance-enabled", "")
+	flags.StringVar(&o.rebalanceMaxMigrations, "rebalance-max-migrations", "", "")
 
 	if err := flags.Parse(args); err != nil {
 		return 1
@@ -131,6 +138,15 @@ func (o *OperatorSchedulerSetConfig) Run(args []string) int {
 	o.preemptServiceScheduler.Merge(&schedulerConfig.PreemptionConfig.ServiceSchedulerEnabled)
 	o.preemptSysBatchScheduler.Merge(&schedulerConfig.PreemptionConfig.SysBatchSchedulerEnabled)
 	o.preemptSystemScheduler.Merge(&schedulerConfig.PreemptionConfig.SystemSchedulerEnabled)
+	o.rebalanceEnabled.Merge(&schedulerConfig.RebalanceConfig.Enabled)
+	if o.rebalanceMaxMigrations != "" {
+		maxMigrations, err := strconv.Atoi(o.rebalanceMaxMigrations)
+		if err != nil {
+			o.Ui.Error(fmt.Sprintf("Error parsing rebalance-max-migrations value %q: %v", o.rebalanceMaxMigrations, err))
+			return 1
+		}
+		schedulerConfig.RebalanceConfig.MaxMigrations = maxMigrations
+	}
 
 	// Check-and-set the new configuration.
 	result, _, err := client.Operator().SchedulerCASConfiguration(schedulerConfig, nil)
@@ -205,6 +221,14 @@ Scheduler Set Config Options:
   -preempt-system-scheduler=[true|false]
     Specifies whether preemption for system jobs is enabled. Note that if this
     is set to true, then system jobs can preempt any other jobs.
+
+  -rebalance-enabled=[true|false]
+    Specifies whether the leader periodically migrates allocations to reduce
+    cluster fragmentation. See 'nomad operator scheduler rebalance'.
+
+  -rebalance-max-migrations=<n>
+    Specifies the maximum number of allocations migrated by a single rebalance
+    pass across the whole cluster. Defaults to 10 when set to 0.
 `
 	return strings.TrimSpace(helpText)
 }
@@ -48,6 +48,8 @@ func TestOperatorSchedulerSetConfig_Run(t *testing.T) {
 		"-preempt-service-scheduler=true",
 		"-preempt-sysbatch-scheduler=true",
 		"-preempt-system-scheduler=false",
+		"-rebalance-enabled=true",
+		"-rebalance-max-migrations=5",
 	}
 	require.EqualValues(t, 0, c.Run(modifyingArgs))
 	s := ui.OutputWriter.String()
@@ -66,6 +68,10 @@ func TestOperatorSchedulerSetConfig_Run(t *testing.T) {
 		MemoryOversubscriptionEnabled: true,
 		RejectJobRegistration:         true,
 		PauseEvalBroker:               true,
+		RebalanceConfig: api.RebalanceConfig{
+			Enabled:       true,
+			MaxMigrations: 5,
+		},
 	}, modifiedConfig.SchedulerConfig)
 
 	ui.ErrorWriter.Reset()
@@ -105,4 +111,5 @@ func schedulerConfigEquals(t *testing.T, expected, actual *api.SchedulerConfigur
 	require.Equal(t, expected.MemoryOversubscriptionEnabled, actual.MemoryOversubscriptionEnabled)
 	require.Equal(t, expected.PauseEvalBroker, actual.PauseEvalBroker)
 	require.Equal(t, expected.PreemptionConfig, actual.PreemptionConfig)
+	require.Equal(t, expected.RebalanceConfig, actual.RebalanceConfig)
 }
@@ -0,0 +1,534 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/command/agent"
+	flagHelper "github.com/hashicorp/nomad/helper/flags"
+	"github.com/hashicorp/nomad/helper/raftutil"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/scheduler"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+// Ensure OperatorSchedulerSimulateCommand satisfies the cli.Command interface.
+var _ cli.Command = &OperatorSchedulerSimulateCommand{}
+
+type OperatorSchedulerSimulateCommand struct {
+	Meta
+	JobGetter
+
+	// The scheduler configuration flags allow us to tell whether the user set
+	// a value or not, so that only the values set are changed in the
+	// configuration of the snapshot.
+	schedulerAlgorithm       string
+	memoryOversubscription   flagHelper.BoolValue
+	preemptBatchScheduler    flagHelper.BoolValue
+	preemptServiceScheduler  flagHelper.BoolValue
+	preemptSysBatchScheduler flagHelper.BoolValue
+	preemptSystemScheduler   flagHelper.BoolValue
+}
+
+func (c *OperatorSchedulerSimulateCommand) Help() string {
+	helpText := `
+Usage: nomad operator scheduler simulate [options] <snapshot> [<job>]
+
+  Simulates scheduling against the cluster state of a snapshot saved with
+  "nomad operator snapshot save", without contacting the cluster. The real
+  schedulers are run against the snapshot and the resulting placements,
+  preemptions, stops and unplaced allocations are displayed.
+
+  If a job file is given, the job is registered in the snapshot and evaluated.
+  Otherwise the scheduler configuration options are applied to the snapshot
+  and every running job is evaluated again. If the supplied job path is "-",
+  the job file is read from stdin.
+
+  Simulate the registration of a job:
+
+      $ nomad operator scheduler simulate backup.snap example.nomad
+
+  Simulate enabling preemption for service jobs:
+
+      $ nomad operator scheduler simulate -preempt-service-scheduler=true backup.snap
+
+Scheduler Simulate Options:
+
+  -scheduler-algorithm=["binpack"|"spread"]
+    Overrides the scheduler algorithm of the snapshot.
+
+  -memory-oversubscription=[true|false]
+    Overrides whether memory oversubscription is enabled in the snapshot.
+
+  -preempt-batch-scheduler=[true|false]
+    Overrides whether preemption for batch jobs is enabled in the snapshot.
+
+  -preempt-service-scheduler=[true|false]
+    Overrides whether preemption for service jobs is enabled in the snapshot.
+
+  -preempt-sysbatch-scheduler=[true|false]
+    Overrides whether preemption for sysbatch jobs is enabled in the snapshot.
+
+  -preempt-system-scheduler=[true|false]
+    Overrides whether preemption for system jobs is enabled in the snapshot.
+
+  -json
+    Parses the job file as JSON. If the outer object has a Job field, such as
+    from "nomad job inspect" or "nomad run -output", the value of the field is
+    used as the job.
+
+  -hcl1
+    Parses the job file as HCLv1.
+
+  -var 'key=value'
+    Variable for template, can be used multiple times.
+
+  -var-file=path
+    Path to HCL2 file containing user variables.
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *OperatorSchedulerSimulateCommand) AutocompleteFlags() complete.Flags {
+	return complete.Flags{
+		"-scheduler-algorithm": complete.PredictSet(
+			string(structs.SchedulerAlgorithmBinpack),
+			string(structs.SchedulerAlgorithmSpread),
+		),
+		"-memory-oversubscription":    complete.PredictSet("true", "false"),
+		"-preempt-batch-scheduler":    complete.PredictSet("true", "false"),
+		"-preempt-service-scheduler":  complete.PredictSet("true", "false"),
+		"-preempt-sysbatch-scheduler": complete.PredictSet("true", "false"),
+		"-preempt-system-scheduler":   complete.PredictSet("true", "false"),
+		"-json":                       complete.PredictNothing,
+		"-hcl1":                       complete.PredictNothing,
+		"-var":                        complete.PredictAnything,
+		"-var-file":                   complete.PredictFiles("*.var"),
+	}
+}
+
+func (c *OperatorSchedulerSimulateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*")
+}
+
+func (c *OperatorSchedulerSimulateCommand) Synopsis() string {
+	return "Simulate scheduling against a snapshot"
+}
+
+func (c *OperatorSchedulerSimulateCommand) Name() string { return "operator scheduler simulate" }
+
+func (c *OperatorSchedulerSimulateCommand) Run(args []string) int {
+	flags := c.Meta.FlagSet(c.Name(), FlagSetNone)
+	flags.Usage = func() { c.Ui.Output(c.Help()) }
+	flags.StringVar(&c.schedulerAlgorithm, "scheduler-algorithm", "", "")
+	flags.Var(&c.memoryOversubscription, "memory-oversubscription", "")
+	flags.Var(&c.preemptBatchScheduler, "preempt-batch-scheduler", "")
+	flags.Var(&c.preemptServiceScheduler, "preempt-service-scheduler", "")
+	flags.Var(&c.preemptSysBatchScheduler, "preempt-sysbatch-scheduler", "")
+	flags.Var(&c.preemptSystemScheduler, "preempt-system-scheduler", "")
+	flags.BoolVar(&c.JobGetter.JSON, "json", false, "")
+	flags.BoolVar(&c.JobGetter.HCL1, "hcl1", false, "")
+	flags.Var(&c.JobGetter.Vars, "var", "")
+	flags.Var(&c.JobGetter.VarFiles, "var-file", "")
+
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// Check that we got the snapshot and at most one job.
+	args = flags.Args()
+	if l := len(args); l != 1 && l != 2 {
+		c.Ui.Error("This command takes one or two arguments: <snapshot> [<job>]")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	switch c.schedulerAlgorithm {
+	case "", string(structs.SchedulerAlgorithmBinpack), string(structs.SchedulerAlgorithmSpread):
+	default:
+		c.Ui.Error(fmt.Sprintf("Invalid scheduler algorithm %q", c.schedulerAlgorithm))
+		return 1
+	}
+
+	// Parse the job before doing the more expensive snapshot restore.
+	var job *structs.Job
+	if len(args) == 2 {
+		if err := c.JobGetter.Validate(); err != nil {
+			c.Ui.Error(fmt.Sprintf("Invalid job options: %s", err))
+			return 1
+		}
+		apiJob, err := c.JobGetter.Get(args[1])
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error getting job struct: %s", err))
+			return 1
+		}
+		job = agent.ApiJobToStructJob(apiJob)
+		job.Canonicalize()
+		if err := job.Validate(); err != nil {
+			c.Ui.Error(fmt.Sprintf("Job validation failed: %s", err))
+			return 1
+		}
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error opening snapshot file: %s", err))
+		return 1
+	}
+	defer f.Close()
+
+	store, _, err := raftutil.RestoreFromArchive(f, nil)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to read snapshot file: %s", err))
+		return 1
+	}
+
+	sim := &schedulerSimulation{store: store}
+	configChanged, err := sim.setSchedulerConfig(c.mergeSchedulerConfig)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error setting scheduler configuration: %s", err))
+		return 1
+	}
+
+	var jobs []*structs.Job
+	switch {
+	case job != nil:
+		if err := sim.registerJob(job); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error registering job: %s", err))
+			return 1
+		}
+		jobs = append(jobs, job)
+	case configChanged:
+		if jobs, err = sim.runningJobs(); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error listing jobs: %s", err))
+			return 1
+		}
+	default:
+		c.Ui.Error("A job file or a scheduler configuration option must be given")
+		c.Ui.Error(commandErrorText(c))
+		return 1
+	}
+
+	changed := false
+	for _, job := range jobs {
+		result, err := sim.evaluate(job)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error evaluating job %q: %s", job.ID, err))
+			return 1
+		}
+		if result.empty() {
+			continue
+		}
+		changed = true
+		c.Ui.Output(c.Colorize().Color(c.formatSimulationResult(result)))
+	}
+
+	if !changed {
+		c.Ui.Output("No changes")
+	}
+	return 0
+}
+
+// mergeSchedulerConfig overlays the scheduler configuration options set by the
+// operator onto the configuration.
+func (c *OperatorSchedulerSimulateCommand) mergeSchedulerConfig(config *structs.SchedulerConfiguration) {
+	if c.schedulerAlgorithm != "" {
+		config.SchedulerAlgorithm = structs.SchedulerAlgorithm(c.schedulerAlgorithm)
+	}
+	c.memoryOversubscription.Merge(&config.MemoryOversubscriptionEnabled)
+	c.preemptBatchScheduler.Merge(&config.PreemptionConfig.BatchSchedulerEnabled)
+	c.preemptServiceScheduler.Merge(&config.PreemptionConfig.ServiceSchedulerEnabled)
+	c.preemptSysBatchScheduler.Merge(&config.PreemptionConfig.SysBatchSchedulerEnabled)
+	c.preemptSystemScheduler.Merge(&config.PreemptionConfig.SystemSchedulerEnabled)
+}
+
+func (c *OperatorSchedulerSimulateCommand) formatSimulationResult(r *simulationResult) string {
+	var out strings.Builder
+	out.WriteString(fmt.Sprintf("[bold]==> Job %q (namespace %q)[reset]\n", r.job.ID, r.job.Namespace))
+
+	if len(r.placements) > 0 {
+		rows := []string{"Alloc Name|Node ID|Node Name|Update"}
+		for _, p := range r.placements {
+			rows = append(rows, fmt.Sprintf("%s|%s|%s|%s",
+				p.alloc.Name, limit(p.alloc.NodeID, shortId), p.alloc.NodeName, p.update))
+		}
+		out.WriteString(fmt.Sprintf("\n[bold]Placements[reset]\n%s\n", formatList(rows)))
+	}
+
+	if len(r.stops) > 0 {
+		rows := []string{"Alloc ID|Alloc Name|Node ID|Description"}
+		for _, alloc := range r.stops {
+			rows = append(rows, fmt.Sprintf("%s|%s|%s|%s",
+				limit(alloc.ID, shortId), alloc.Name, limit(alloc.NodeID, shortId), alloc.DesiredDescription))
+		}
+		out.WriteString(fmt.Sprintf("\n[bold]Stops[reset]\n%s\n", formatList(rows)))
+	}
+
+	if len(r.preemptions) > 0 {
+		rows := []string{"Alloc ID|Namespace|Job ID|Alloc Name|Node ID"}
+		for _, alloc := range r.preemptions {
+			rows = append(rows, fmt.Sprintf("%s|%s|%s|%s|%s",
+				limit(alloc.ID, shortId), alloc.Namespace, alloc.JobID, alloc.Name, limit(alloc.NodeID, shortId)))
+		}
+		out.WriteString(fmt.Sprintf("\n[bold][yellow]Preemptions[reset]\n%s\n", formatList(rows)))
+	}
+
+	if len(r.failed) > 0 {
+		tgs := make([]string, 0, len(r.failed))
+		for tg := range r.failed {
+			tgs = append(tgs, tg)
+		}
+		sort.Strings(tgs)
+
+		out.WriteString("\n[bold][red]Blocked Capacity[reset]\n")
+		for _, tg := range tgs {
+			metrics := r.failed[tg]
+			out.WriteString(fmt.Sprintf("[red]Task Group %q (failed to place %d allocation(s)):[reset]\n",
+				tg, metrics.CoalescedFailures+1))
+			out.WriteString(formatAllocMetrics(simulatedAllocMetrics(metrics), false, "  "))
+		}
+	}
+
+	return out.String()
+}
+
+// simulatedAllocMetrics converts the placement failure metrics of the
+// simulation to be displayed like the ones returned by the API.
+func simulatedAllocMetrics(m *structs.AllocMetric) *api.AllocationMetric {
+	return &api.AllocationMetric{
+		NodesEvaluated:     m.NodesEvaluated,
+		NodesFiltered:      m.NodesFiltered,
+		NodesAvailable:     m.NodesAvailable,
+		ClassFiltered:      m.ClassFiltered,
+		ConstraintFiltered: m.ConstraintFiltered,
+		NodesExhausted:     m.NodesExhausted,
+		ClassExhausted:     m.ClassExhausted,
+		DimensionExhausted: m.DimensionExhausted,
+		QuotaExhausted:     m.QuotaExhausted,
+		AllocationTime:     m.AllocationTime,
+		CoalescedFailures:  m.CoalescedFailures,
+	}
+}
+
+// schedulerSimulation runs the schedulers against a state store restored from
+// a snapshot. Plans are applied to the state store in place of Raft, so each
+// evaluation sees the results of the previous ones.
+type schedulerSimulation struct {
+	store *state.StateStore
+	index uint64
+}
+
+// nextIndex returns the index for the next write to the state store.
+func (s *schedulerSimulation) nextIndex() (uint64, error) {
+	if s.index == 0 {
+		latest, err := s.store.LatestIndex()
+		if err != nil {
+			return 0, err
+		}
+		s.index = latest
+	}
+	s.index++
+	return s.index, nil
+}
+
+// setSchedulerConfig applies merge to the scheduler configuration of the
+// snapshot and returns whether the configuration changed.
+func (s *schedulerSimulation) setSchedulerConfig(merge func(*structs.SchedulerConfiguration)) (bool, error) {
+	_, current, err := s.store.SchedulerConfig()
+	if err != nil {
+		return false, err
+	}
+	if current == nil {
+		current = &structs.SchedulerConfiguration{}
+	}
+
+	config := *current
+	merge(&config)
+	if config == *current {
+		return false, nil
+	}
+
+	index, err := s.nextIndex()
+	if err != nil {
+		return false, err
+	}
+	return true, s.store.SchedulerSetConfig(index, &config)
+}
+
+// registerJob registers the job in the state store. Like job registration,
+// jobs without a node pool use the default node pool of their namespace.
+func (s *schedulerSimulation) registerJob(job *structs.Job) error {
+	if job.NodePool == "" {
+		ns, err := s.store.NamespaceByName(nil, job.Namespace)
+		if err != nil {
+			return err
+		}
+		if ns == nil {
+			return fmt.Errorf("namespace %q not found", job.Namespace)
+		}
+		job.NodePool = ns.NodePoolConfiguration.DefaultPool()
+	}
+
+	index, err := s.nextIndex()
+	if err != nil {
+		return err
+	}
+	return s.store.UpsertJob(structs.MsgTypeTestSetup, index, job)
+}
+
+// runningJobs returns the jobs that can be evaluated, sorted by descending
+// priority so that the most important jobs are placed first.
+func (s *schedulerSimulation) runningJobs() ([]*structs.Job, error) {
+	iter, err := s.store.Jobs(memdb.NewWatchSet())
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []*structs.Job
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		job := raw.(*structs.Job)
+		if job.Stopped() || job.IsPeriodic() || job.IsParameterized() {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].Priority != jobs[j].Priority {
+			return jobs[i].Priority > jobs[j].Priority
+		}
+		if jobs[i].Namespace != jobs[j].Namespace {
+			return jobs[i].Namespace < jobs[j].Namespace
+		}
+		return jobs[i].ID < jobs[j].ID
+	})
+	return jobs, nil
+}
+
+// evaluate runs the scheduler for the job and returns the changes it made.
+func (s *schedulerSimulation) evaluate(job *structs.Job) (*simulationResult, error) {
+	// Lookup the job to evaluate the version stored by registerJob.
+	job, err := s.store.JobByID(nil, job.Namespace, job.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.store.AllocsByJob(nil, job.Namespace, job.ID, true)
+	if err != nil {
+		return nil, err
+	}
+	existingIDs := make(map[string]struct{}, len(existing))
+	for _, alloc := range existing {
+		existingIDs[alloc.ID] = struct{}{}
+	}
+
+	index, err := s.nextIndex()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixNano()
+	eval := &structs.Evaluation{
+		ID:             uuid.Generate(),
+		Namespace:      job.Namespace,
+		Priority:       job.Priority,
+		Type:           job.Type,
+		TriggeredBy:    structs.EvalTriggerJobRegister,
+		JobID:          job.ID,
+		JobModifyIndex: job.JobModifyIndex,
+		Status:         structs.EvalStatusPending,
+		CreateTime:     now,
+		ModifyTime:     now,
+	}
+	if err := s.store.UpsertEvals(structs.MsgTypeTestSetup, index, []*structs.Evaluation{eval}); err != nil {
+		return nil, err
+	}
+
+	// The harness applies plans to the state store directly instead of
+	// going through the plan applier and Raft.
+	planIndex, err := s.nextIndex()
+	if err != nil {
+		return nil, err
+	}
+	planner := scheduler.NewSimulationHarness(s.store, planIndex)
+	sched, err := scheduler.NewScheduler(eval.Type, hclog.NewNullLogger(), nil, s.store, planner)
+	if err != nil {
+		return nil, err
+	}
+	if err := sched.Process(eval); err != nil {
+		return nil, err
+	}
+
+	// Move the index past the writes of the harness
+	if s.index, err = s.store.LatestIndex(); err != nil {
+		return nil, err
+	}
+
+	result := &simulationResult{job: job}
+	for _, plan := range planner.Plans {
+		result.addPlan(plan, existingIDs)
+	}
+	if n := len(planner.Evals); n > 0 {
+		result.failed = planner.Evals[n-1].FailedTGAllocs
+	}
+	result.sort()
+	return result, nil
+}
+
+// simulationResult is the set of changes the scheduler made for a job.
+type simulationResult struct {
+	job         *structs.Job
+	placements  []*simulatedPlacement
+	stops       []*structs.Allocation
+	preemptions []*structs.Allocation
+	failed      map[string]*structs.AllocMetric
+}
+
+type simulatedPlacement struct {
+	alloc *structs.Allocation
+
+	// update is set to "in-place" for existing allocations updated in place
+	update string
+}
+
+func (r *simulationResult) addPlan(plan *structs.Plan, existingIDs map[string]struct{}) {
+	for _, allocs := range plan.NodeAllocation {
+		for _, alloc := range allocs {
+			p := &simulatedPlacement{alloc: alloc, update: "new"}
+			if _, ok := existingIDs[alloc.ID]; ok {
+				p.update = "in-place"
+			}
+			r.placements = append(r.placements, p)
+		}
+	}
+	for _, allocs := range plan.NodeUpdate {
+		r.stops = append(r.stops, allocs...)
+	}
+	for _, allocs := range plan.NodePreemptions {
+		r.preemptions = append(r.preemptions, allocs...)
+	}
+}
+
+func (r *simulationResult) sort() {
+	sort.Slice(r.placements, func(i, j int) bool {
+		return r.placements[i].alloc.Name < r.placements[j].alloc.Name
+	})
+	for _, allocs := range [][]*structs.Allocation{r.stops, r.preemptions} {
+		sort.Slice(allocs, func(i, j int) bool {
+			return allocs[i].Name < allocs[j].Name
+		})
+	}
+}
+
+func (r *simulationResult) empty() bool {
+	return len(r.placements) == 0 && len(r.stops) == 0 &&
+		len(r.preemptions) == 0 && len(r.failed) == 0
+}
@@ -0,0 +1,137 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/command/agent"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/mitchellh/cli"
+	"github.com/shoenig/test/must"
+)
+
+func TestOperatorSchedulerSimulate_Implements(t *testing.T) {
+	ci.Parallel(t)
+	var _ cli.Command = &OperatorSchedulerSimulateCommand{}
+}
+
+func TestOperatorSchedulerSimulate_Fails(t *testing.T) {
+	ci.Parallel(t)
+
+	ui := cli.NewMockUi()
+	cmd := &OperatorSchedulerSimulateCommand{Meta: Meta{Ui: ui}}
+
+	// Fails on misuse
+	code := cmd.Run([]string{"some", "bad", "args"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), commandErrorText(cmd))
+	ui.ErrorWriter.Reset()
+
+	// Fails on an invalid scheduler algorithm
+	code = cmd.Run([]string{"-scheduler-algorithm=random", "backup.snap"})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), `Invalid scheduler algorithm "random"`)
+	ui.ErrorWriter.Reset()
+
+	// Fails on a missing snapshot
+	code = cmd.Run([]string{"-scheduler-algorithm=spread", filepath.Join(t.TempDir(), "backup.snap")})
+	must.One(t, code)
+	must.StrContains(t, ui.ErrorWriter.String(), "no such file")
+}
+
+func TestOperatorSchedulerSimulate_Job(t *testing.T) {
+	ci.Parallel(t)
+
+	snapPath := generateSnapshotFile(t, func(srv *agent.TestAgent, _ *api.Client, _ string) {
+		node := mock.Node()
+		req := &structs.NodeRegisterRequest{
+			Node:         node,
+			WriteRequest: structs.WriteRequest{Region: "global"},
+		}
+		var resp structs.NodeUpdateResponse
+		must.NoError(t, srv.Agent.RPC("Node.Register", req, &resp))
+	})
+
+	jobPath := filepath.Join(t.TempDir(), "example.nomad")
+	must.NoError(t, os.WriteFile(jobPath, []byte(`
+job "example" {
+  datacenters = ["dc1"]
+
+  group "web" {
+    count = 2
+
+    task "server" {
+      driver = "exec"
+
+      resources {
+        cpu    = 500
+        memory = 256
+      }
+    }
+  }
+
+  group "huge" {
+    task "server" {
+      driver = "exec"
+
+      resources {
+        cpu    = 500
+        memory = 100000
+      }
+    }
+  }
+}
+`), 0644))
+
+	ui := cli.NewMockUi()
+	cmd := &OperatorSchedulerSimulateCommand{Meta: Meta{Ui: ui}}
+
+	code := cmd.Run([]string{snapPath, jobPath})
+	must.Zero(t, code)
+
+	out := ui.OutputWriter.String()
+	must.StrContains(t, out, `==> Job "example" (namespace "default")`)
+	must.StrContains(t, out, "Placements")
+	must.StrContains(t, out, "example.web[0]")
+	must.StrContains(t, out, "example.web[1]")
+	must.StrContains(t, out, "Blocked Capacity")
+	must.StrContains(t, out, `Task Group "huge" (failed to place 1 allocation(s))`)
+	must.StrContains(t, out, `Dimension "memory" exhausted on 1 nodes`)
+
+	// Changing the scheduler configuration doesn't change anything for a
+	// snapshot without jobs
+	ui = cli.NewMockUi()
+	cmd = &OperatorSchedulerSimulateCommand{Meta: Meta{Ui: ui}}
+
+	code = cmd.Run([]string{"-scheduler-algorithm=spread", snapPath})
+	must.Zero(t, code)
+	must.StrContains(t, ui.OutputWriter.String(), "No changes")
+}
+
+func TestOperatorSchedulerSimulate_PlanIndex(t *testing.T) {
+	ci.Parallel(t)
+
+	store := state.TestStateStore(t)
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1000, mock.Node()))
+
+	sim := &schedulerSimulation{store: store}
+	job := mock.Job()
+	must.NoError(t, sim.registerJob(job))
+
+	result, err := sim.evaluate(job)
+	must.NoError(t, err)
+	must.SliceNotEmpty(t, result.placements)
+
+	// Plans are applied above the indexes of the snapshot
+	allocs, err := store.AllocsByJob(nil, job.Namespace, job.ID, true)
+	must.NoError(t, err)
+	must.Len(t, len(result.placements), allocs)
+	for _, alloc := range allocs {
+		must.Greater(t, 1001, alloc.CreateIndex)
+	}
+}
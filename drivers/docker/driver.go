@@ -1551,6 +1551,52 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 	return h.Signal(context.Background(), sig)
 }
 
+// UpdateTaskResources resizes the container of a running task by updating
+// its memory limits and cpu shares, which docker applies to the container's
+// cgroup without restarting it.
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	if resources == nil || resources.NomadResources == nil || resources.LinuxResources == nil {
+		return fmt.Errorf("task resources must be set")
+	}
+
+	var driverConfig TaskConfig
+	if err := h.task.DecodeDriverConfig(&driverConfig); err != nil {
+		return fmt.Errorf("failed to decode driver config: %v", err)
+	}
+
+	memory, memoryReservation := memoryLimits(driverConfig.MemoryHardLimit, resources.NomadResources.Memory)
+	opts := docker.UpdateContainerOptions{
+		Memory:            int(memory),
+		MemoryReservation: int(memoryReservation),
+		CPUShares:         int(resources.LinuxResources.CPUShares),
+	}
+
+	// The swap limit must be raised along with the memory limit, as it
+	// includes memory
+	if runtime.GOOS != "windows" {
+		opts.MemorySwap = int(memory)
+	}
+
+	if driverConfig.CPUHardLimit {
+		period := driverConfig.CPUCFSPeriod
+		if period == 0 {
+			period = resources.LinuxResources.CPUPeriod
+		}
+		opts.CPUPeriod = int(period)
+		opts.CPUQuota = int(resources.LinuxResources.PercentTicks*float64(period)) * runtime.NumCPU()
+	}
+
+	if err := h.client.UpdateContainer(h.containerID, opts); err != nil {
+		return fmt.Errorf("failed to update container resources: %v", err)
+	}
+	return nil
+}
+
 func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	h, ok := d.tasks.Get(taskID)
 	if !ok {
@@ -1568,6 +1614,7 @@ func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*
 }
 
 var _ drivers.ExecTaskStreamingDriver = (*Driver)(nil)
+var _ drivers.DriverResourceUpdater = (*Driver)(nil)
 
 func (d *Driver) ExecTaskStreaming(ctx context.Context, taskID string, opts *drivers.ExecOptions) (*drivers.ExitResult, error) {
 	defer opts.Stdout.Close()
@@ -663,6 +663,15 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 	return handle.exec.Signal(sig)
 }
 
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	handle, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	return handle.updateResources(resources)
+}
+
 func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	if len(cmd) == 0 {
 		return nil, fmt.Errorf("error cmd must have at least one value")
@@ -691,6 +700,7 @@ func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*
 }
 
 var _ drivers.ExecTaskStreamingRawDriver = (*Driver)(nil)
+var _ drivers.DriverResourceUpdater = (*Driver)(nil)
 
 func (d *Driver) ExecTaskStreamingRaw(ctx context.Context,
 	taskID string,
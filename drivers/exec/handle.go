@@ -2,12 +2,14 @@ package exec
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"sync"
 	"time"
 
 	hclog "github.com/hashicorp/go-hclog"
 	plugin "github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/nomad/client/lib/cgutil"
 	"github.com/hashicorp/nomad/drivers/shared/executor"
 	"github.com/hashicorp/nomad/plugins/drivers"
 )
@@ -77,3 +79,40 @@ func (h *taskHandle) run() {
 
 	// TODO: detect if the task OOMed
 }
+
+// updateResources resizes the running task by editing the memory limits and
+// cpu weight of its cgroup.
+func (h *taskHandle) updateResources(resources *drivers.Resources) error {
+	if resources == nil || resources.NomadResources == nil {
+		return errors.New("task resources must be set")
+	}
+
+	h.stateLock.Lock()
+	defer h.stateLock.Unlock()
+
+	current := h.taskConfig.Resources
+	if current == nil || current.LinuxResources == nil || current.LinuxResources.CpusetCgroupPath == "" {
+		return errors.New("task cgroup is unknown")
+	}
+
+	// Memory limits are set the same way the executor sets them when the
+	// task starts
+	memory := resources.NomadResources.Memory
+	memHard, memSoft := memory.MemoryMaxMB, memory.MemoryMB
+	if memHard <= 0 {
+		memHard = memory.MemoryMB
+		memSoft = 0
+	}
+
+	cpuShares := resources.NomadResources.Cpu.CpuShares
+	err := cgutil.UpdateTaskResources(current.LinuxResources.CpusetCgroupPath,
+		memHard*1024*1024, memSoft*1024*1024, uint64(cpuShares))
+	if err != nil {
+		return err
+	}
+
+	current.NomadResources = resources.NomadResources
+	current.LinuxResources.MemoryLimitBytes = memHard * 1024 * 1024
+	current.LinuxResources.CPUShares = cpuShares
+	return nil
+}
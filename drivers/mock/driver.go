@@ -92,6 +92,7 @@ var (
 		"stderr_string":          hclspec.NewAttr("stderr_string", "string", false),
 		"stderr_repeat":          hclspec.NewAttr("stderr_repeat", "number", false),
 		"stderr_repeat_duration": hclspec.NewAttr("stderr_repeat_duration", "string", false),
+		"update_resources_error": hclspec.NewAttr("update_resources_error", "string", false),
 
 		"exec_command": hclspec.NewBlock("exec_command", false, hclspec.NewObject(map[string]*hclspec.Spec{
 			"run_for":                hclspec.NewAttr("run_for", "string", false),
@@ -201,6 +202,10 @@ type Command struct {
 	// SignalErr is the error message that the task returns if signalled
 	SignalErr string `codec:"signal_error"`
 
+	// UpdateResourcesErr is the error message that the task returns if its
+	// resources are updated
+	UpdateResourcesErr string `codec:"update_resources_error"`
+
 	// StdoutString is the string that should be sent to stdout
 	StdoutString string `codec:"stdout_string"`
 
@@ -609,6 +614,19 @@ func (d *Driver) SignalTask(taskID string, signal string) error {
 	return errors.New(h.command.SignalErr)
 }
 
+func (d *Driver) UpdateTaskResources(taskID string, resources *drivers.Resources) error {
+	h, ok := d.tasks.Get(taskID)
+	if !ok {
+		return drivers.ErrTaskNotFound
+	}
+
+	if h.command.UpdateResourcesErr == "" {
+		return nil
+	}
+
+	return errors.New(h.command.UpdateResourcesErr)
+}
+
 func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*drivers.ExecTaskResult, error) {
 	h, ok := d.tasks.Get(taskID)
 	if !ok {
@@ -623,6 +641,7 @@ func (d *Driver) ExecTask(taskID string, cmd []string, timeout time.Duration) (*
 }
 
 var _ drivers.ExecTaskStreamingDriver = (*Driver)(nil)
+var _ drivers.DriverResourceUpdater = (*Driver)(nil)
 
 func (d *Driver) ExecTaskStreaming(ctx context.Context, taskID string, execOpts *drivers.ExecOptions) (*drivers.ExitResult, error) {
 	h, ok := d.tasks.Get(taskID)
@@ -63,6 +63,8 @@ var msgTypeNames = map[structs.MessageType]string{
 	structs.ACLAuthMethodsDeleteRequestType:              "ACLAuthMethodsDeleteRequestType",
 	structs.ACLBindingRulesUpsertRequestType:             "ACLBindingRulesUpsertRequestType",
 	structs.ACLBindingRulesDeleteRequestType:             "ACLBindingRulesDeleteRequestType",
+	structs.NodePoolUpsertRequestType:                    "NodePoolUpsertRequestType",
+	structs.NodePoolDeleteRequestType:                    "NodePoolDeleteRequestType",
 	structs.NamespaceUpsertRequestType:                   "NamespaceUpsertRequestType",
 	structs.NamespaceDeleteRequestType:                   "NamespaceDeleteRequestType",
 }
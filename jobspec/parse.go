@@ -98,10 +98,14 @@ func parseReschedulePolicy(final **api.ReschedulePolicy, list *ast.ObjectList) e
 		"delay",
 		"max_delay",
 		"delay_function",
+		"on_exit",
 	}
 	if err := checkHCLKeys(obj.Val, valid); err != nil {
 		return err
 	}
+	if err := checkExitRuleKeys(obj); err != nil {
+		return err
+	}
 
 	var m map[string]interface{}
 	if err := hcl.DecodeObject(&m, obj.Val); err != nil {
@@ -125,6 +129,27 @@ func parseReschedulePolicy(final **api.ReschedulePolicy, list *ast.ObjectList) e
 	return nil
 }
 
+// checkExitRuleKeys checks the keys of the on_exit blocks of a restart or
+// reschedule block.
+func checkExitRuleKeys(obj *ast.ObjectItem) error {
+	ot, ok := obj.Val.(*ast.ObjectType)
+	if !ok {
+		return nil
+	}
+
+	valid := []string{
+		"exit_codes",
+		"signals",
+		"action",
+	}
+	for i, o := range ot.List.Filter("on_exit").Elem().Items {
+		if err := checkHCLKeys(o.Val, valid); err != nil {
+			return multierror.Prefix(err, fmt.Sprintf("on_exit[%d] ->", i))
+		}
+	}
+	return nil
+}
+
 func parseConstraints(result *[]*api.Constraint, list *ast.ObjectList) error {
 	for _, o := range list.Elem().Items {
 		// Check for invalid keys
@@ -298,6 +323,39 @@ func parseAffinities(result *[]*api.Affinity, list *ast.ObjectList) error {
 	return nil
 }
 
+func parseAllocAffinities(result *[]*api.AllocAffinity, list *ast.ObjectList) error {
+	for _, o := range list.Elem().Items {
+		// Check for invalid keys
+		valid := []string{
+			"namespace",
+			"job_id",
+			"task_group",
+			"job_meta",
+			"anti",
+			"required",
+			"weight",
+		}
+		if err := checkHCLKeys(o.Val, valid); err != nil {
+			return err
+		}
+
+		var m map[string]interface{}
+		if err := hcl.DecodeObject(&m, o.Val); err != nil {
+			return err
+		}
+
+		// Build allocation affinity
+		var a api.AllocAffinity
+		if err := mapstructure.WeakDecode(m, &a); err != nil {
+			return err
+		}
+
+		*result = append(*result, &a)
+	}
+
+	return nil
+}
+
 func parseSpread(result *[]*api.Spread, list *ast.ObjectList) error {
 	for _, o := range list.Elem().Items {
 		// Check for invalid keys
@@ -305,6 +363,8 @@ func parseSpread(result *[]*api.Spread, list *ast.ObjectList) error {
 			"attribute",
 			"weight",
 			"target",
+			"max_skew",
+			"required",
 		}
 		if err := checkHCLKeys(o.Val, valid); err != nil {
 			return err
@@ -424,6 +484,7 @@ func parseUpdate(result **api.UpdateStrategy, list *ast.ObjectList) error {
 	valid := []string{
 		"stagger",
 		"max_parallel",
+		"max_surge",
 		"health_check",
 		"min_healthy_time",
 		"healthy_deadline",
@@ -431,11 +492,49 @@ func parseUpdate(result **api.UpdateStrategy, list *ast.ObjectList) error {
 		"auto_revert",
 		"auto_promote",
 		"canary",
+		"canary_percent",
+		"stage",
+		"analysis",
 	}
 	if err := checkHCLKeys(o.Val, valid); err != nil {
 		return err
 	}
 
+	var analysis *api.CanaryAnalysis
+	if ot, ok := o.Val.(*ast.ObjectType); ok {
+		for _, stage := range ot.List.Filter("stage").Items {
+			if err := checkHCLKeys(stage.Val, []string{"percent", "pause"}); err != nil {
+				return multierror.Prefix(err, "stage ->")
+			}
+		}
+
+		analysisList := ot.List.Filter("analysis")
+		if len(analysisList.Items) > 1 {
+			return fmt.Errorf("only one 'analysis' block allowed")
+		}
+		for _, a := range analysisList.Items {
+			valid := []string{
+				"max_memory_ratio",
+				"max_cpu_ratio",
+				"max_restarts",
+				"metrics_url",
+			}
+			if err := checkHCLKeys(a.Val, valid); err != nil {
+				return multierror.Prefix(err, "analysis ->")
+			}
+
+			var am map[string]interface{}
+			if err := hcl.DecodeObject(&am, a.Val); err != nil {
+				return err
+			}
+			analysis = &api.CanaryAnalysis{}
+			if err := mapstructure.WeakDecode(am, analysis); err != nil {
+				return err
+			}
+		}
+	}
+	delete(m, "analysis")
+
 	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
 		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
 		WeaklyTypedInput: true,
@@ -444,7 +543,14 @@ func parseUpdate(result **api.UpdateStrategy, list *ast.ObjectList) error {
 	if err != nil {
 		return err
 	}
-	return dec.Decode(m)
+	if err := dec.Decode(m); err != nil {
+		return err
+	}
+
+	if analysis != nil {
+		(*result).Analysis = analysis
+	}
+	return nil
 }
 
 func parseMigrate(result **api.MigrateStrategy, list *ast.ObjectList) error {
@@ -483,6 +589,37 @@ func parseMigrate(result **api.MigrateStrategy, list *ast.ObjectList) error {
 	return dec.Decode(m)
 }
 
+func parseScheduling(result **api.Scheduling, list *ast.ObjectList) error {
+	list = list.Elem()
+	if len(list.Items) > 1 {
+		return fmt.Errorf("only one 'scheduling' block allowed")
+	}
+
+	// Get our resource object
+	o := list.Items[0]
+
+	var m map[string]interface{}
+	if err := hcl.DecodeObject(&m, o.Val); err != nil {
+		return err
+	}
+
+	// Check for invalid keys
+	valid := []string{
+		"algorithm",
+	}
+	if err := checkHCLKeys(o.Val, valid); err != nil {
+		return err
+	}
+
+	var s api.Scheduling
+	if err := mapstructure.WeakDecode(m, &s); err != nil {
+		return err
+	}
+
+	*result = &s
+	return nil
+}
+
 func parseVault(result *api.Vault, list *ast.ObjectList) error {
 	list = list.Elem()
 	if len(list.Items) == 0 {
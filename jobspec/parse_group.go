@@ -51,6 +51,10 @@ func parseGroups(result *api.Job, list *ast.ObjectList) error {
 			"vault",
 			"migrate",
 			"spread",
+			"alloc_affinity",
+			"scheduling",
+			"gang",
+			"depends_on",
 			"shutdown_delay",
 			"network",
 			"service",
@@ -71,6 +75,8 @@ func parseGroups(result *api.Job, list *ast.ObjectList) error {
 		delete(m, "constraint")
 		delete(m, "consul")
 		delete(m, "affinity")
+		delete(m, "alloc_affinity")
+		delete(m, "depends_on")
 		delete(m, "meta")
 		delete(m, "task")
 		delete(m, "restart")
@@ -79,6 +85,7 @@ func parseGroups(result *api.Job, list *ast.ObjectList) error {
 		delete(m, "vault")
 		delete(m, "migrate")
 		delete(m, "spread")
+		delete(m, "scheduling")
 		delete(m, "network")
 		delete(m, "service")
 		delete(m, "volume")
@@ -121,6 +128,20 @@ func parseGroups(result *api.Job, list *ast.ObjectList) error {
 			}
 		}
 
+		// Parse allocation affinities
+		if o := listVal.Filter("alloc_affinity"); len(o.Items) > 0 {
+			if err := parseAllocAffinities(&g.AllocAffinities, o); err != nil {
+				return multierror.Prefix(err, fmt.Sprintf("'%s', alloc_affinity ->", n))
+			}
+		}
+
+		// Parse group dependencies
+		if o := listVal.Filter("depends_on"); len(o.Items) > 0 {
+			if err := parseGroupDependencies(&g.DependsOn, o); err != nil {
+				return multierror.Prefix(err, fmt.Sprintf("'%s', depends_on ->", n))
+			}
+		}
+
 		// Parse restart policy
 		if o := listVal.Filter("restart"); len(o.Items) > 0 {
 			if err := parseRestartPolicy(&g.RestartPolicy, o); err != nil {
@@ -135,6 +156,13 @@ func parseGroups(result *api.Job, list *ast.ObjectList) error {
 			}
 		}
 
+		// Parse scheduling
+		if o := listVal.Filter("scheduling"); len(o.Items) > 0 {
+			if err := parseScheduling(&g.Scheduling, o); err != nil {
+				return multierror.Prefix(err, fmt.Sprintf("'%s', scheduling ->", n))
+			}
+		}
+
 		// Parse network
 		if o := listVal.Filter("network"); len(o.Items) > 0 {
 			networks, err := ParseNetwork(o)
@@ -316,11 +344,17 @@ func parseRestartPolicy(final **api.RestartPolicy, list *ast.ObjectList) error {
 		"attempts",
 		"interval",
 		"delay",
+		"delay_function",
+		"max_delay",
 		"mode",
+		"on_exit",
 	}
 	if err := checkHCLKeys(obj.Val, valid); err != nil {
 		return err
 	}
+	if err := checkExitRuleKeys(obj); err != nil {
+		return err
+	}
 
 	var m map[string]interface{}
 	if err := hcl.DecodeObject(&m, obj.Val); err != nil {
@@ -441,3 +475,30 @@ func parseScalingPolicy(item *ast.ObjectItem) (*api.ScalingPolicy, error) {
 
 	return &result, nil
 }
+
+func parseGroupDependencies(result *[]*api.GroupDependency, list *ast.ObjectList) error {
+	for _, o := range list.Elem().Items {
+		// Check for invalid keys
+		valid := []string{
+			"group",
+			"condition",
+		}
+		if err := checkHCLKeys(o.Val, valid); err != nil {
+			return err
+		}
+
+		var m map[string]interface{}
+		if err := hcl.DecodeObject(&m, o.Val); err != nil {
+			return err
+		}
+
+		var d api.GroupDependency
+		if err := mapstructure.WeakDecode(m, &d); err != nil {
+			return err
+		}
+
+		*result = append(*result, &d)
+	}
+
+	return nil
+}
@@ -29,6 +29,7 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 	}
 	delete(m, "constraint")
 	delete(m, "affinity")
+	delete(m, "alloc_affinity")
 	delete(m, "meta")
 	delete(m, "migrate")
 	delete(m, "parameterized")
@@ -38,6 +39,7 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 	delete(m, "vault")
 	delete(m, "spread")
 	delete(m, "multiregion")
+	delete(m, "scheduling")
 
 	// Set the ID and name to the object key
 	result.ID = stringToPtr(obj.Keys[0].Token.Value().(string))
@@ -61,6 +63,7 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 		"all_at_once",
 		"constraint",
 		"affinity",
+		"alloc_affinity",
 		"spread",
 		"datacenters",
 		"group",
@@ -69,11 +72,13 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 		"migrate",
 		"name",
 		"namespace",
+		"node_pool",
 		"parameterized",
 		"periodic",
 		"priority",
 		"region",
 		"reschedule",
+		"scheduling",
 		"task",
 		"type",
 		"update",
@@ -100,6 +105,13 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 		}
 	}
 
+	// Parse allocation affinities
+	if o := listVal.Filter("alloc_affinity"); len(o.Items) > 0 {
+		if err := parseAllocAffinities(&result.AllocAffinities, o); err != nil {
+			return multierror.Prefix(err, "alloc_affinity ->")
+		}
+	}
+
 	// If we have an update strategy, then parse that
 	if o := listVal.Filter("update"); len(o.Items) > 0 {
 		if err := parseUpdate(&result.Update, o); err != nil {
@@ -121,6 +133,13 @@ func parseJob(result *api.Job, list *ast.ObjectList) error {
 		}
 	}
 
+	// Parse scheduling
+	if o := listVal.Filter("scheduling"); len(o.Items) > 0 {
+		if err := parseScheduling(&result.Scheduling, o); err != nil {
+			return multierror.Prefix(err, "scheduling ->")
+		}
+	}
+
 	// If we have a parameterized definition, then parse that
 	if o := listVal.Filter("parameterized"); len(o.Items) > 0 {
 		if err := parseParameterizedJob(&result.ParameterizedJob, o); err != nil {
@@ -230,8 +249,14 @@ func parsePeriodic(result **api.PeriodicConfig, list *ast.ObjectList) error {
 	valid := []string{
 		"enabled",
 		"cron",
+		"crons",
 		"prohibit_overlap",
 		"time_zone",
+		"catchup_window",
+		"jitter",
+		"concurrency_policy",
+		"successful_history_limit",
+		"failed_history_limit",
 	}
 	if err := checkHCLKeys(o.Val, valid); err != nil {
 		return err
@@ -251,9 +276,22 @@ func parsePeriodic(result **api.PeriodicConfig, list *ast.ObjectList) error {
 		m["Spec"] = cron
 	}
 
+	// If "crons" is provided, set the type to "cron" and store the specs.
+	if _, ok := m["crons"]; ok {
+		m["SpecType"] = api.PeriodicSpecCron
+	}
+
 	// Build the constraint
 	var p api.PeriodicConfig
-	if err := mapstructure.WeakDecode(m, &p); err != nil {
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           &p,
+	})
+	if err != nil {
+		return err
+	}
+	if err := dec.Decode(m); err != nil {
 		return err
 	}
 	*result = &p
@@ -35,6 +35,7 @@ var (
 		"dispatch_payload",
 		"lifecycle",
 		"leader",
+		"prestop",
 		"restart",
 		"service",
 		"template",
@@ -103,6 +104,7 @@ func parseTask(item *ast.ObjectItem, keys []string) (*api.Task, error) {
 	delete(m, "affinity")
 	delete(m, "dispatch_payload")
 	delete(m, "lifecycle")
+	delete(m, "prestop")
 	delete(m, "env")
 	delete(m, "logs")
 	delete(m, "meta")
@@ -309,6 +311,42 @@ func parseTask(item *ast.ObjectItem, keys []string) (*api.Task, error) {
 		t.Vault = v
 	}
 
+	// If we have a prestop block parse that
+	if o := listVal.Filter("prestop"); len(o.Items) > 0 {
+		if len(o.Items) > 1 {
+			return nil, fmt.Errorf("only one prestop block is allowed in a task. Number of prestop blocks found: %d", len(o.Items))
+		}
+		var m map[string]interface{}
+		prestopBlock := o.Items[0]
+
+		// Check for invalid keys
+		valid := []string{
+			"command",
+			"args",
+			"timeout",
+		}
+		if err := checkHCLKeys(prestopBlock.Val, valid); err != nil {
+			return nil, multierror.Prefix(err, "prestop ->")
+		}
+
+		if err := hcl.DecodeObject(&m, prestopBlock.Val); err != nil {
+			return nil, err
+		}
+
+		t.Prestop = &api.TaskPrestop{}
+		dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+			WeaklyTypedInput: true,
+			Result:           t.Prestop,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := dec.Decode(m); err != nil {
+			return nil, err
+		}
+	}
+
 	// If we have a dispatch_payload block parse that
 	if o := listVal.Filter("dispatch_payload"); len(o.Items) > 0 {
 		if len(o.Items) > 1 {
@@ -348,6 +386,8 @@ func parseTask(item *ast.ObjectItem, keys []string) (*api.Task, error) {
 		valid := []string{
 			"hook",
 			"sidecar",
+			"ready_checks",
+			"ready_timeout",
 		}
 		if err := checkHCLKeys(lifecycleBlock.Val, valid); err != nil {
 			return nil, multierror.Prefix(err, "lifecycle ->")
@@ -358,7 +398,15 @@ func parseTask(item *ast.ObjectItem, keys []string) (*api.Task, error) {
 		}
 
 		t.Lifecycle = &api.TaskLifecycle{}
-		if err := mapstructure.WeakDecode(m, t.Lifecycle); err != nil {
+		dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+			WeaklyTypedInput: true,
+			Result:           t.Lifecycle,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := dec.Decode(m); err != nil {
 			return nil, err
 		}
 	}
@@ -612,7 +660,9 @@ func parseResources(result *api.Resources, list *ast.ObjectList) error {
 		"memory_max",
 		"network",
 		"device",
+		"custom",
 		"cores",
+		"numa",
 	}
 	if err := checkHCLKeys(listVal, valid); err != nil {
 		return multierror.Prefix(err, "resources ->")
@@ -624,11 +674,37 @@ func parseResources(result *api.Resources, list *ast.ObjectList) error {
 	}
 	delete(m, "network")
 	delete(m, "device")
+	delete(m, "custom")
+	delete(m, "numa")
 
 	if err := mapstructure.WeakDecode(m, result); err != nil {
 		return err
 	}
 
+	// Parse the NUMA block
+	if o := listVal.Filter("numa"); len(o.Items) > 0 {
+		if len(o.Items) > 1 {
+			return fmt.Errorf("only one 'numa' block allowed per resources block")
+		}
+
+		var numaList *ast.ObjectList
+		if ot, ok := o.Items[0].Val.(*ast.ObjectType); ok {
+			numaList = ot.List
+		} else {
+			return fmt.Errorf("numa should be an object")
+		}
+
+		if err := checkHCLKeys(numaList, []string{"affinity"}); err != nil {
+			return multierror.Prefix(err, "resources, numa ->")
+		}
+
+		var numa api.NUMAResource
+		if err := hcl.DecodeObject(&numa, numaList); err != nil {
+			return err
+		}
+		result.NUMA = &numa
+	}
+
 	// Parse the network resources
 	if o := listVal.Filter("network"); len(o.Items) > 0 {
 		r, err := ParseNetwork(o)
@@ -661,6 +737,7 @@ func parseResources(result *api.Resources, list *ast.ObjectList) error {
 			valid := []string{
 				"name",
 				"count",
+				"share",
 				"affinity",
 				"constraint",
 			}
@@ -702,6 +779,35 @@ func parseResources(result *api.Resources, list *ast.ObjectList) error {
 		}
 	}
 
+	// Parse the custom resources
+	if o := listVal.Filter("custom"); len(o.Items) > 0 {
+		result.Custom = make([]*api.CustomResource, len(o.Items))
+		for idx, co := range o.Items {
+			if l := len(co.Keys); l == 0 {
+				return multierror.Prefix(fmt.Errorf("missing custom resource name"), fmt.Sprintf("resources, custom[%d]->", idx))
+			} else if l > 1 {
+				return multierror.Prefix(fmt.Errorf("only one name may be specified"), fmt.Sprintf("resources, custom[%d]->", idx))
+			}
+
+			if err := checkHCLKeys(co.Val, []string{"count"}); err != nil {
+				return multierror.Prefix(err, fmt.Sprintf("resources, custom[%d]->", idx))
+			}
+
+			var r api.CustomResource
+			r.Name = co.Keys[0].Token.Value().(string)
+
+			var m map[string]interface{}
+			if err := hcl.DecodeObject(&m, co.Val); err != nil {
+				return err
+			}
+			if err := mapstructure.WeakDecode(m, &r); err != nil {
+				return err
+			}
+
+			result.Custom[idx] = &r
+		}
+	}
+
 	return nil
 }
 
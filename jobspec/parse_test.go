@@ -38,6 +38,9 @@ func uint64ToPtr(u uint64) *uint64 {
 func int64ToPtr(i int64) *int64 {
 	return &i
 }
+func float64ToPtr(f float64) *float64 {
+	return &f
+}
 
 func TestParse(t *testing.T) {
 	ci.Parallel(t)
@@ -56,6 +59,7 @@ func TestParse(t *testing.T) {
 				Priority:    intToPtr(52),
 				AllAtOnce:   boolToPtr(true),
 				Datacenters: []string{"us2", "eu1"},
+				NodePool:    stringToPtr("dev"),
 				Region:      stringToPtr("fooregion"),
 				Namespace:   stringToPtr("foonamespace"),
 				ConsulToken: stringToPtr("abc"),
@@ -561,6 +565,37 @@ func TestParse(t *testing.T) {
 			false,
 		},
 
+		{
+			"periodic-crons.hcl",
+			&api.Job{
+				ID:   stringToPtr("foo"),
+				Name: stringToPtr("foo"),
+				Periodic: &api.PeriodicConfig{
+					SpecType:      stringToPtr(api.PeriodicSpecCron),
+					Specs:         []string{"0 2 * * 1-5", "0 6 * * 6,0"},
+					CatchupWindow: timeToPtr(2 * time.Hour),
+					Jitter:        timeToPtr(5 * time.Minute),
+				},
+			},
+			false,
+		},
+
+		{
+			"periodic-concurrency.hcl",
+			&api.Job{
+				ID:   stringToPtr("foo"),
+				Name: stringToPtr("foo"),
+				Periodic: &api.PeriodicConfig{
+					SpecType:               stringToPtr(api.PeriodicSpecCron),
+					Spec:                   stringToPtr("*/5 * * *"),
+					ConcurrencyPolicy:      stringToPtr(api.PeriodicConcurrencyReplace),
+					SuccessfulHistoryLimit: intToPtr(3),
+					FailedHistoryLimit:     intToPtr(1),
+				},
+			},
+			false,
+		},
+
 		{
 			"specify-job.hcl",
 			&api.Job{
@@ -1802,6 +1837,302 @@ func TestParse(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"scheduling.hcl",
+			&api.Job{
+				ID:   stringToPtr("scheduling"),
+				Name: stringToPtr("scheduling"),
+				Scheduling: &api.Scheduling{
+					Algorithm: "binpack",
+				},
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("api"),
+						Gang: boolToPtr(true),
+						Scheduling: &api.Scheduling{
+							Algorithm: "spread",
+						},
+						Tasks: []*api.Task{
+							{
+								Name:   "server",
+								Driver: "docker",
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"alloc-affinity.hcl",
+			&api.Job{
+				ID:   stringToPtr("alloc-affinity"),
+				Name: stringToPtr("alloc-affinity"),
+				AllocAffinities: []*api.AllocAffinity{
+					{
+						Namespace: "*",
+						JobID:     "cache",
+						Weight:    int8ToPtr(75),
+					},
+				},
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("web"),
+						AllocAffinities: []*api.AllocAffinity{
+							{
+								JobID:     "web",
+								TaskGroup: "web",
+								Anti:      true,
+								Required:  true,
+							},
+							{
+								JobMeta: map[string]string{
+									"team": "payments",
+								},
+								Anti: true,
+							},
+						},
+						Tasks: []*api.Task{
+							{
+								Name:   "server",
+								Driver: "docker",
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"group-depends-on.hcl",
+			&api.Job{
+				ID:   stringToPtr("group-depends-on"),
+				Name: stringToPtr("group-depends-on"),
+				Type: stringToPtr("batch"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("migrate"),
+						Tasks: []*api.Task{
+							{
+								Name:   "migrate",
+								Driver: "docker",
+							},
+						},
+					},
+					{
+						Name: stringToPtr("api"),
+						DependsOn: []*api.GroupDependency{
+							{
+								Group:     "migrate",
+								Condition: stringToPtr("complete"),
+							},
+							{
+								Group: "cache",
+							},
+						},
+						Tasks: []*api.Task{
+							{
+								Name:   "api",
+								Driver: "docker",
+							},
+						},
+					},
+					{
+						Name: stringToPtr("cache"),
+						Tasks: []*api.Task{
+							{
+								Name:   "redis",
+								Driver: "docker",
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"lifecycle-ready-checks.hcl",
+			&api.Job{
+				ID:   stringToPtr("lifecycle-ready-checks"),
+				Name: stringToPtr("lifecycle-ready-checks"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("web"),
+						Tasks: []*api.Task{
+							{
+								Name:   "proxy",
+								Driver: "docker",
+								Lifecycle: &api.TaskLifecycle{
+									Hook:         "prestart",
+									Sidecar:      true,
+									ReadyChecks:  true,
+									ReadyTimeout: timeToPtr(2 * time.Minute),
+								},
+							},
+							{
+								Name:   "app",
+								Driver: "docker",
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"task-prestop.hcl",
+			&api.Job{
+				ID:   stringToPtr("task-prestop"),
+				Name: stringToPtr("task-prestop"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("web"),
+						Tasks: []*api.Task{
+							{
+								Name:   "nginx",
+								Driver: "docker",
+								Prestop: &api.TaskPrestop{
+									Command: "nginx",
+									Args:    []string{"-s", "quit"},
+									Timeout: timeToPtr(15 * time.Second),
+								},
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"update-stages.hcl",
+			&api.Job{
+				ID:   stringToPtr("update-stages"),
+				Name: stringToPtr("update-stages"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("web"),
+						Update: &api.UpdateStrategy{
+							Canary:      intToPtr(1),
+							AutoPromote: boolToPtr(true),
+							Stages: []*api.UpdateStage{
+								{Percent: 10, Pause: 5 * time.Minute},
+								{Percent: 50, Pause: 10 * time.Minute},
+								{Percent: 100},
+							},
+						},
+						Tasks: []*api.Task{
+							{
+								Name:   "app",
+								Driver: "docker",
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"update-analysis.hcl",
+			&api.Job{
+				ID:   stringToPtr("update-analysis"),
+				Name: stringToPtr("update-analysis"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("web"),
+						Update: &api.UpdateStrategy{
+							Canary:      intToPtr(1),
+							AutoPromote: boolToPtr(true),
+							Analysis: &api.CanaryAnalysis{
+								MaxMemoryRatio: float64ToPtr(1.5),
+								MaxCPURatio:    float64ToPtr(2),
+								MaxRestarts:    intToPtr(1),
+								MetricsURL:     stringToPtr("https://metrics.example.com/canary"),
+							},
+						},
+						Tasks: []*api.Task{
+							{
+								Name:   "app",
+								Driver: "docker",
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"update-canary-percent.hcl",
+			&api.Job{
+				ID:   stringToPtr("update-canary-percent"),
+				Name: stringToPtr("update-canary-percent"),
+				Type: stringToPtr("system"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("agent"),
+						Update: &api.UpdateStrategy{
+							MaxParallel:   intToPtr(2),
+							CanaryPercent: intToPtr(10),
+							AutoRevert:    boolToPtr(true),
+						},
+						Tasks: []*api.Task{
+							{
+								Name:   "agent",
+								Driver: "exec",
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"update-max-surge.hcl",
+			&api.Job{
+				ID:   stringToPtr("update-max-surge"),
+				Name: stringToPtr("update-max-surge"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("web"),
+						Update: &api.UpdateStrategy{
+							MaxSurge: intToPtr(2),
+						},
+						Tasks: []*api.Task{
+							{
+								Name:   "app",
+								Driver: "docker",
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"spread-max-skew.hcl",
+			&api.Job{
+				ID:   stringToPtr("spread-max-skew"),
+				Name: stringToPtr("spread-max-skew"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("web"),
+						Spreads: []*api.Spread{
+							{
+								Attribute: "${meta.rack}",
+								MaxSkew:   1,
+								Required:  true,
+							},
+						},
+						Tasks: []*api.Task{
+							{
+								Name:   "server",
+								Driver: "docker",
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
 		{
 			"resources-cores.hcl",
 			&api.Job{
@@ -1818,6 +2149,9 @@ func TestParse(t *testing.T) {
 								Resources: &api.Resources{
 									Cores:    intToPtr(4),
 									MemoryMB: intToPtr(128),
+									NUMA: &api.NUMAResource{
+										Affinity: "require",
+									},
 								},
 							},
 						},
@@ -1826,6 +2160,104 @@ func TestParse(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"resources-custom.hcl",
+			&api.Job{
+				ID:   stringToPtr("custom-test"),
+				Name: stringToPtr("custom-test"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("group"),
+						Tasks: []*api.Task{
+							{
+								Name:   "task",
+								Driver: "docker",
+								Resources: &api.Resources{
+									MemoryMB: intToPtr(128),
+									Custom: []*api.CustomResource{
+										{
+											Name:  "license_seat",
+											Count: uint64ToPtr(2),
+										},
+										{
+											Name: "build_slot",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"on-exit.hcl",
+			&api.Job{
+				ID:   stringToPtr("on-exit"),
+				Name: stringToPtr("on-exit"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("group"),
+						RestartPolicy: &api.RestartPolicy{
+							Attempts: intToPtr(3),
+							Mode:     stringToPtr("fail"),
+							OnExit: []*api.ExitRule{
+								{
+									ExitCodes: []int{2, 3},
+									Action:    "fail",
+								},
+								{
+									Signals: []string{"SIGTERM"},
+									Action:  "success",
+								},
+							},
+						},
+						ReschedulePolicy: &api.ReschedulePolicy{
+							Attempts: intToPtr(1),
+							OnExit: []*api.ExitRule{
+								{
+									ExitCodes: []int{2},
+									Action:    "fail",
+								},
+							},
+						},
+						Tasks: []*api.Task{
+							{
+								Name:   "task",
+								Driver: "docker",
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"restart-backoff.hcl",
+			&api.Job{
+				ID:   stringToPtr("restart-backoff"),
+				Name: stringToPtr("restart-backoff"),
+				TaskGroups: []*api.TaskGroup{
+					{
+						Name: stringToPtr("group"),
+						RestartPolicy: &api.RestartPolicy{
+							Attempts:      intToPtr(5),
+							Delay:         timeToPtr(5 * time.Second),
+							DelayFunction: stringToPtr("exponential"),
+							MaxDelay:      timeToPtr(2 * time.Minute),
+						},
+						Tasks: []*api.Task{
+							{
+								Name:   "task",
+								Driver: "docker",
+							},
+						},
+					},
+				},
+			},
+			false,
+		},
 		{
 			"service-provider.hcl",
 			&api.Job{
@@ -0,0 +1,27 @@
+job "alloc-affinity" {
+  alloc_affinity {
+    namespace = "*"
+    job_id    = "cache"
+    weight    = 75
+  }
+
+  group "web" {
+    alloc_affinity {
+      job_id     = "web"
+      task_group = "web"
+      anti       = true
+      required   = true
+    }
+
+    alloc_affinity {
+      job_meta = {
+        team = "payments"
+      }
+      anti = true
+    }
+
+    task "server" {
+      driver = "docker"
+    }
+  }
+}
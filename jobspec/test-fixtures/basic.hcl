@@ -5,6 +5,7 @@ job "binstore-storagelocker" {
   priority     = 52
   all_at_once  = true
   datacenters  = ["us2", "eu1"]
+  node_pool    = "dev"
   consul_token = "abc"
   vault_token  = "foo"
 
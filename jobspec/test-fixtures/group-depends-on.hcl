@@ -0,0 +1,30 @@
+job "group-depends-on" {
+  type = "batch"
+
+  group "migrate" {
+    task "migrate" {
+      driver = "docker"
+    }
+  }
+
+  group "api" {
+    depends_on {
+      group     = "migrate"
+      condition = "complete"
+    }
+
+    depends_on {
+      group = "cache"
+    }
+
+    task "api" {
+      driver = "docker"
+    }
+  }
+
+  group "cache" {
+    task "redis" {
+      driver = "docker"
+    }
+  }
+}
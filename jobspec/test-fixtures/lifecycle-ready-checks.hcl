@@ -0,0 +1,18 @@
+job "lifecycle-ready-checks" {
+  group "web" {
+    task "proxy" {
+      driver = "docker"
+
+      lifecycle {
+        hook          = "prestart"
+        sidecar       = true
+        ready_checks  = true
+        ready_timeout = "2m"
+      }
+    }
+
+    task "app" {
+      driver = "docker"
+    }
+  }
+}
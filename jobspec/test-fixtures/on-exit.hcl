@@ -0,0 +1,31 @@
+job "on-exit" {
+  group "group" {
+    restart {
+      attempts = 3
+      mode     = "fail"
+
+      on_exit {
+        exit_codes = [2, 3]
+        action     = "fail"
+      }
+
+      on_exit {
+        signals = ["SIGTERM"]
+        action  = "success"
+      }
+    }
+
+    reschedule {
+      attempts = 1
+
+      on_exit {
+        exit_codes = [2]
+        action     = "fail"
+      }
+    }
+
+    task "task" {
+      driver = "docker"
+    }
+  }
+}
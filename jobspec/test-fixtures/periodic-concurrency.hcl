@@ -0,0 +1,8 @@
+job "foo" {
+  periodic {
+    cron                     = "*/5 * * *"
+    concurrency_policy       = "replace"
+    successful_history_limit = 3
+    failed_history_limit     = 1
+  }
+}
@@ -0,0 +1,7 @@
+job "foo" {
+  periodic {
+    crons          = ["0 2 * * 1-5", "0 6 * * 6,0"]
+    catchup_window = "2h"
+    jitter         = "5m"
+  }
+}
@@ -8,6 +8,10 @@ job "cores-test" {
       resources {
         cores  = 4
         memory = 128
+
+        numa {
+          affinity = "require"
+        }
       }
     }
   }
@@ -0,0 +1,17 @@
+job "custom-test" {
+  group "group" {
+    task "task" {
+      driver = "docker"
+
+      resources {
+        memory = 128
+
+        custom "license_seat" {
+          count = 2
+        }
+
+        custom "build_slot" {}
+      }
+    }
+  }
+}
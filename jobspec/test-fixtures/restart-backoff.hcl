@@ -0,0 +1,14 @@
+job "restart-backoff" {
+  group "group" {
+    restart {
+      attempts       = 5
+      delay          = "5s"
+      delay_function = "exponential"
+      max_delay      = "2m"
+    }
+
+    task "task" {
+      driver = "docker"
+    }
+  }
+}
@@ -0,0 +1,17 @@
+job "scheduling" {
+  scheduling {
+    algorithm = "binpack"
+  }
+
+  group "api" {
+    gang = true
+
+    scheduling {
+      algorithm = "spread"
+    }
+
+    task "server" {
+      driver = "docker"
+    }
+  }
+}
@@ -0,0 +1,13 @@
+job "spread-max-skew" {
+  group "web" {
+    spread {
+      attribute = "${meta.rack}"
+      max_skew  = 1
+      required  = true
+    }
+
+    task "server" {
+      driver = "docker"
+    }
+  }
+}
@@ -0,0 +1,13 @@
+job "task-prestop" {
+  group "web" {
+    task "nginx" {
+      driver = "docker"
+
+      prestop {
+        command = "nginx"
+        args    = ["-s", "quit"]
+        timeout = "15s"
+      }
+    }
+  }
+}
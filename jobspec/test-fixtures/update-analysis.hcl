@@ -0,0 +1,19 @@
+job "update-analysis" {
+  group "web" {
+    update {
+      canary       = 1
+      auto_promote = true
+
+      analysis {
+        max_memory_ratio = 1.5
+        max_cpu_ratio    = 2
+        max_restarts     = 1
+        metrics_url      = "https://metrics.example.com/canary"
+      }
+    }
+
+    task "app" {
+      driver = "docker"
+    }
+  }
+}
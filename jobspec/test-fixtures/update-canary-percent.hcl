@@ -0,0 +1,15 @@
+job "update-canary-percent" {
+  type = "system"
+
+  group "agent" {
+    update {
+      max_parallel   = 2
+      canary_percent = 10
+      auto_revert    = true
+    }
+
+    task "agent" {
+      driver = "exec"
+    }
+  }
+}
@@ -0,0 +1,11 @@
+job "update-max-surge" {
+  group "web" {
+    update {
+      max_surge = 2
+    }
+
+    task "app" {
+      driver = "docker"
+    }
+  }
+}
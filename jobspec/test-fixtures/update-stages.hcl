@@ -0,0 +1,26 @@
+job "update-stages" {
+  group "web" {
+    update {
+      canary       = 1
+      auto_promote = true
+
+      stage {
+        percent = 10
+        pause   = "5m"
+      }
+
+      stage {
+        percent = 50
+        pause   = "10m"
+      }
+
+      stage {
+        percent = 100
+      }
+    }
+
+    task "app" {
+      driver = "docker"
+    }
+  }
+}
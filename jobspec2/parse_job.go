@@ -16,7 +16,7 @@ func normalizeJob(jc *jobConfig) {
 		j.ID = &jc.JobID
 	}
 
-	if j.Periodic != nil && j.Periodic.Spec != nil {
+	if j.Periodic != nil && (j.Periodic.Spec != nil || len(j.Periodic.Specs) != 0) {
 		v := "cron"
 		j.Periodic.SpecType = &v
 	}
@@ -221,6 +221,11 @@ type Config struct {
 	// rekey any variables associated with a key in the Rekeying state
 	VariablesRekeyInterval time.Duration
 
+	// RebalanceInterval is how often we dispatch a job to migrate
+	// allocations that reduce cluster fragmentation, if rebalancing is
+	// enabled in the scheduler configuration.
+	RebalanceInterval time.Duration
+
 	// EvalNackTimeout controls how long we allow a sub-scheduler to
 	// work on an evaluation before we consider it failed and Nack it.
 	// This allows that evaluation to be handed to another sub-scheduler
@@ -399,6 +404,11 @@ type Config struct {
 	// DeploymentQueryRateLimit is in queries per second and is used by the
 	// DeploymentWatcher to throttle the amount of simultaneously deployments
 	DeploymentQueryRateLimit float64
+
+	// CanaryMetricsAllowlist is the list of URL prefixes the canary analysis
+	// of deployments is allowed to query metrics from. Metrics queries are
+	// rejected when it is empty.
+	CanaryMetricsAllowlist []string
 }
 
 func (c *Config) Copy() *Config {
@@ -420,6 +430,7 @@ func (c *Config) Copy() *Config {
 	nc.RaftConfig = pointer.Copy(c.RaftConfig)
 	nc.SerfConfig = pointer.Copy(c.SerfConfig)
 	nc.EnabledSchedulers = slices.Clone(c.EnabledSchedulers)
+	nc.CanaryMetricsAllowlist = slices.Clone(c.CanaryMetricsAllowlist)
 	nc.ConsulConfig = c.ConsulConfig.Copy()
 	nc.VaultConfig = c.VaultConfig.Copy()
 	nc.TLSConfig = c.TLSConfig.Copy()
@@ -471,6 +482,7 @@ func DefaultConfig() *Config {
 		RootKeyGCThreshold:               1 * time.Hour,
 		RootKeyRotationThreshold:         720 * time.Hour, // 30 days
 		VariablesRekeyInterval:           10 * time.Minute,
+		RebalanceInterval:                5 * time.Minute,
 		EvalNackTimeout:                  60 * time.Second,
 		EvalDeliveryLimit:                3,
 		EvalNackInitialReenqueueDelay:    1 * time.Second,
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 	"time"
 
@@ -63,6 +64,8 @@ func (c *CoreScheduler) Process(eval *structs.Evaluation) error {
 		return c.rootKeyRotateOrGC(eval)
 	case structs.CoreJobVariablesRekey:
 		return c.variablesRekey(eval)
+	case structs.CoreJobRebalance:
+		return c.rebalance(eval)
 	case structs.CoreJobForceGC:
 		return c.forceGC(eval)
 	default:
@@ -116,16 +119,31 @@ func (c *CoreScheduler) jobGC(eval *structs.Evaluation) error {
 	oldThreshold := c.getThreshold(eval, "job",
 		"job_gc_threshold", c.srv.config.JobGCThreshold)
 
+	var jobs []*structs.Job
+	for i := iter.Next(); i != nil; i = iter.Next() {
+		jobs = append(jobs, i.(*structs.Job))
+	}
+
+	// Children of periodic jobs with history limits are kept or collected
+	// based on the limits rather than the threshold.
+	history := c.periodicHistory(jobs)
+
 	// Collect the allocations, evaluations and jobs to GC
 	var gcAlloc, gcEval []string
 	var gcJob []*structs.Job
 
 OUTER:
-	for i := iter.Next(); i != nil; i = iter.Next() {
-		job := i.(*structs.Job)
+	for _, job := range jobs {
+		threshold := oldThreshold
+		if keep, ok := history[job.NamespacedID()]; ok {
+			if keep {
+				continue
+			}
+			threshold = math.MaxUint64
+		}
 
 		// Ignore new jobs.
-		if job.CreateIndex > oldThreshold {
+		if job.CreateIndex > threshold {
 			continue
 		}
 
@@ -139,7 +157,7 @@ OUTER:
 		allEvalsGC := true
 		var jobAlloc, jobEval []string
 		for _, eval := range evals {
-			gc, allocs, err := c.gcEval(eval, oldThreshold, true)
+			gc, allocs, err := c.gcEval(eval, threshold, true)
 			if err != nil {
 				continue OUTER
 			} else if gc {
@@ -177,6 +195,89 @@ OUTER:
 	return c.jobReap(gcJob, eval.LeaderACL)
 }
 
+// periodicHistory returns whether the children of periodic jobs with history
+// limits among the passed jobs should be kept. Only the latest successful and
+// failed children up to the limits are kept, the others are collected
+// regardless of the job GC threshold. Jobs without limits are not returned.
+func (c *CoreScheduler) periodicHistory(jobs []*structs.Job) map[structs.NamespacedID]bool {
+	type historyKey struct {
+		parent structs.NamespacedID
+		failed bool
+	}
+
+	ws := memdb.NewWatchSet()
+	parents := make(map[structs.NamespacedID]*structs.Job)
+	children := make(map[historyKey][]*structs.Job)
+	for _, job := range jobs {
+		if job.ParentID == "" {
+			continue
+		}
+
+		parentID := structs.NewNamespacedID(job.ParentID, job.Namespace)
+		parent, ok := parents[parentID]
+		if !ok {
+			var err error
+			parent, err = c.snap.JobByID(ws, job.Namespace, job.ParentID)
+			if err != nil {
+				c.logger.Error("job GC failed to get parent of job", "job", job.ID, "error", err)
+				continue
+			}
+			parents[parentID] = parent
+		}
+		if parent == nil || !parent.IsPeriodic() {
+			continue
+		}
+
+		failed, err := c.periodicChildFailed(job)
+		if err != nil {
+			c.logger.Error("job GC failed to get allocs for job", "job", job.ID, "error", err)
+			continue
+		}
+		if parent.Periodic.HistoryLimit(failed) == 0 {
+			continue
+		}
+
+		key := historyKey{parent: parentID, failed: failed}
+		children[key] = append(children[key], job)
+	}
+
+	history := make(map[structs.NamespacedID]bool)
+	for key, jobs := range children {
+		limit := parents[key.parent].Periodic.HistoryLimit(key.failed)
+
+		// Keep the most recently launched children
+		sort.Slice(jobs, func(i, j int) bool {
+			return jobs[i].CreateIndex > jobs[j].CreateIndex
+		})
+		for i, job := range jobs {
+			history[job.NamespacedID()] = i < limit
+		}
+	}
+	return history
+}
+
+// periodicChildFailed returns whether the child of a periodic job failed,
+// which is the case if any of its allocations failed or was lost without
+// being replaced.
+func (c *CoreScheduler) periodicChildFailed(job *structs.Job) (bool, error) {
+	ws := memdb.NewWatchSet()
+	allocs, err := c.snap.AllocsByJob(ws, job.Namespace, job.ID, true)
+	if err != nil {
+		return false, err
+	}
+
+	for _, alloc := range allocs {
+		if alloc.NextAllocation != "" {
+			continue
+		}
+		switch alloc.ClientStatus {
+		case structs.AllocClientStatusFailed, structs.AllocClientStatusLost:
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // jobReap contacts the leader and issues a reap on the passed jobs
 func (c *CoreScheduler) jobReap(jobs []*structs.Job, leaderACL string) error {
 	// Call to the leader to issue the reap
@@ -1145,3 +1246,30 @@ func (c *CoreScheduler) getThreshold(eval *structs.Evaluation, objectName, confi
 	}
 	return oldThreshold
 }
+
+// rebalance migrates allocations to reduce cluster fragmentation when
+// rebalancing is enabled in the scheduler configuration.
+func (c *CoreScheduler) rebalance(eval *structs.Evaluation) error {
+	_, schedConfig, err := c.snap.SchedulerConfig()
+	if err != nil {
+		return err
+	}
+	if schedConfig == nil || !schedConfig.RebalanceConfig.Enabled {
+		return nil
+	}
+
+	r := newRebalancer(c.snap, schedConfig, 0, c.logger)
+	migrations, err := r.compute()
+	if err != nil {
+		return err
+	}
+	if len(migrations) == 0 {
+		return nil
+	}
+	c.logger.Debug("rebalance migrating allocations", "allocs", len(migrations))
+
+	req := r.transitionRequest()
+	req.Region = c.srv.config.Region
+	req.AuthToken = eval.LeaderACL
+	return c.srv.RPC("Alloc.UpdateDesiredTransition", req, &structs.GenericResponse{})
+}
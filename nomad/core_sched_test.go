@@ -1738,6 +1738,80 @@ func TestCoreScheduler_JobGC_Periodic(t *testing.T) {
 	}
 }
 
+func TestCoreScheduler_JobGC_PeriodicHistory(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// COMPAT Remove in 0.6: Reset the FSM time table since we reconcile which sets index 0
+	s1.fsm.timetable.table = make([]TimeTableEntry, 1, 10)
+
+	// Insert a periodic job with history limits.
+	store := s1.fsm.State()
+	job := mock.PeriodicJob()
+	job.Periodic.SuccessfulHistoryLimit = 2
+	job.Periodic.FailedHistoryLimit = 1
+	require.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1000, job))
+
+	// Insert dead children, none of which are older than the GC threshold.
+	index := uint64(1000)
+	launchChild := func(status string) *structs.Job {
+		child := mock.Job()
+		child.Type = structs.JobTypeBatch
+		child.ParentID = job.ID
+		index++
+		require.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, index, child))
+
+		eval := mock.Eval()
+		eval.JobID = child.ID
+		eval.Status = structs.EvalStatusComplete
+		index++
+		require.NoError(t, store.UpsertEvals(structs.MsgTypeTestSetup, index, []*structs.Evaluation{eval}))
+
+		alloc := mock.Alloc()
+		alloc.Job = child
+		alloc.JobID = child.ID
+		alloc.EvalID = eval.ID
+		alloc.DesiredStatus = structs.AllocDesiredStatusRun
+		alloc.ClientStatus = status
+		index++
+		require.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, index, []*structs.Allocation{alloc}))
+		return child
+	}
+
+	var succeeded, failed []*structs.Job
+	for i := 0; i < 4; i++ {
+		succeeded = append(succeeded, launchChild(structs.AllocClientStatusComplete))
+		failed = append(failed, launchChild(structs.AllocClientStatusFailed))
+	}
+
+	// Create a core scheduler
+	snap, err := store.Snapshot()
+	require.NoError(t, err)
+	core := NewCoreScheduler(s1, snap)
+
+	// Attempt the GC
+	gc := s1.coreJobEval(structs.CoreJobJobGC, index+1)
+	require.NoError(t, core.Process(gc))
+
+	// Only the latest children up to the limits should still exist
+	ws := memdb.NewWatchSet()
+	exists := func(child *structs.Job) bool {
+		out, err := store.JobByID(ws, child.Namespace, child.ID)
+		require.NoError(t, err)
+		return out != nil
+	}
+	for i, child := range succeeded {
+		require.Equal(t, i >= 2, exists(child), "successful child %d", i)
+	}
+	for i, child := range failed {
+		require.Equal(t, i >= 3, exists(child), "failed child %d", i)
+	}
+	require.True(t, exists(job))
+}
+
 func TestCoreScheduler_DeploymentGC(t *testing.T) {
 	ci.Parallel(t)
 
@@ -2846,3 +2920,51 @@ func TestCoreScheduler_ExpiredACLTokenGC_Force(t *testing.T) {
 	tokens = fromIteratorFunc(iter)
 	require.ElementsMatch(t, append(nonExpiredGlobalTokens, nonExpiredLocalTokens...), tokens)
 }
+
+func TestCoreScheduler_Rebalance(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, nil)
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	store := s1.fsm.State()
+	full, light := mock.Node(), mock.Node()
+	require.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1000, full))
+	require.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1001, light))
+
+	job := mock.Job()
+	require.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1002, job))
+
+	fullAlloc := rebalanceTestAlloc(job, full, 2000, 4096)
+	lightAlloc := rebalanceTestAlloc(job, light, 250, 512)
+	require.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, 1003,
+		[]*structs.Allocation{fullAlloc, lightAlloc}))
+
+	rebalance := func() *structs.Allocation {
+		snap, err := store.Snapshot()
+		require.NoError(t, err)
+		core := NewCoreScheduler(s1, snap)
+		require.NoError(t, core.Process(s1.coreJobEval(structs.CoreJobRebalance, 2000)))
+
+		alloc, err := store.AllocByID(nil, lightAlloc.ID)
+		require.NoError(t, err)
+		return alloc
+	}
+
+	// Rebalancing is disabled by default.
+	require.False(t, rebalance().DesiredTransition.ShouldMigrate())
+
+	_, config, err := store.SchedulerConfig()
+	require.NoError(t, err)
+	config = config.Copy()
+	config.RebalanceConfig.Enabled = true
+	require.NoError(t, store.SchedulerSetConfig(1004, config))
+
+	require.True(t, rebalance().DesiredTransition.ShouldMigrate())
+
+	evals, err := store.EvalsByJob(nil, job.Namespace, job.ID)
+	require.NoError(t, err)
+	require.Len(t, evals, 1)
+	require.Equal(t, structs.EvalTriggerRebalance, evals[0].TriggeredBy)
+}
@@ -1,6 +1,7 @@
 package nomad
 
 import (
+	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
@@ -54,3 +55,24 @@ func (d *deploymentWatcherRaftShim) UpdateAllocDesiredTransition(req *structs.Al
 	fsmErrIntf, index, raftErr := d.apply(structs.AllocUpdateDesiredTransitionRequestType, req)
 	return d.convertApplyErrors(fsmErrIntf, index, raftErr)
 }
+
+// deploymentWatcherStatsShim provides the deployment watcher with the resource
+// usage of allocations, retrieved from the clients running them.
+type deploymentWatcherStatsShim struct {
+	srv *Server
+}
+
+func (d *deploymentWatcherStatsShim) AllocStats(allocID string) (*cstructs.AllocResourceUsage, error) {
+	args := &cstructs.AllocStatsRequest{
+		AllocID: allocID,
+		QueryOptions: structs.QueryOptions{
+			Region:    d.srv.config.Region,
+			AuthToken: d.srv.getLeaderAcl(),
+		},
+	}
+	var reply cstructs.AllocStatsResponse
+	if err := d.srv.RPC("ClientAllocations.Stats", args, &reply); err != nil {
+		return nil, err
+	}
+	return reply.Stats, nil
+}
@@ -0,0 +1,376 @@
+package deploymentwatcher
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// metricsQueryTimeout is the timeout of the canary analysis metrics
+	// query.
+	metricsQueryTimeout = 10 * time.Second
+)
+
+// canaryAnalysisResult is the result of the canary analysis of a group.
+type canaryAnalysisResult struct {
+	group string
+
+	// reason is why the canaries failed the analysis or empty if they
+	// passed it
+	reason string
+
+	// err is set if the analysis couldn't be completed
+	err error
+}
+
+// startCanaryAnalyses starts the canary analysis of the groups whose canaries
+// are all healthy and have not passed or started it yet. The analyses query
+// the clients and the metrics endpoint, so they run in the background and
+// report their results to analysisCh.
+func (w *deploymentWatcher) startCanaryAnalyses(allocs []*structs.AllocListStub) {
+	d := w.getDeployment()
+	if !d.RequiresPromotion() {
+		return
+	}
+
+	for name, dstate := range d.TaskGroups {
+		if dstate.DesiredCanaries == 0 || dstate.Promoted {
+			continue
+		}
+
+		tg := w.j.LookupTaskGroup(name)
+		if tg == nil || tg.Update == nil || tg.Update.Analysis == nil {
+			continue
+		}
+
+		if healthyCanaries(dstate, allocs) < dstate.DesiredCanaries {
+			continue
+		}
+
+		w.l.Lock()
+		if w.analyzed[name] || w.analyzing[name] {
+			w.l.Unlock()
+			continue
+		}
+		w.analyzing[name] = true
+		w.l.Unlock()
+
+		go w.runCanaryAnalysis(d, name, dstate, tg.Update.Analysis)
+	}
+}
+
+// runCanaryAnalysis runs the canary analysis of the group and sends its
+// result to analysisCh.
+func (w *deploymentWatcher) runCanaryAnalysis(d *structs.Deployment, group string,
+	dstate *structs.DeploymentState, analysis *structs.CanaryAnalysis) {
+
+	reason, err := w.compareCanaries(d, group, dstate, analysis)
+	select {
+	case w.analysisCh <- &canaryAnalysisResult{group: group, reason: reason, err: err}:
+	case <-w.ctx.Done():
+	}
+}
+
+// handleCanaryAnalysis records the result of a canary analysis. It returns the
+// reason the deployment failed the analysis, if any, and whether the
+// deployment should be rolled back. Analyses that couldn't be completed are
+// retried on the next allocation update.
+func (w *deploymentWatcher) handleCanaryAnalysis(res *canaryAnalysisResult) (string, bool) {
+	w.l.Lock()
+	delete(w.analyzing, res.group)
+	w.l.Unlock()
+
+	if res.err != nil {
+		w.logger.Error("failed to analyze canaries", "task_group", res.group, "error", res.err)
+		return "", false
+	}
+
+	// The group may have been promoted while the analysis was running
+	dstate := w.getDeployment().TaskGroups[res.group]
+	if dstate == nil || dstate.Promoted {
+		return "", false
+	}
+
+	if res.reason != "" {
+		w.logger.Info("canary analysis failed", "task_group", res.group, "reason", res.reason)
+		return fmt.Sprintf("task group %q %s", res.group, res.reason), dstate.AutoRevert
+	}
+
+	w.logger.Debug("canary analysis passed", "task_group", res.group)
+	w.l.Lock()
+	w.analyzed[res.group] = true
+	w.l.Unlock()
+	return "", false
+}
+
+// analysisPassed returns whether the canaries of the group passed the canary
+// analysis.
+func (w *deploymentWatcher) analysisPassed(group string) bool {
+	w.l.RLock()
+	defer w.l.RUnlock()
+	return w.analyzed[group]
+}
+
+// analysisBlocksPromotion returns an error if the promotion request includes a
+// group with a canary analysis that hasn't passed. A failed analysis fails the
+// deployment, so this rejects promotions while the analysis is pending or
+// running.
+func (w *deploymentWatcher) analysisBlocksPromotion(req *structs.DeploymentPromoteRequest) error {
+	d := w.getDeployment()
+	for name, dstate := range d.TaskGroups {
+		if dstate.DesiredCanaries == 0 || dstate.Promoted {
+			continue
+		}
+		if !req.All && !slices.Contains(req.Groups, name) {
+			continue
+		}
+
+		tg := w.j.LookupTaskGroup(name)
+		if tg == nil || tg.Update == nil || tg.Update.Analysis == nil {
+			continue
+		}
+		if !w.analysisPassed(name) {
+			return fmt.Errorf("canaries of task group %q have not passed the canary analysis", name)
+		}
+	}
+	return nil
+}
+
+// healthyCanaries returns the number of placed canaries of the group that are
+// healthy.
+func healthyCanaries(dstate *structs.DeploymentState, allocs []*structs.AllocListStub) int {
+	healthy := 0
+	for _, c := range dstate.PlacedCanaries {
+		for _, a := range allocs {
+			if c == a.ID && a.DeploymentStatus.IsHealthy() {
+				healthy++
+			}
+		}
+	}
+	return healthy
+}
+
+// compareCanaries compares the running canaries of the group with its
+// running allocations that are not part of the deployment. It returns why the
+// canaries failed the analysis or an empty string if they passed.
+func (w *deploymentWatcher) compareCanaries(d *structs.Deployment, group string,
+	dstate *structs.DeploymentState, analysis *structs.CanaryAnalysis) (string, error) {
+
+	snap, err := w.state.Snapshot()
+	if err != nil {
+		return "", err
+	}
+
+	allocs, err := snap.AllocsByJob(nil, d.Namespace, d.JobID, false)
+	if err != nil {
+		return "", err
+	}
+
+	placed := make(map[string]struct{}, len(dstate.PlacedCanaries))
+	for _, id := range dstate.PlacedCanaries {
+		placed[id] = struct{}{}
+	}
+
+	var canaries, stable []*structs.Allocation
+	for _, alloc := range allocs {
+		if alloc.TaskGroup != group || alloc.ClientStatus != structs.AllocClientStatusRunning {
+			continue
+		}
+		if _, ok := placed[alloc.ID]; ok {
+			canaries = append(canaries, alloc)
+		} else if alloc.DeploymentID != d.ID {
+			stable = append(stable, alloc)
+		}
+	}
+
+	// Without stable allocations there is nothing to compare the canaries
+	// with, but the metrics query is still made.
+	if len(canaries) > 0 && len(stable) > 0 {
+		// Restarts are counted over the same window for both sets, since the
+		// stable allocations have usually been running much longer.
+		since := canariesStartTime(canaries)
+		canaryRestarts, stableRestarts := averageRestartsSince(canaries, since), averageRestartsSince(stable, since)
+		if canaryRestarts > stableRestarts+float64(analysis.MaxRestarts) {
+			return fmt.Sprintf("canaries restarted %.1f times on average, more than %.1f for stable allocations",
+				canaryRestarts, stableRestarts), nil
+		}
+
+		if analysis.MaxMemoryRatio > 0 || analysis.MaxCPURatio > 0 {
+			canaryUsage := w.averageUsage(canaries)
+			stableUsage := w.averageUsage(stable)
+
+			// The ratios can't be checked without the usage of both sides,
+			// so unknown usage fails the analysis rather than passing it.
+			if analysis.MaxMemoryRatio > 0 {
+				if !canaryUsage.memoryKnown || !stableUsage.memoryKnown || stableUsage.memory <= 0 {
+					return "memory usage of canaries or stable allocations is unknown", nil
+				}
+				if ratio := canaryUsage.memory / stableUsage.memory; ratio > analysis.MaxMemoryRatio {
+					return fmt.Sprintf("canaries use %.2fx the memory of stable allocations, above %.2f",
+						ratio, analysis.MaxMemoryRatio), nil
+				}
+			}
+			if analysis.MaxCPURatio > 0 {
+				if !canaryUsage.cpuKnown || !stableUsage.cpuKnown || stableUsage.cpu <= 0 {
+					return "CPU usage of canaries or stable allocations is unknown", nil
+				}
+				if ratio := canaryUsage.cpu / stableUsage.cpu; ratio > analysis.MaxCPURatio {
+					return fmt.Sprintf("canaries use %.2fx the CPU of stable allocations, above %.2f",
+						ratio, analysis.MaxCPURatio), nil
+				}
+			}
+		}
+	}
+
+	if analysis.MetricsURL != "" {
+		return w.queryCanaryMetrics(analysis.MetricsURL, d, group)
+	}
+
+	return "", nil
+}
+
+// canariesStartTime returns when the first of the canaries was created.
+func canariesStartTime(canaries []*structs.Allocation) time.Time {
+	var start int64
+	for _, c := range canaries {
+		if start == 0 || c.CreateTime < start {
+			start = c.CreateTime
+		}
+	}
+	return time.Unix(0, start)
+}
+
+// averageRestartsSince returns the average number of task restarts of the
+// allocations since the given time, counted from their task events. Only the
+// most recent task events are kept, so restarts beyond them are not counted.
+func averageRestartsSince(allocs []*structs.Allocation, since time.Time) float64 {
+	restarts := 0
+	for _, alloc := range allocs {
+		for _, ts := range alloc.TaskStates {
+			for _, e := range ts.Events {
+				if e.Type == structs.TaskRestarting && e.Time >= since.UnixNano() {
+					restarts++
+				}
+			}
+		}
+	}
+	return float64(restarts) / float64(len(allocs))
+}
+
+// averageResourceUsage is the average resource usage of a set of allocations.
+// Each dimension is only known if at least one allocation reported it.
+type averageResourceUsage struct {
+	memory      float64
+	memoryKnown bool
+	cpu         float64
+	cpuKnown    bool
+}
+
+// averageUsage returns the average memory and CPU usage reported by the
+// clients running the allocations. Allocations whose usage can't be retrieved
+// are skipped.
+func (w *deploymentWatcher) averageUsage(allocs []*structs.Allocation) *averageResourceUsage {
+	var mem, cpu float64
+	memAllocs, cpuAllocs := 0, 0
+	for _, alloc := range allocs {
+		usage, err := w.allocStats(alloc.ID)
+		if err != nil {
+			w.logger.Debug("failed to retrieve allocation resource usage", "alloc_id", alloc.ID, "error", err)
+			continue
+		}
+		if usage == nil || usage.ResourceUsage == nil {
+			continue
+		}
+		if m, ok := memoryUsage(usage.ResourceUsage.MemoryStats); ok {
+			mem += m
+			memAllocs++
+		}
+		if cs := usage.ResourceUsage.CpuStats; cs != nil {
+			cpu += cs.TotalTicks
+			cpuAllocs++
+		}
+	}
+
+	avg := &averageResourceUsage{
+		memoryKnown: memAllocs > 0,
+		cpuKnown:    cpuAllocs > 0,
+	}
+	if avg.memoryKnown {
+		avg.memory = mem / float64(memAllocs)
+	}
+	if avg.cpuKnown {
+		avg.cpu = cpu / float64(cpuAllocs)
+	}
+	return avg
+}
+
+// memoryUsage returns the memory used by an allocation. RSS is preferred, but
+// it isn't measured on cgroups v2 hosts, which only report the total usage.
+func memoryUsage(ms *cstructs.MemoryStats) (float64, bool) {
+	if ms == nil {
+		return 0, false
+	}
+	switch {
+	case slices.Contains(ms.Measured, "RSS"):
+		return float64(ms.RSS), true
+	case slices.Contains(ms.Measured, "Usage"):
+		return float64(ms.Usage), true
+	}
+	return 0, false
+}
+
+// metricsURLAllowed returns whether the metrics URL matches an entry of the
+// allowlist. The scheme and host must be the same and the path must start
+// with the path of the entry.
+func metricsURLAllowed(allowlist []string, metricsURL string) bool {
+	u, err := url.Parse(metricsURL)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range allowlist {
+		a, err := url.Parse(allowed)
+		if err != nil {
+			continue
+		}
+		if u.Scheme == a.Scheme && u.Host == a.Host && strings.HasPrefix(u.Path, a.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchCanaryMetrics queries the user supplied metrics endpoint for the
+// canaries of the group. Any response other than a 2xx fails the analysis.
+func fetchCanaryMetrics(metricsURL string, d *structs.Deployment, group string) (string, error) {
+	u, err := url.Parse(metricsURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("namespace", d.Namespace)
+	q.Set("job_id", d.JobID)
+	q.Set("job_version", fmt.Sprint(d.JobVersion))
+	q.Set("deployment_id", d.ID)
+	q.Set("task_group", group)
+	u.RawQuery = q.Encode()
+
+	client := cleanhttp.DefaultClient()
+	client.Timeout = metricsQueryTimeout
+	resp, err := client.Get(u.String())
+	if err != nil {
+		return "", fmt.Errorf("failed to query canary metrics: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Sprintf("metrics query returned status %d", resp.StatusCode), nil
+	}
+	return "", nil
+}
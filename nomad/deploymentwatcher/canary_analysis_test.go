@@ -0,0 +1,374 @@
+package deploymentwatcher
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+	mocker "github.com/stretchr/testify/mock"
+)
+
+// analysisTestDeployment creates a job with a canary analysis, a stable
+// allocation and a deployment with one canary. It returns the deployment and
+// the canary and stable allocations.
+func analysisTestDeployment(t *testing.T, m *mockBackend, analysis *structs.CanaryAnalysis) (*structs.Deployment, *structs.Allocation, *structs.Allocation) {
+	j := mock.Job()
+	j.TaskGroups[0].Update = structs.DefaultUpdateStrategy.Copy()
+	j.TaskGroups[0].Update.Canary = 1
+	j.TaskGroups[0].Update.AutoPromote = true
+	j.TaskGroups[0].Update.Analysis = analysis
+
+	d := mock.Deployment()
+	d.JobID = j.ID
+	d.TaskGroups = map[string]*structs.DeploymentState{
+		"web": {
+			AutoPromote:     true,
+			DesiredCanaries: 1,
+			DesiredTotal:    2,
+		},
+	}
+
+	stable := mock.Alloc()
+	stable.Job = j
+	stable.JobID = j.ID
+	stable.DeploymentID = uuid.Generate()
+	stable.ClientStatus = structs.AllocClientStatusRunning
+
+	canary := mock.Alloc()
+	canary.Job = j
+	canary.JobID = j.ID
+	canary.DeploymentID = d.ID
+	canary.ClientStatus = structs.AllocClientStatusRunning
+	canary.DeploymentStatus = &structs.AllocDeploymentStatus{Canary: true}
+	d.TaskGroups["web"].PlacedCanaries = []string{canary.ID}
+
+	must.NoError(t, m.state.UpsertJob(structs.MsgTypeTestSetup, m.nextIndex(), j))
+	must.NoError(t, m.state.UpsertDeployment(m.nextIndex(), d))
+	must.NoError(t, m.state.UpsertAllocs(structs.MsgTypeTestSetup, m.nextIndex(), []*structs.Allocation{stable, canary}))
+
+	m.On("UpdateDeploymentAllocHealth", mocker.Anything).Return(nil)
+	m.On("UpdateDeploymentStatus", mocker.Anything).Return(nil).Maybe()
+	m.On("UpdateDeploymentPromotion", mocker.Anything).Return(nil).Maybe()
+	return d, canary, stable
+}
+
+func rssUsage(rss uint64) *cstructs.AllocResourceUsage {
+	return &cstructs.AllocResourceUsage{
+		ResourceUsage: &cstructs.ResourceUsage{
+			MemoryStats: &cstructs.MemoryStats{RSS: rss, Measured: []string{"RSS", "Usage"}},
+			CpuStats:    &cstructs.CpuStats{},
+		},
+	}
+}
+
+// cgroupsV2Usage returns the usage reported on cgroups v2 hosts, which don't
+// measure RSS.
+func cgroupsV2Usage(usage uint64) *cstructs.AllocResourceUsage {
+	return &cstructs.AllocResourceUsage{
+		ResourceUsage: &cstructs.ResourceUsage{
+			MemoryStats: &cstructs.MemoryStats{Usage: usage, Measured: []string{"Cache", "Swap", "Usage"}},
+			CpuStats:    &cstructs.CpuStats{},
+		},
+	}
+}
+
+func TestWatcher_CanaryAnalysis_MemoryRatio(t *testing.T) {
+	ci.Parallel(t)
+	w, m := defaultTestDeploymentWatcher(t)
+
+	d, canary, stable := analysisTestDeployment(t, m, &structs.CanaryAnalysis{
+		MaxMemoryRatio: 1.5,
+	})
+	m.On("AllocStats", stable.ID).Return(rssUsage(100), nil)
+	m.On("AllocStats", canary.ID).Return(rssUsage(300), nil)
+
+	w.SetEnabled(true, m.state)
+	testutil.WaitForResult(func() (bool, error) { return 1 == watchersCount(w), nil },
+		func(err error) { must.Eq(t, 1, watchersCount(w)) })
+
+	req := &structs.DeploymentAllocHealthRequest{
+		DeploymentID:         d.ID,
+		HealthyAllocationIDs: []string{canary.ID},
+	}
+	var resp structs.DeploymentUpdateResponse
+	must.NoError(t, w.SetAllocHealth(req, &resp))
+
+	// The canary uses 3x the memory of the stable allocation
+	testutil.WaitForResult(func() (bool, error) {
+		out, err := m.state.DeploymentByID(nil, d.ID)
+		if err != nil {
+			return false, err
+		}
+		return out.Status == structs.DeploymentStatusFailed, nil
+	}, func(err error) { must.NoError(t, err) })
+
+	out, err := m.state.DeploymentByID(nil, d.ID)
+	must.NoError(t, err)
+	must.StrContains(t, out.StatusDescription, "canaries use 3.00x the memory of stable allocations")
+	must.False(t, out.TaskGroups["web"].Promoted)
+	m.AssertNotCalled(t, "UpdateDeploymentPromotion", mocker.Anything)
+}
+
+func TestWatcher_CanaryAnalysis_MetricsURL(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name     string
+		status   int
+		promoted bool
+	}{
+		{
+			name:     "passing",
+			status:   http.StatusOK,
+			promoted: true,
+		},
+		{
+			name:   "failing",
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, m := defaultTestDeploymentWatcher(t)
+
+			queries := make(chan string, 1)
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				select {
+				case queries <- r.URL.Query().Get("deployment_id"):
+				default:
+				}
+				rw.WriteHeader(tc.status)
+			}))
+			defer srv.Close()
+			w.metricsAllowlist = []string{srv.URL}
+
+			d, canary, stable := analysisTestDeployment(t, m, &structs.CanaryAnalysis{
+				MetricsURL: srv.URL,
+			})
+			m.On("AllocStats", stable.ID).Return(rssUsage(100), nil).Maybe()
+			m.On("AllocStats", canary.ID).Return(rssUsage(100), nil).Maybe()
+
+			w.SetEnabled(true, m.state)
+			testutil.WaitForResult(func() (bool, error) { return 1 == watchersCount(w), nil },
+				func(err error) { must.Eq(t, 1, watchersCount(w)) })
+
+			req := &structs.DeploymentAllocHealthRequest{
+				DeploymentID:         d.ID,
+				HealthyAllocationIDs: []string{canary.ID},
+			}
+			var resp structs.DeploymentUpdateResponse
+			must.NoError(t, w.SetAllocHealth(req, &resp))
+
+			testutil.WaitForResult(func() (bool, error) {
+				out, err := m.state.DeploymentByID(nil, d.ID)
+				if err != nil {
+					return false, err
+				}
+				if tc.promoted {
+					return out.TaskGroups["web"].Promoted, nil
+				}
+				return out.Status == structs.DeploymentStatusFailed, nil
+			}, func(err error) { must.NoError(t, err) })
+
+			must.Eq(t, d.ID, <-queries)
+			if !tc.promoted {
+				out, err := m.state.DeploymentByID(nil, d.ID)
+				must.NoError(t, err)
+				must.StrContains(t, out.StatusDescription, "metrics query returned status 500")
+			}
+		})
+	}
+}
+
+func TestWatcher_CanaryAnalysis_MemoryUsage(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name   string
+		stable *cstructs.AllocResourceUsage
+		canary *cstructs.AllocResourceUsage
+		reason string
+	}{
+		{
+			name:   "cgroups v2",
+			stable: cgroupsV2Usage(100),
+			canary: cgroupsV2Usage(300),
+			reason: "canaries use 3.00x the memory of stable allocations",
+		},
+		{
+			name:   "unknown",
+			stable: &cstructs.AllocResourceUsage{ResourceUsage: &cstructs.ResourceUsage{}},
+			canary: &cstructs.AllocResourceUsage{ResourceUsage: &cstructs.ResourceUsage{}},
+			reason: "memory usage of canaries or stable allocations is unknown",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, m := defaultTestDeploymentWatcher(t)
+
+			d, canary, stable := analysisTestDeployment(t, m, &structs.CanaryAnalysis{
+				MaxMemoryRatio: 1.5,
+			})
+			m.On("AllocStats", stable.ID).Return(tc.stable, nil)
+			m.On("AllocStats", canary.ID).Return(tc.canary, nil)
+
+			w.SetEnabled(true, m.state)
+			testutil.WaitForResult(func() (bool, error) { return 1 == watchersCount(w), nil },
+				func(err error) { must.Eq(t, 1, watchersCount(w)) })
+
+			req := &structs.DeploymentAllocHealthRequest{
+				DeploymentID:         d.ID,
+				HealthyAllocationIDs: []string{canary.ID},
+			}
+			var resp structs.DeploymentUpdateResponse
+			must.NoError(t, w.SetAllocHealth(req, &resp))
+
+			testutil.WaitForResult(func() (bool, error) {
+				out, err := m.state.DeploymentByID(nil, d.ID)
+				if err != nil {
+					return false, err
+				}
+				return out.Status == structs.DeploymentStatusFailed, nil
+			}, func(err error) { must.NoError(t, err) })
+
+			out, err := m.state.DeploymentByID(nil, d.ID)
+			must.NoError(t, err)
+			must.StrContains(t, out.StatusDescription, tc.reason)
+			m.AssertNotCalled(t, "UpdateDeploymentPromotion", mocker.Anything)
+		})
+	}
+}
+
+func TestWatcher_CanaryAnalysis_MetricsURLNotAllowed(t *testing.T) {
+	ci.Parallel(t)
+	w, m := defaultTestDeploymentWatcher(t)
+
+	queried := make(chan struct{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		select {
+		case queried <- struct{}{}:
+		default:
+		}
+	}))
+	defer srv.Close()
+
+	d, canary, _ := analysisTestDeployment(t, m, &structs.CanaryAnalysis{
+		MetricsURL: srv.URL,
+	})
+	m.On("AllocStats", mocker.Anything).Return(rssUsage(100), nil).Maybe()
+
+	w.SetEnabled(true, m.state)
+	testutil.WaitForResult(func() (bool, error) { return 1 == watchersCount(w), nil },
+		func(err error) { must.Eq(t, 1, watchersCount(w)) })
+
+	req := &structs.DeploymentAllocHealthRequest{
+		DeploymentID:         d.ID,
+		HealthyAllocationIDs: []string{canary.ID},
+	}
+	var resp structs.DeploymentUpdateResponse
+	must.NoError(t, w.SetAllocHealth(req, &resp))
+
+	testutil.WaitForResult(func() (bool, error) {
+		out, err := m.state.DeploymentByID(nil, d.ID)
+		if err != nil {
+			return false, err
+		}
+		return out.Status == structs.DeploymentStatusFailed, nil
+	}, func(err error) { must.NoError(t, err) })
+
+	out, err := m.state.DeploymentByID(nil, d.ID)
+	must.NoError(t, err)
+	must.StrContains(t, out.StatusDescription, "is not allowed by the canary_metrics_allowlist")
+	must.Zero(t, len(queried))
+}
+
+func TestWatcher_CanaryAnalysis_ManualPromote(t *testing.T) {
+	ci.Parallel(t)
+	w, m := defaultTestDeploymentWatcher(t)
+
+	d, canary, _ := analysisTestDeployment(t, m, &structs.CanaryAnalysis{})
+
+	w.SetEnabled(true, m.state)
+	testutil.WaitForResult(func() (bool, error) { return 1 == watchersCount(w), nil },
+		func(err error) { must.Eq(t, 1, watchersCount(w)) })
+
+	// The canary isn't healthy yet, so the analysis hasn't passed
+	promote := &structs.DeploymentPromoteRequest{
+		DeploymentID: d.ID,
+		All:          true,
+	}
+	var resp structs.DeploymentUpdateResponse
+	err := w.PromoteDeployment(promote, &resp)
+	must.EqError(t, err, `canaries of task group "web" have not passed the canary analysis`)
+	m.AssertNotCalled(t, "UpdateDeploymentPromotion", mocker.Anything)
+
+	req := &structs.DeploymentAllocHealthRequest{
+		DeploymentID:         d.ID,
+		HealthyAllocationIDs: []string{canary.ID},
+	}
+	must.NoError(t, w.SetAllocHealth(req, &resp))
+
+	testutil.WaitForResult(func() (bool, error) {
+		out, err := m.state.DeploymentByID(nil, d.ID)
+		if err != nil {
+			return false, err
+		}
+		return out.TaskGroups["web"].Promoted, nil
+	}, func(err error) { must.NoError(t, err) })
+}
+
+func Test_averageRestartsSince(t *testing.T) {
+	ci.Parallel(t)
+
+	now := time.Now()
+	restarts := func(ages ...time.Duration) *structs.Allocation {
+		alloc := mock.Alloc()
+		ts := &structs.TaskState{Restarts: uint64(len(ages))}
+		for _, age := range ages {
+			ts.Events = append(ts.Events, &structs.TaskEvent{
+				Type: structs.TaskRestarting,
+				Time: now.Add(-age).UnixNano(),
+			})
+		}
+		alloc.TaskStates = map[string]*structs.TaskState{"web": ts}
+		return alloc
+	}
+
+	canary := restarts(time.Minute)
+	canary.CreateTime = now.Add(-10 * time.Minute).UnixNano()
+	since := canariesStartTime([]*structs.Allocation{canary})
+	must.Eq(t, canary.CreateTime, since.UnixNano())
+
+	// Restarts of the stable allocations before the canary was placed don't
+	// count against it
+	stable := []*structs.Allocation{
+		restarts(24*time.Hour, 12*time.Hour, 5*time.Minute),
+		restarts(48 * time.Hour),
+	}
+	must.Eq(t, 0.5, averageRestartsSince(stable, since))
+	must.Eq(t, 1.0, averageRestartsSince([]*structs.Allocation{canary}, since))
+}
+
+func Test_metricsURLAllowed(t *testing.T) {
+	ci.Parallel(t)
+
+	allowlist := []string{"https://metrics.example.com/canary", "http://127.0.0.1:9090"}
+
+	must.True(t, metricsURLAllowed(allowlist, "https://metrics.example.com/canary/check?x=1"))
+	must.True(t, metricsURLAllowed(allowlist, "http://127.0.0.1:9090/analysis"))
+	must.False(t, metricsURLAllowed(allowlist, "http://metrics.example.com/canary"))
+	must.False(t, metricsURLAllowed(allowlist, "https://metrics.example.com/other"))
+	must.False(t, metricsURLAllowed(allowlist, "http://127.0.0.1:8500/v1/kv"))
+	must.False(t, metricsURLAllowed(allowlist, "http://169.254.169.254/latest/meta-data"))
+	must.False(t, metricsURLAllowed(nil, "https://metrics.example.com/canary"))
+}
@@ -8,6 +8,8 @@ import (
 
 	log "github.com/hashicorp/go-hclog"
 	memdb "github.com/hashicorp/go-memdb"
+	cstructs "github.com/hashicorp/nomad/client/structs"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/hashicorp/nomad/helper/pointer"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/state"
@@ -50,6 +52,15 @@ type deploymentTriggers interface {
 	// upsertDeploymentAllocHealth is used to set the health of allocations in a
 	// deployment
 	upsertDeploymentAllocHealth(req *structs.ApplyDeploymentAllocHealthRequest) (uint64, error)
+
+	// allocStats is used to retrieve the resource usage of an allocation for
+	// the canary analysis
+	allocStats(allocID string) (*cstructs.AllocResourceUsage, error)
+
+	// queryCanaryMetrics is used to query the metrics endpoint of the canary
+	// analysis of a group. It returns why the canaries failed the analysis
+	// or an empty string if they passed.
+	queryCanaryMetrics(metricsURL string, d *structs.Deployment, group string) (string, error)
 }
 
 // deploymentWatcher is used to watch a single deployment and trigger the
@@ -98,6 +109,24 @@ type deploymentWatcher struct {
 	// by holding the lock or using the setter and getter methods.
 	latestEval uint64
 
+	// stageHealthyAt tracks when the current stage of the groups rolled out
+	// in stages became healthy, keyed by group and stage index. A zero time
+	// marks a stage that has already been auto promoted. Access should be
+	// done through the lock.
+	stageHealthyAt map[string]time.Time
+
+	// analyzed is the set of groups whose canaries passed the canary
+	// analysis. Access should be done through the lock.
+	analyzed map[string]bool
+
+	// analyzing is the set of groups whose canary analysis is running.
+	// Access should be done through the lock.
+	analyzing map[string]bool
+
+	// analysisCh receives the results of the canary analyses running in the
+	// background
+	analysisCh chan *canaryAnalysisResult
+
 	logger log.Logger
 	ctx    context.Context
 	exitFn context.CancelFunc
@@ -122,6 +151,10 @@ func newDeploymentWatcher(parent context.Context, queryLimiter *rate.Limiter,
 		deploymentTriggers: triggers,
 		DeploymentRPC:      deploymentRPC,
 		JobRPC:             jobRPC,
+		stageHealthyAt:     make(map[string]time.Time),
+		analyzed:           make(map[string]bool),
+		analyzing:          make(map[string]bool),
+		analysisCh:         make(chan *canaryAnalysisResult),
 		logger:             logger.With("deployment_id", d.ID, "job", j.NamespacedID()),
 		ctx:                ctx,
 		exitFn:             exitFn,
@@ -257,6 +290,11 @@ func (w *deploymentWatcher) PromoteDeployment(
 	req *structs.DeploymentPromoteRequest,
 	resp *structs.DeploymentUpdateResponse) error {
 
+	// Groups with a canary analysis can't be promoted until it has passed
+	if err := w.analysisBlocksPromotion(req); err != nil {
+		return err
+	}
+
 	// Create the request
 	areq := &structs.ApplyDeploymentPromoteRequest{
 		DeploymentPromoteRequest: *req,
@@ -297,16 +335,15 @@ func (w *deploymentWatcher) autoPromoteDeployment(allocs []*structs.AllocListStu
 			return nil
 		}
 
-		healthyCanaries := 0
-		// Find the health status of each canary
-		for _, c := range dstate.PlacedCanaries {
-			for _, a := range allocs {
-				if c == a.ID && a.DeploymentStatus.IsHealthy() {
-					healthyCanaries += 1
-				}
-			}
+		if healthyCanaries(dstate, allocs) != dstate.DesiredCanaries {
+			return nil
 		}
-		if healthyCanaries != dstate.DesiredCanaries {
+	}
+
+	// Groups with a canary analysis are only promoted once it has passed
+	for name := range d.TaskGroups {
+		tg := w.j.LookupTaskGroup(name)
+		if tg != nil && tg.Update != nil && tg.Update.Analysis != nil && !w.analysisPassed(name) {
 			return nil
 		}
 	}
@@ -319,6 +356,62 @@ func (w *deploymentWatcher) autoPromoteDeployment(allocs []*structs.AllocListStu
 	return err
 }
 
+// autoAdvanceStages promotes the groups rolled out in stages with auto promote
+// set once the allocations of their current stage have been healthy for the
+// stage pause. The timer is reset to fire when the next waiting group may be
+// advanced.
+func (w *deploymentWatcher) autoAdvanceStages(timer *time.Timer) error {
+	d := w.getDeployment()
+	if d == nil || d.Status != structs.DeploymentStatusRunning {
+		return nil
+	}
+
+	now := time.Now()
+	var groups []string
+	var next time.Time
+
+	w.l.Lock()
+	for name, dstate := range d.TaskGroups {
+		if !dstate.AutoPromote || !dstate.StagedRollout() ||
+			!dstate.HasNextStage() || !dstate.StageHealthy() {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%d", name, dstate.Stage)
+		healthyAt, ok := w.stageHealthyAt[key]
+		if !ok {
+			healthyAt = now
+			w.stageHealthyAt[key] = now
+		} else if healthyAt.IsZero() {
+			continue
+		}
+
+		readyAt := healthyAt.Add(dstate.Stages[dstate.Stage].Pause)
+		if !readyAt.After(now) {
+			groups = append(groups, name)
+			w.stageHealthyAt[key] = time.Time{}
+			continue
+		}
+		if next.IsZero() || readyAt.Before(next) {
+			next = readyAt
+		}
+	}
+	w.l.Unlock()
+
+	if !next.IsZero() {
+		timer.Reset(time.Until(next))
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+
+	_, err := w.upsertDeploymentPromotion(&structs.ApplyDeploymentPromoteRequest{
+		DeploymentPromoteRequest: structs.DeploymentPromoteRequest{DeploymentID: d.GetID(), Groups: groups},
+		Eval:                     w.getEval(),
+	})
+	return err
+}
+
 func (w *deploymentWatcher) PauseDeployment(
 	req *structs.DeploymentPauseRequest,
 	resp *structs.DeploymentUpdateResponse) error {
@@ -426,11 +519,17 @@ func (w *deploymentWatcher) watch() {
 		deadlineTimer = time.NewTimer(time.Until(currentDeadline))
 	}
 
+	// stageTimer fires when a group rolled out in stages has been healthy
+	// for the pause of its current stage.
+	stageTimer, stopStageTimer := helper.NewStoppedTimer()
+	defer stopStageTimer()
+
 	allocIndex := uint64(1)
 	allocsCh := w.getAllocsCh(allocIndex)
 	var updates *allocUpdates
 
 	rollback, deadlineHit := false, false
+	analysisFailure := ""
 
 FAIL:
 	for {
@@ -499,6 +598,34 @@ FAIL:
 				break FAIL
 			}
 
+			if err := w.autoAdvanceStages(stageTimer); err != nil {
+				w.logger.Error("failed to auto promote deployment stage", "error", err)
+			}
+
+		case <-stageTimer.C:
+			if err := w.autoAdvanceStages(stageTimer); err != nil {
+				w.logger.Error("failed to auto promote deployment stage", "error", err)
+			}
+
+		case res := <-w.analysisCh:
+			reason, rback := w.handleCanaryAnalysis(res)
+			if reason != "" {
+				rollback = rback
+				analysisFailure = reason
+				err := w.nextRegion(structs.DeploymentStatusFailed)
+				if err != nil {
+					w.logger.Error("multiregion deployment error", "error", err)
+				}
+				break FAIL
+			}
+
+			// The analysis may be the last thing blocking the promotion
+			if updates != nil {
+				if err := w.autoPromoteDeployment(updates.allocs); err != nil {
+					w.logger.Error("failed to auto promote deployment", "error", err)
+				}
+			}
+
 		case updates = <-allocsCh:
 			if err := updates.err; err != nil {
 				if err == context.Canceled || w.ctx.Err() == context.Canceled {
@@ -533,11 +660,17 @@ FAIL:
 				break FAIL
 			}
 
+			// Compare the healthy canaries with the stable allocations
+			w.startCanaryAnalyses(updates.allocs)
+
 			// If permitted, automatically promote this canary deployment
 			err = w.autoPromoteDeployment(updates.allocs)
 			if err != nil {
 				w.logger.Error("failed to auto promote deployment", "error", err)
 			}
+			if err := w.autoAdvanceStages(stageTimer); err != nil {
+				w.logger.Error("failed to auto promote deployment stage", "error", err)
+			}
 
 			// Create an eval to push the deployment along
 			if res.createEval || len(res.allowReplacements) != 0 {
@@ -553,6 +686,8 @@ FAIL:
 	desc := structs.DeploymentStatusDescriptionFailedAllocations
 	if deadlineHit {
 		desc = structs.DeploymentStatusDescriptionProgressDeadline
+	} else if analysisFailure != "" {
+		desc = structs.DeploymentStatusDescriptionFailedAnalysis(analysisFailure)
 	}
 
 	// Rollback to the old job if necessary
@@ -679,6 +814,12 @@ func (w *deploymentWatcher) shouldFail() (fail, rollback bool, err error) {
 			if dstate.HealthyAllocs >= dstate.DesiredCanaries {
 				continue
 			}
+		} else if dstate.StagedRollout() && dstate.HasNextStage() {
+			// We are waiting for the next stage to be promoted, so we only
+			// fail if the current stage isn't healthy
+			if dstate.StageHealthy() {
+				continue
+			}
 		} else if dstate.HealthyAllocs >= dstate.DesiredTotal {
 			continue
 		}
@@ -686,9 +827,10 @@ func (w *deploymentWatcher) shouldFail() (fail, rollback bool, err error) {
 		// We have failed this TG
 		fail = true
 
-		// We don't need to autorevert this group
+		// We don't need to autorevert this group, staged rollouts are always
+		// reverted
 		upd := w.j.LookupTaskGroup(tg).Update
-		if upd == nil || !upd.AutoRevert {
+		if upd == nil || !upd.AutoRevert && len(upd.Stages) == 0 {
 			continue
 		}
 
@@ -760,6 +902,12 @@ func (w *deploymentWatcher) doneGroups(d *structs.Deployment) map[string]bool {
 			continue
 		}
 
+		// Requires promotion of the next stage
+		if dstate.StagedRollout() && dstate.HasNextStage() {
+			groups[name] = false
+			continue
+		}
+
 		// Check we have enough healthy currently running allocations
 		groups[name] = healthy[name] >= dstate.DesiredTotal
 	}
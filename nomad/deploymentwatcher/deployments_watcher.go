@@ -11,6 +11,7 @@ import (
 	log "github.com/hashicorp/go-hclog"
 	memdb "github.com/hashicorp/go-memdb"
 
+	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/nomad/state"
 	"github.com/hashicorp/nomad/nomad/structs"
 )
@@ -54,6 +55,13 @@ type DeploymentRaftEndpoints interface {
 	UpdateAllocDesiredTransition(req *structs.AllocUpdateDesiredTransitionRequest) (uint64, error)
 }
 
+// AllocStatsEndpoint exposes the deployment watcher to the resource usage of
+// allocations reported by the clients running them.
+type AllocStatsEndpoint interface {
+	// AllocStats returns the resource usage of the allocation
+	AllocStats(allocID string) (*cstructs.AllocResourceUsage, error)
+}
+
 // Watcher is used to watch deployments and their allocations created
 // by the scheduler and trigger the scheduler when allocation health
 // transitions.
@@ -72,6 +80,14 @@ type Watcher struct {
 	// deployments watcher
 	raft DeploymentRaftEndpoints
 
+	// stats is used to retrieve the resource usage of allocations for the
+	// canary analysis
+	stats AllocStatsEndpoint
+
+	// metricsAllowlist is the list of URL prefixes the canary analysis is
+	// allowed to query metrics from
+	metricsAllowlist []string
+
 	// state is the state that is watched for state changes.
 	state *state.StateStore
 
@@ -99,6 +115,8 @@ type Watcher struct {
 // deployments and trigger the scheduler as needed.
 func NewDeploymentsWatcher(logger log.Logger,
 	raft DeploymentRaftEndpoints,
+	stats AllocStatsEndpoint,
+	metricsAllowlist []string,
 	deploymentRPC DeploymentRPC, jobRPC JobRPC,
 	stateQueriesPerSecond float64,
 	updateBatchDuration time.Duration,
@@ -106,6 +124,8 @@ func NewDeploymentsWatcher(logger log.Logger,
 
 	return &Watcher{
 		raft:                raft,
+		stats:               stats,
+		metricsAllowlist:    metricsAllowlist,
 		deploymentRPC:       deploymentRPC,
 		jobRPC:              jobRPC,
 		queryLimiter:        rate.NewLimiter(rate.Limit(stateQueriesPerSecond), 100),
@@ -452,3 +472,19 @@ func (w *Watcher) upsertDeploymentPromotion(req *structs.ApplyDeploymentPromoteR
 func (w *Watcher) upsertDeploymentAllocHealth(req *structs.ApplyDeploymentAllocHealthRequest) (uint64, error) {
 	return w.raft.UpdateDeploymentAllocHealth(req)
 }
+
+// allocStats returns the resource usage of the allocation reported by its
+// client
+func (w *Watcher) allocStats(allocID string) (*cstructs.AllocResourceUsage, error) {
+	return w.stats.AllocStats(allocID)
+}
+
+// queryCanaryMetrics queries the metrics endpoint of a canary analysis if it
+// is allowed by the metrics allowlist of the agent. Endpoints that aren't
+// allowed fail the analysis, since the job submitter controls the URL.
+func (w *Watcher) queryCanaryMetrics(metricsURL string, d *structs.Deployment, group string) (string, error) {
+	if !metricsURLAllowed(w.metricsAllowlist, metricsURL) {
+		return fmt.Sprintf("metrics URL %q is not allowed by the canary_metrics_allowlist of the servers", metricsURL), nil
+	}
+	return fetchCanaryMetrics(metricsURL, d, group)
+}
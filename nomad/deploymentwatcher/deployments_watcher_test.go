@@ -20,7 +20,7 @@ import (
 
 func testDeploymentWatcher(t *testing.T, qps float64, batchDur time.Duration) (*Watcher, *mockBackend) {
 	m := newMockBackend(t)
-	w := NewDeploymentsWatcher(testlog.HCLogger(t), m, nil, nil, qps, batchDur)
+	w := NewDeploymentsWatcher(testlog.HCLogger(t), m, m, nil, nil, nil, qps, batchDur)
 	return w, m
 }
 
@@ -832,6 +832,109 @@ func TestWatcher_AutoPromoteDeployment_UnhealthyCanaries(t *testing.T) {
 	require.False(t, b1.DeploymentStatus.Canary)
 }
 
+// Test that a deployment rolled out in stages is automatically advanced to
+// the next stage once the current stage has been healthy for its pause
+func TestWatcher_AutoPromoteDeployment_Stages(t *testing.T) {
+	ci.Parallel(t)
+	w, m := defaultTestDeploymentWatcher(t)
+	now := time.Now()
+
+	upd := structs.DefaultUpdateStrategy.Copy()
+	upd.AutoPromote = true
+	upd.MaxParallel = 2
+	upd.ProgressDeadline = 5 * time.Second
+	upd.Stages = []*structs.UpdateStage{
+		{Percent: 50, Pause: 200 * time.Millisecond},
+		{Percent: 100},
+	}
+
+	j := mock.Job()
+	j.TaskGroups[0].Update = upd
+
+	d := mock.Deployment()
+	d.JobID = j.ID
+	// This is created in scheduler.computeGroup at runtime, where properties from the
+	// UpdateStrategy are copied in
+	d.TaskGroups = map[string]*structs.DeploymentState{
+		"web": {
+			AutoPromote:      upd.AutoPromote,
+			AutoRevert:       true,
+			ProgressDeadline: upd.ProgressDeadline,
+			DesiredTotal:     2,
+			PlacedAllocs:     1,
+			Stages:           upd.Stages,
+		},
+	}
+
+	a := mock.Alloc()
+	a.DeploymentID = d.ID
+	a.CreateTime = now.UnixNano()
+	a.ModifyTime = now.UnixNano()
+	a.DeploymentStatus = &structs.AllocDeploymentStatus{}
+
+	require.NoError(t, m.state.UpsertJob(structs.MsgTypeTestSetup, m.nextIndex(), j), "UpsertJob")
+	require.NoError(t, m.state.UpsertDeployment(m.nextIndex(), d), "UpsertDeployment")
+	require.NoError(t, m.state.UpsertAllocs(structs.MsgTypeTestSetup, m.nextIndex(), []*structs.Allocation{a}), "UpsertAllocs")
+
+	// clear UpdateDeploymentStatus default expectation
+	m.Mock.ExpectedCalls = nil
+
+	matchConfig0 := &matchDeploymentAllocHealthRequestConfig{
+		DeploymentID: d.ID,
+		Healthy:      []string{a.ID},
+		Eval:         true,
+	}
+	matcher0 := matchDeploymentAllocHealthRequest(matchConfig0)
+	m.On("UpdateDeploymentAllocHealth", mocker.MatchedBy(matcher0)).Return(nil)
+
+	matchConfig1 := &matchDeploymentPromoteRequestConfig{
+		Promotion: &structs.DeploymentPromoteRequest{
+			DeploymentID: d.ID,
+			Groups:       []string{"web"},
+		},
+		Eval: true,
+	}
+	matcher1 := matchDeploymentPromoteRequest(matchConfig1)
+	m.On("UpdateDeploymentPromotion", mocker.MatchedBy(matcher1)).Return(nil)
+
+	// Start the deployment
+	w.SetEnabled(true, m.state)
+	testutil.WaitForResult(func() (bool, error) {
+		w.l.RLock()
+		defer w.l.RUnlock()
+		return 1 == len(w.watchers), nil
+	},
+		func(err error) {
+			w.l.RLock()
+			defer w.l.RUnlock()
+			require.Equal(t, 1, len(w.watchers), "Should have 1 deployment")
+		},
+	)
+
+	// Mark the allocation of the first stage healthy
+	req := &structs.DeploymentAllocHealthRequest{
+		DeploymentID:         d.ID,
+		HealthyAllocationIDs: []string{a.ID},
+	}
+	var resp structs.DeploymentUpdateResponse
+	require.NoError(t, w.SetAllocHealth(req, &resp))
+
+	// The stage is advanced once its pause has passed
+	ws := memdb.NewWatchSet()
+	testutil.WaitForResult(
+		func() (bool, error) {
+			d, _ := m.state.DeploymentByID(ws, d.ID)
+			if stage := d.TaskGroups["web"].Stage; stage != 1 {
+				return false, fmt.Errorf("expected stage 1, got %d", stage)
+			}
+			return true, nil
+		},
+		func(err error) { require.NoError(t, err) },
+	)
+	m.AssertCalled(t, "UpdateDeploymentPromotion", mocker.MatchedBy(matcher1))
+	m.AssertNumberOfCalls(t, "UpdateDeploymentPromotion", 1)
+}
+
 // Test pausing a deployment that is running
 func TestWatcher_PauseDeployment_Pause_Running(t *testing.T) {
 	ci.Parallel(t)
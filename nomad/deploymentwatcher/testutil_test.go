@@ -6,6 +6,7 @@ import (
 	"sync"
 	"testing"
 
+	cstructs "github.com/hashicorp/nomad/client/structs"
 	"github.com/hashicorp/nomad/nomad/state"
 	"github.com/hashicorp/nomad/nomad/structs"
 	mocker "github.com/stretchr/testify/mock"
@@ -146,6 +147,12 @@ func matchDeploymentStatusUpdateRequest(c *matchDeploymentStatusUpdateConfig) fu
 	}
 }
 
+func (m *mockBackend) AllocStats(allocID string) (*cstructs.AllocResourceUsage, error) {
+	args := m.Called(allocID)
+	usage, _ := args.Get(0).(*cstructs.AllocResourceUsage)
+	return usage, args.Error(1)
+}
+
 func (m *mockBackend) UpdateDeploymentPromotion(req *structs.ApplyDeploymentPromoteRequest) (uint64, error) {
 	m.Called(req)
 	i := m.nextIndex()
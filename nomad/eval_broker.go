@@ -70,8 +70,17 @@ type EvalBroker struct {
 	// now safe for the Eval.Ack RPC to cancel in batches
 	cancelable []*structs.Evaluation
 
-	// ready tracks the ready jobs by scheduler in a priority queue
-	ready map[string]PendingEvaluations
+	// ready tracks the ready jobs by scheduler in queues that are shared
+	// fairly between namespaces
+	ready map[string]*readyQueue
+
+	// readyTimes tracks when ready evaluations were enqueued by ID, to
+	// measure how long they wait to be dequeued
+	readyTimes map[string]time.Time
+
+	// namespaceWeight returns the eval weight of a namespace, which sets
+	// its share of the ready evaluations dequeued
+	namespaceWeight func(namespace string) int
 
 	// unack is a map of evalID to an un-acknowledged evaluation
 	unack map[string]*unackEval
@@ -148,7 +157,8 @@ func NewEvalBroker(timeout, initialNackDelay, subsequentNackDelay time.Duration,
 		jobEvals:             make(map[structs.NamespacedID]string),
 		blocked:              make(map[structs.NamespacedID]BlockedEvaluations),
 		cancelable:           make([]*structs.Evaluation, 0, structs.MaxUUIDsPerWriteRequest),
-		ready:                make(map[string]PendingEvaluations),
+		ready:                make(map[string]*readyQueue),
+		readyTimes:           make(map[string]time.Time),
 		unack:                make(map[string]*unackEval),
 		waiting:              make(map[string]chan struct{}),
 		requeue:              make(map[string]*structs.Evaluation),
@@ -159,11 +169,32 @@ func NewEvalBroker(timeout, initialNackDelay, subsequentNackDelay time.Duration,
 		delayedEvalsUpdateCh: make(chan struct{}, 1),
 	}
 	b.stats.ByScheduler = make(map[string]*SchedulerStats)
+	b.stats.ByNamespace = make(map[string]*NamespaceStats)
 	b.stats.DelayedEvals = make(map[string]*structs.Evaluation)
 
 	return b, nil
 }
 
+// SetNamespaceWeightFn sets the function used to look up the eval weight of
+// namespaces. Without it, every namespace has the default weight.
+func (b *EvalBroker) SetNamespaceWeightFn(fn func(namespace string) int) {
+	b.l.Lock()
+	defer b.l.Unlock()
+	b.namespaceWeight = fn
+}
+
+// evalWeight returns the eval weight of the namespace. This assumes locks
+// are held.
+func (b *EvalBroker) evalWeight(namespace string) int {
+	if b.namespaceWeight == nil {
+		return structs.DefaultNamespaceEvalWeight
+	}
+	if weight := b.namespaceWeight(namespace); weight > 0 {
+		return weight
+	}
+	return structs.DefaultNamespaceEvalWeight
+}
+
 // Enabled is used to check if the broker is enabled.
 func (b *EvalBroker) Enabled() bool {
 	b.l.RLock()
@@ -315,17 +346,18 @@ func (b *EvalBroker) enqueueLocked(eval *structs.Evaluation, queue string) {
 	}
 
 	// Find the pending by scheduler class
-	pending, ok := b.ready[queue]
+	ready, ok := b.ready[queue]
 	if !ok {
-		pending = make([]*structs.Evaluation, 0, 16)
+		ready = newReadyQueue()
+		b.ready[queue] = ready
 		if _, ok := b.waiting[queue]; !ok {
 			b.waiting[queue] = make(chan struct{}, 1)
 		}
 	}
 
-	// Push onto the heap
-	heap.Push(&pending, eval)
-	b.ready[queue] = pending
+	// Push onto the namespace's heap
+	ready.Push(eval)
+	b.readyTimes[eval.ID] = time.Now()
 
 	// Update the stats
 	b.stats.TotalReady += 1
@@ -335,6 +367,12 @@ func (b *EvalBroker) enqueueLocked(eval *structs.Evaluation, queue string) {
 		b.stats.ByScheduler[queue] = bySched
 	}
 	bySched.Ready += 1
+	byNamespace, ok := b.stats.ByNamespace[eval.Namespace]
+	if !ok {
+		byNamespace = &NamespaceStats{}
+		b.stats.ByNamespace[eval.Namespace] = byNamespace
+	}
+	byNamespace.Ready += 1
 
 	// Unblock any blocked dequeues
 	select {
@@ -445,10 +483,14 @@ func (b *EvalBroker) scanForSchedulers(schedulers []string) (*structs.Evaluation
 // This assumes locks are held and that this scheduler has work
 func (b *EvalBroker) dequeueForSched(sched string) (*structs.Evaluation, string, error) {
 	// Get the pending queue
-	pending := b.ready[sched]
-	raw := heap.Pop(&pending)
-	b.ready[sched] = pending
-	eval := raw.(*structs.Evaluation)
+	eval := b.ready[sched].Pop(b.evalWeight)
+
+	// Measure how long the evaluation waited to be dequeued
+	if enqueued, ok := b.readyTimes[eval.ID]; ok {
+		metrics.MeasureSinceWithLabels([]string{"nomad", "broker", "wait_time"}, enqueued,
+			[]metrics.Label{{Name: "namespace", Value: eval.Namespace}})
+		delete(b.readyTimes, eval.ID)
+	}
 
 	// Generate a UUID for the token
 	token := uuid.Generate()
@@ -474,6 +516,9 @@ func (b *EvalBroker) dequeueForSched(sched string) (*structs.Evaluation, string,
 	bySched := b.stats.ByScheduler[sched]
 	bySched.Ready -= 1
 	bySched.Unacked += 1
+	if byNamespace, ok := b.stats.ByNamespace[eval.Namespace]; ok {
+		byNamespace.Ready -= 1
+	}
 
 	return eval, token, nil
 }
@@ -757,11 +802,13 @@ func (b *EvalBroker) flush() {
 	b.stats.TotalCancelable = 0
 	b.stats.DelayedEvals = make(map[string]*structs.Evaluation)
 	b.stats.ByScheduler = make(map[string]*SchedulerStats)
+	b.stats.ByNamespace = make(map[string]*NamespaceStats)
 	b.evals = make(map[string]int)
 	b.jobEvals = make(map[structs.NamespacedID]string)
 	b.blocked = make(map[structs.NamespacedID]BlockedEvaluations)
 	b.cancelable = make([]*structs.Evaluation, 0, structs.MaxUUIDsPerWriteRequest)
-	b.ready = make(map[string]PendingEvaluations)
+	b.ready = make(map[string]*readyQueue)
+	b.readyTimes = make(map[string]time.Time)
 	b.unack = make(map[string]*unackEval)
 	b.timeWait = make(map[string]*time.Timer)
 	b.delayHeap = delayheap.NewDelayHeap()
@@ -844,6 +891,7 @@ func (b *EvalBroker) Stats() *BrokerStats {
 	stats := new(BrokerStats)
 	stats.DelayedEvals = make(map[string]*structs.Evaluation)
 	stats.ByScheduler = make(map[string]*SchedulerStats)
+	stats.ByNamespace = make(map[string]*NamespaceStats)
 
 	b.l.RLock()
 	defer b.l.RUnlock()
@@ -862,6 +910,10 @@ func (b *EvalBroker) Stats() *BrokerStats {
 		subStatCopy := *subStat
 		stats.ByScheduler[sched] = &subStatCopy
 	}
+	for ns, subStat := range b.stats.ByNamespace {
+		subStatCopy := *subStat
+		stats.ByNamespace[ns] = &subStatCopy
+	}
 	return stats
 }
 
@@ -912,6 +964,11 @@ func (b *EvalBroker) EmitStats(period time.Duration, stopCh <-chan struct{}) {
 				metrics.SetGauge([]string{"nomad", "broker", sched, "ready"}, float32(schedStats.Ready))
 				metrics.SetGauge([]string{"nomad", "broker", sched, "unacked"}, float32(schedStats.Unacked))
 			}
+			for ns, nsStats := range stats.ByNamespace {
+				metrics.SetGaugeWithLabels([]string{"nomad", "broker", "namespace_ready"},
+					float32(nsStats.Ready),
+					[]metrics.Label{{Name: "namespace", Value: ns}})
+			}
 
 		case <-stopCh:
 			return
@@ -928,6 +985,7 @@ type BrokerStats struct {
 	TotalCancelable int
 	DelayedEvals    map[string]*structs.Evaluation
 	ByScheduler     map[string]*SchedulerStats
+	ByNamespace     map[string]*NamespaceStats
 }
 
 // SchedulerStats returns the stats per scheduler
@@ -936,6 +994,123 @@ type SchedulerStats struct {
 	Unacked int
 }
 
+// NamespaceStats returns the stats per namespace
+type NamespaceStats struct {
+	Ready int
+}
+
+// readyQueue is the queue of ready evaluations of a scheduler. Evaluations
+// are queued by namespace and the namespaces are served by start-time fair
+// queuing, so that each namespace with ready evaluations receives a share of
+// dequeues proportional to its eval weight no matter how many evaluations
+// other namespaces have queued. Within a namespace, evaluations are dequeued
+// in priority order.
+type readyQueue struct {
+	// namespaces tracks the ready evaluations by namespace
+	namespaces map[string]*namespaceReadyQueue
+
+	// vtime is the virtual time of the last dequeued evaluation. Namespaces
+	// that become ready start at this time so that they can't claim the
+	// share they didn't use while they were idle.
+	vtime float64
+
+	// seq orders namespaces by how long they've waited to be served
+	seq uint64
+}
+
+// namespaceReadyQueue is the ready evaluations of a single namespace
+type namespaceReadyQueue struct {
+	pending PendingEvaluations
+
+	// vtime is the virtual time at which the namespace's next evaluation
+	// starts being served. Every dequeue advances it by the inverse of the
+	// namespace's weight.
+	vtime float64
+
+	// seq is the readyQueue sequence number at which the namespace was
+	// last queued or served
+	seq uint64
+}
+
+func newReadyQueue() *readyQueue {
+	return &readyQueue{
+		namespaces: make(map[string]*namespaceReadyQueue),
+	}
+}
+
+// Push adds a ready evaluation to the queue of its namespace
+func (q *readyQueue) Push(eval *structs.Evaluation) {
+	nq, ok := q.namespaces[eval.Namespace]
+	if !ok {
+		nq = &namespaceReadyQueue{
+			pending: make([]*structs.Evaluation, 0, 16),
+			vtime:   q.vtime,
+			seq:     q.nextSeq(),
+		}
+		q.namespaces[eval.Namespace] = nq
+	}
+	heap.Push(&nq.pending, eval)
+}
+
+// Peek returns the evaluation that will be dequeued next, or nil if the
+// queue is empty
+func (q *readyQueue) Peek() *structs.Evaluation {
+	if _, nq := q.next(); nq != nil {
+		return nq.pending.Peek()
+	}
+	return nil
+}
+
+// Pop dequeues the next evaluation, charging its namespace based on the
+// weight returned by weightFn. The queue must not be empty.
+func (q *readyQueue) Pop(weightFn func(namespace string) int) *structs.Evaluation {
+	ns, nq := q.next()
+	eval := heap.Pop(&nq.pending).(*structs.Evaluation)
+
+	q.vtime = nq.vtime
+	nq.vtime += 1 / float64(weightFn(ns))
+	nq.seq = q.nextSeq()
+	if nq.pending.Len() == 0 {
+		delete(q.namespaces, ns)
+	}
+	return eval
+}
+
+func (q *readyQueue) nextSeq() uint64 {
+	q.seq++
+	return q.seq
+}
+
+// next returns the namespace served next, which is the one with the lowest
+// virtual time. Ties are broken by the priority and the age of the
+// namespaces' next evaluation, and then by how long the namespaces have
+// waited.
+func (q *readyQueue) next() (string, *namespaceReadyQueue) {
+	var nextNS string
+	var next *namespaceReadyQueue
+	for ns, nq := range q.namespaces {
+		if next == nil || nq.before(next) {
+			nextNS, next = ns, nq
+		}
+	}
+	return nextNS, next
+}
+
+// before returns whether namespace queue a should be served before b
+func (a *namespaceReadyQueue) before(b *namespaceReadyQueue) bool {
+	if a.vtime != b.vtime {
+		return a.vtime < b.vtime
+	}
+	aEval, bEval := a.pending.Peek(), b.pending.Peek()
+	if aEval.Priority != bEval.Priority {
+		return aEval.Priority > bEval.Priority
+	}
+	if aEval.CreateIndex != bEval.CreateIndex {
+		return aEval.CreateIndex < bEval.CreateIndex
+	}
+	return a.seq < b.seq
+}
+
 // Len is for the sorting interface
 func (p PendingEvaluations) Len() int {
 	return len(p)
@@ -430,6 +430,7 @@ func TestEvalBroker_Serialize_DuplicateJobID(t *testing.T) {
 		stats := b.Stats()
 		stats.DelayedEvals = nil
 		stats.ByScheduler = nil
+		stats.ByNamespace = nil
 		return *stats
 	}
 
@@ -1383,6 +1384,103 @@ func TestEvalBroker_NamespacedJobs(t *testing.T) {
 
 }
 
+func TestEvalBroker_NamespaceFairShare(t *testing.T) {
+	ci.Parallel(t)
+	b := testBroker(t, 0)
+	b.SetEnabled(true)
+
+	newEval := func(ns string, index uint64) *structs.Evaluation {
+		eval := mock.Eval()
+		eval.Namespace = ns
+		eval.CreateIndex = index
+		b.Enqueue(eval)
+		return eval
+	}
+
+	// A namespace that floods the broker doesn't starve another namespace
+	// that enqueues later
+	for i := uint64(1); i <= 10; i++ {
+		newEval("flood", i)
+	}
+	newEval("quiet", 11)
+	quiet2 := newEval("quiet", 12)
+
+	stats := b.Stats()
+	must.Eq(t, 10, stats.ByNamespace["flood"].Ready)
+	must.Eq(t, 2, stats.ByNamespace["quiet"].Ready)
+
+	var order []string
+	for i := 0; i < 4; i++ {
+		out, _, err := b.Dequeue(defaultSched, time.Second)
+		must.NoError(t, err)
+		order = append(order, out.Namespace)
+		if out.ID == quiet2.ID {
+			break
+		}
+	}
+	must.Eq(t, []string{"flood", "quiet", "flood", "quiet"}, order)
+
+	stats = b.Stats()
+	must.Eq(t, 8, stats.ByNamespace["flood"].Ready)
+	must.Eq(t, 0, stats.ByNamespace["quiet"].Ready)
+}
+
+func TestEvalBroker_NamespaceFairShare_Weights(t *testing.T) {
+	ci.Parallel(t)
+	b := testBroker(t, 0)
+	b.SetEnabled(true)
+	b.SetNamespaceWeightFn(func(ns string) int {
+		if ns == "heavy" {
+			return 3
+		}
+		return 0
+	})
+
+	for i := uint64(1); i <= 8; i++ {
+		for _, ns := range []string{"heavy", "light"} {
+			eval := mock.Eval()
+			eval.Namespace = ns
+			eval.CreateIndex = i
+			b.Enqueue(eval)
+		}
+	}
+
+	// The heavy namespace gets three dequeues for every one of the light
+	// namespace, which falls back to the default weight
+	counts := map[string]int{}
+	for i := 0; i < 8; i++ {
+		out, _, err := b.Dequeue(defaultSched, time.Second)
+		must.NoError(t, err)
+		counts[out.Namespace]++
+	}
+	must.Eq(t, map[string]int{"heavy": 6, "light": 2}, counts)
+}
+
+func TestEvalBroker_NamespaceFairShare_Priority(t *testing.T) {
+	ci.Parallel(t)
+	b := testBroker(t, 0)
+	b.SetEnabled(true)
+
+	// Within a namespace evaluations are still dequeued by priority
+	low := mock.Eval()
+	low.Namespace = "ns1"
+	low.Priority = 10
+	b.Enqueue(low)
+
+	high := mock.Eval()
+	high.Namespace = "ns1"
+	high.Priority = 90
+	b.Enqueue(high)
+
+	out, _, err := b.Dequeue(defaultSched, time.Second)
+	must.NoError(t, err)
+	must.Eq(t, high.ID, out.ID)
+
+	out, _, err = b.Dequeue(defaultSched, time.Second)
+	must.NoError(t, err)
+	must.Eq(t, low.ID, out.ID)
+}
+
 func TestEvalBroker_PendingEvals_Ordering(t *testing.T) {
 
 	ready := PendingEvaluations{}
@@ -1536,6 +1634,7 @@ func TestEvalBroker_IntegrationTest(t *testing.T) {
 		stats := srv.evalBroker.Stats()
 		stats.DelayedEvals = nil
 		stats.ByScheduler = nil
+		stats.ByNamespace = nil
 		return *stats
 	}
 
@@ -61,6 +61,7 @@ const (
 	ACLRoleSnapshot                      SnapshotType = 25
 	ACLAuthMethodSnapshot                SnapshotType = 26
 	ACLBindingRuleSnapshot               SnapshotType = 27
+	NodePoolSnapshot                     SnapshotType = 28
 
 	// Namespace appliers were moved from enterprise and therefore start at 64
 	NamespaceSnapshot SnapshotType = 64
@@ -338,6 +339,10 @@ func (n *nomadFSM) Apply(log *raft.Log) interface{} {
 		return n.applyACLBindingRulesUpsert(buf[1:], log.Index)
 	case structs.ACLBindingRulesDeleteRequestType:
 		return n.applyACLBindingRulesDelete(buf[1:], log.Index)
+	case structs.NodePoolUpsertRequestType:
+		return n.applyNodePoolUpsert(msgType, buf[1:], log.Index)
+	case structs.NodePoolDeleteRequestType:
+		return n.applyNodePoolDelete(msgType, buf[1:], log.Index)
 	}
 
 	// Check enterprise only message types.
@@ -1809,6 +1814,18 @@ func (n *nomadFSM) restoreImpl(old io.ReadCloser, filter *FSMFilter) error {
 				return err
 			}
 
+		case NodePoolSnapshot:
+			pool := new(structs.NodePool)
+
+			if err := dec.Decode(pool); err != nil {
+				return err
+			}
+
+			// Perform the restoration.
+			if err := restore.NodePoolRestore(pool); err != nil {
+				return err
+			}
+
 		default:
 			// Check if this is an enterprise only object being restored
 			restorer, ok := n.enterpriseRestorers[snapType]
@@ -2158,6 +2175,36 @@ func (n *nomadFSM) applyACLBindingRulesDelete(buf []byte, index uint64) interfac
 	return nil
 }
 
+func (n *nomadFSM) applyNodePoolUpsert(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_node_pool_upsert"}, time.Now())
+	var req structs.NodePoolUpsertRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.UpsertNodePools(msgType, index, req.NodePools); err != nil {
+		n.logger.Error("UpsertNodePools failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (n *nomadFSM) applyNodePoolDelete(msgType structs.MessageType, buf []byte, index uint64) interface{} {
+	defer metrics.MeasureSince([]string{"nomad", "fsm", "apply_node_pool_delete"}, time.Now())
+	var req structs.NodePoolDeleteRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	if err := n.state.DeleteNodePools(msgType, index, req.Names); err != nil {
+		n.logger.Error("DeleteNodePools failed", "error", err)
+		return err
+	}
+
+	return nil
+}
+
 type FSMFilter struct {
 	evaluator *bexpr.Evaluator
 }
@@ -2371,6 +2418,10 @@ func (s *nomadSnapshot) Persist(sink raft.SnapshotSink) error {
 		sink.Cancel()
 		return err
 	}
+	if err := s.persistNodePools(sink, encoder); err != nil {
+		sink.Cancel()
+		return err
+	}
 	return nil
 }
 
@@ -3066,6 +3117,27 @@ func (s *nomadSnapshot) persistACLBindingRules(sink raft.SnapshotSink, encoder *
 	return nil
 }
 
+func (s *nomadSnapshot) persistNodePools(sink raft.SnapshotSink, encoder *codec.Encoder) error {
+
+	// Get all the node pools.
+	ws := memdb.NewWatchSet()
+	iter, err := s.snap.NodePools(ws, state.SortDefault)
+	if err != nil {
+		return err
+	}
+
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		pool := raw.(*structs.NodePool)
+
+		// write the snapshot
+		sink.Write([]byte{byte(NodePoolSnapshot)})
+		if err := encoder.Encode(pool); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Release is a no-op, as we just need to GC the pointer
 // to the state store snapshot. There is nothing to explicitly
 // cleanup.
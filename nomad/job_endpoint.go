@@ -34,6 +34,14 @@ const (
 	// DispatchPayloadSizeLimit is the maximum size of the uncompressed input
 	// data payload.
 	DispatchPayloadSizeLimit = 16 * 1024
+
+	// DispatchArrayCountLimit is the maximum number of instances of an array
+	// dispatched job.
+	DispatchArrayCountLimit = 10000
+
+	// DispatchArrayAllocsLimit is the maximum number of allocations of an
+	// array dispatched job, summed over its task groups and instances.
+	DispatchArrayAllocsLimit = 10000
 )
 
 // ErrMultipleNamespaces is send when multiple namespaces are used in the OSS setup
@@ -70,12 +78,14 @@ func NewJobEndpoints(s *Server, ctx *RPCContext) *Job {
 			jobConnectHook{},
 			jobExposeCheckHook{},
 			jobImpliedConstraints{},
+			jobNodePoolMutatingHook{srv: s},
 		},
 		validators: []jobValidator{
 			jobConnectHook{},
 			jobExposeCheckHook{},
 			jobVaultHook{srv: s},
 			jobNamespaceConstraintCheckHook{srv: s},
+			jobNodePoolValidatingHook{srv: s},
 			jobValidate{},
 			&memoryOversubscriptionValidate{srv: s},
 		},
@@ -172,6 +182,14 @@ func (j *Job) Register(args *structs.JobRegisterRequest, reply *structs.JobRegis
 			}
 		}
 
+		// Allocation affinities disclose where the allocations of the jobs
+		// they target run, so they require reading those jobs
+		if ok, err := allowAllocAffinities(aclObj, j.srv.State(), args.Job); err != nil {
+			return err
+		} else if !ok {
+			return structs.ErrPermissionDenied
+		}
+
 		// Check if override is set and we do not have permissions
 		if args.PolicyOverride {
 			if !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilitySentinelOverride) {
@@ -1298,6 +1316,42 @@ func allowedNSes(aclObj *acl.ACL, state *state.StateStore, allow func(ns string)
 	return r, nil
 }
 
+// allowAllocAffinities returns whether a token can read the jobs of every
+// namespace targeted by the allocation affinities of job. Affinities targeting
+// all namespaces require reading jobs in every namespace.
+func allowAllocAffinities(aclObj *acl.ACL, state *state.StateStore, job *structs.Job) (bool, error) {
+	if aclObj == nil || aclObj.IsManagement() {
+		return true, nil
+	}
+
+	affinities := append([]*structs.AllocAffinity{}, job.AllocAffinities...)
+	for _, tg := range job.TaskGroups {
+		affinities = append(affinities, tg.AllocAffinities...)
+	}
+
+	for _, affinity := range affinities {
+		switch affinity.Namespace {
+		case "", job.Namespace:
+			continue
+		case structs.AllocAffinityAllNamespaces:
+			nses, err := state.NamespaceNames()
+			if err != nil {
+				return false, err
+			}
+			for _, ns := range nses {
+				if !aclObj.AllowNsOp(ns, acl.NamespaceCapabilityReadJob) {
+					return false, nil
+				}
+			}
+		default:
+			if !aclObj.AllowNsOp(affinity.Namespace, acl.NamespaceCapabilityReadJob) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
 // registrationsAreAllowed checks that the scheduler is not in
 // RejectJobRegistration mode for load-shedding.
 func registrationsAreAllowed(aclObj *acl.ACL, state *state.StateStore) (bool, error) {
@@ -1635,6 +1689,11 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 		if !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilitySubmitJob) {
 			return structs.ErrPermissionDenied
 		}
+		if ok, err := allowAllocAffinities(aclObj, j.srv.State(), args.Job); err != nil {
+			return err
+		} else if !ok {
+			return structs.ErrPermissionDenied
+		}
 		// Check if override is set and we do not have permissions
 		if args.PolicyOverride {
 			if !aclObj.AllowNsOp(args.RequestNamespace(), acl.NamespaceCapabilitySentinelOverride) {
@@ -1720,6 +1779,7 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 		JobModifyIndex: updatedIndex,
 		Status:         structs.EvalStatusPending,
 		AnnotatePlan:   true,
+		ExplainPlan:    args.Explain,
 		// Timestamps are added for consistency but this eval is never persisted
 		CreateTime: now,
 		ModifyTime: now,
@@ -1769,7 +1829,7 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 
 	// If it is a periodic job calculate the next launch
 	if args.Job.IsPeriodic() && args.Job.Periodic.Enabled {
-		reply.NextPeriodicLaunch, err = args.Job.Periodic.Next(time.Now().In(args.Job.Periodic.GetLocation()))
+		reply.NextPeriodicLaunch, err = nextLaunchTime(args.Job, time.Now().In(args.Job.Periodic.GetLocation()))
 		if err != nil {
 			return fmt.Errorf("Failed to parse cron expression: %v", err)
 		}
@@ -1780,9 +1840,37 @@ func (j *Job) Plan(args *structs.JobPlanRequest, reply *structs.JobPlanResponse)
 	reply.Annotations = annotations
 	reply.CreatedEvals = planner.CreateEvals
 	reply.Index = index
+
+	if args.Explain {
+		reply.Explanations = placementExplanations(planner.Plans[0])
+	}
 	return nil
 }
 
+// placementExplanations returns the explanation of each placement of the
+// plan, sorted by allocation name.
+func placementExplanations(plan *structs.Plan) []*structs.PlacementExplanation {
+	var explanations []*structs.PlacementExplanation
+	for nodeID, allocs := range plan.NodeAllocation {
+		for _, alloc := range allocs {
+			// In-place updates don't go through the rank stack
+			if alloc.Metrics == nil || alloc.Metrics.NodeExplanations == nil {
+				continue
+			}
+			explanations = append(explanations, &structs.PlacementExplanation{
+				AllocName: alloc.Name,
+				TaskGroup: alloc.TaskGroup,
+				NodeID:    nodeID,
+				Metrics:   alloc.Metrics,
+			})
+		}
+	}
+	sort.Slice(explanations, func(i, j int) bool {
+		return explanations[i].AllocName < explanations[j].AllocName
+	})
+	return explanations
+}
+
 // validateJobUpdate ensures updates to a job are valid.
 func validateJobUpdate(old, new *structs.Job) error {
 	// Validate Dispatch not set on new Jobs
@@ -1920,6 +2008,14 @@ func (j *Job) Dispatch(args *structs.JobDispatchRequest, reply *structs.JobDispa
 	dispatchJob.StatusDescription = ""
 	dispatchJob.DispatchIdempotencyToken = args.IdempotencyToken
 
+	// Array jobs run each task group once per instance
+	if args.ArrayCount > 0 {
+		dispatchJob.ArrayCount = args.ArrayCount
+		for _, tg := range dispatchJob.TaskGroups {
+			tg.Count *= args.ArrayCount
+		}
+	}
+
 	// Merge in the meta data
 	for k, v := range args.Meta {
 		if dispatchJob.Meta == nil {
@@ -2004,6 +2100,26 @@ func validateDispatchRequest(req *structs.JobDispatchRequest, job *structs.Job)
 		return fmt.Errorf("Payload exceeds maximum size; %d > %d", l, DispatchPayloadSizeLimit)
 	}
 
+	// Check the array count is within bounds
+	if req.ArrayCount < 0 {
+		return fmt.Errorf("Array count can not be less than zero: %d < 0", req.ArrayCount)
+	} else if req.ArrayCount > DispatchArrayCountLimit {
+		return fmt.Errorf("Array count exceeds maximum; %d > %d", req.ArrayCount, DispatchArrayCountLimit)
+	}
+
+	// Check the allocations of every instance are within bounds. The counts
+	// are checked one group at a time so the product can't overflow.
+	if req.ArrayCount > 0 {
+		allocs := 0
+		for _, tg := range job.TaskGroups {
+			if tg.Count > (DispatchArrayAllocsLimit-allocs)/req.ArrayCount {
+				return fmt.Errorf("Array count %d exceeds maximum allocations of the dispatched job; more than %d",
+					req.ArrayCount, DispatchArrayAllocsLimit)
+			}
+			allocs += tg.Count * req.ArrayCount
+		}
+	}
+
 	// Check if the metadata is a set
 	keys := make(map[string]struct{}, len(req.Meta))
 	for k := range req.Meta {
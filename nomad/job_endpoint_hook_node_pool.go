@@ -0,0 +1,75 @@
+package nomad
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// jobNodePoolMutatingHook is an admission controller that sets the job node
+// pool to the default node pool of its namespace when it isn't set.
+type jobNodePoolMutatingHook struct {
+	srv *Server
+}
+
+func (jobNodePoolMutatingHook) Name() string {
+	return "node-pool-mutation"
+}
+
+func (c jobNodePoolMutatingHook) Mutate(job *structs.Job) (*structs.Job, []error, error) {
+	if job.NodePool != "" {
+		return job, nil, nil
+	}
+
+	ns, err := c.srv.State().NamespaceByName(nil, job.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ns == nil {
+		// Leave the job untouched and let the namespace validator report
+		// the missing namespace.
+		return job, nil, nil
+	}
+
+	job.NodePool = ns.NodePoolConfiguration.DefaultPool()
+	return job, nil, nil
+}
+
+// jobNodePoolValidatingHook is an admission controller that ensures the job
+// node pool exists and that it is allowed in the job namespace.
+type jobNodePoolValidatingHook struct {
+	srv *Server
+}
+
+func (jobNodePoolValidatingHook) Name() string {
+	return "node-pool-validation"
+}
+
+func (c jobNodePoolValidatingHook) Validate(job *structs.Job) ([]error, error) {
+	poolName := job.NodePool
+	if poolName == "" {
+		poolName = structs.NodePoolDefault
+	}
+
+	pool, err := c.srv.State().NodePoolByName(nil, poolName)
+	if err != nil {
+		return nil, err
+	}
+	if pool == nil {
+		return nil, fmt.Errorf("job %q is in nonexistent node pool %q", job.ID, poolName)
+	}
+
+	ns, err := c.srv.State().NamespaceByName(nil, job.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if ns == nil {
+		// The namespace validator reports this error.
+		return nil, nil
+	}
+	if !ns.NodePoolConfiguration.IsAllowed(poolName) {
+		return nil, fmt.Errorf("node pool %q is not allowed in namespace %q", poolName, ns.Name)
+	}
+
+	return nil, nil
+}
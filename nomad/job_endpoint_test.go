@@ -2420,6 +2420,83 @@ func TestJobEndpoint_Register_ACL_Namespace(t *testing.T) {
 	assert.NotNil(out, "expected job")
 }
 
+func TestJobEndpoint_Register_ACL_AllocAffinity(t *testing.T) {
+	ci.Parallel(t)
+	s1, root, cleanupS1 := TestACLServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	state := s1.fsm.State()
+	ns1 := mock.Namespace()
+	ns1.Name = "readable"
+	ns2 := mock.Namespace()
+	ns2.Name = "private"
+	must.NoError(t, state.UpsertNamespaces(1000, []*structs.Namespace{ns1, ns2}))
+
+	// Token that can submit jobs in the default namespace and only read jobs
+	// in one of the other namespaces
+	token := mock.CreatePolicyAndToken(t, state, 1001, "alloc-affinity",
+		mock.NamespacePolicy(structs.DefaultNamespace, "write", nil)+
+			mock.NamespacePolicy(ns1.Name, "read", nil))
+
+	testCases := []struct {
+		name      string
+		namespace string
+		token     string
+		allowed   bool
+	}{
+		{name: "same namespace", namespace: "", token: token.SecretID, allowed: true},
+		{name: "readable namespace", namespace: ns1.Name, token: token.SecretID, allowed: true},
+		{name: "private namespace", namespace: ns2.Name, token: token.SecretID, allowed: false},
+		{name: "all namespaces", namespace: structs.AllocAffinityAllNamespaces, token: token.SecretID, allowed: false},
+		{name: "all namespaces management", namespace: structs.AllocAffinityAllNamespaces, token: root.SecretID, allowed: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			job := mock.Job()
+			job.TaskGroups[0].AllocAffinities = []*structs.AllocAffinity{
+				{Namespace: tc.namespace, JobID: "cache", Weight: 50},
+			}
+
+			planReq := &structs.JobPlanRequest{
+				Job: job,
+				WriteRequest: structs.WriteRequest{
+					Region:    "global",
+					Namespace: job.Namespace,
+					AuthToken: tc.token,
+				},
+			}
+			var planResp structs.JobPlanResponse
+			err := msgpackrpc.CallWithCodec(codec, "Job.Plan", planReq, &planResp)
+			if tc.allowed {
+				must.NoError(t, err)
+			} else {
+				must.EqError(t, err, structs.ErrPermissionDenied.Error())
+			}
+
+			req := &structs.JobRegisterRequest{
+				Job: job,
+				WriteRequest: structs.WriteRequest{
+					Region:    "global",
+					Namespace: job.Namespace,
+					AuthToken: tc.token,
+				},
+			}
+			var resp structs.JobRegisterResponse
+			err = msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp)
+			if tc.allowed {
+				must.NoError(t, err)
+			} else {
+				must.EqError(t, err, structs.ErrPermissionDenied.Error())
+			}
+		})
+	}
+}
+
 func TestJobRegister_ACL_RejectedBySchedulerConfig(t *testing.T) {
 	ci.Parallel(t)
 	s1, root, cleanupS1 := TestACLServer(t, func(c *Config) {
@@ -6255,6 +6332,78 @@ func TestJobEndpoint_Plan_WithDiff(t *testing.T) {
 	}
 }
 
+func TestJobEndpoint_Plan_Explain(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// Create a node the job fits on and a node filtered by its constraint
+	state := s1.fsm.State()
+	node1 := mock.Node()
+	require.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 1000, node1))
+	node2 := mock.Node()
+	node2.Attributes["kernel.name"] = "windows"
+	require.NoError(t, node2.ComputeClass())
+	require.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 1001, node2))
+
+	job := mock.Job()
+	job.TaskGroups[0].Count = 1
+	planReq := &structs.JobPlanRequest{
+		Job:     job,
+		Explain: true,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+
+	var planResp structs.JobPlanResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Plan", planReq, &planResp))
+
+	require.Len(t, planResp.Explanations, 1)
+	explanation := planResp.Explanations[0]
+	require.Equal(t, node1.ID, explanation.NodeID)
+	require.Equal(t, job.TaskGroups[0].Name, explanation.TaskGroup)
+
+	nodes := explanation.Metrics.NodeExplanations
+	require.Len(t, nodes, 2)
+	require.Empty(t, nodes[node1.ID].Filtered)
+	require.Contains(t, nodes[node1.ID].Scores, "binpack")
+	require.NotZero(t, nodes[node1.ID].NormScore)
+	require.Contains(t, nodes[node2.ID].Filtered, "kernel.name")
+
+	// Without explain no explanations are returned
+	planReq.Explain = false
+	planResp = structs.JobPlanResponse{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Plan", planReq, &planResp))
+	require.Empty(t, planResp.Explanations)
+
+	// Task groups that fail to place explain every node in their metrics
+	for _, job := range []*structs.Job{mock.Job(), mock.SystemJob()} {
+		job.Constraints = append(job.Constraints, &structs.Constraint{
+			LTarget: "${attr.kernel.name}",
+			RTarget: "darwin",
+			Operand: "=",
+		})
+		planReq.Job = job
+		planReq.Explain = true
+		planResp = structs.JobPlanResponse{}
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Plan", planReq, &planResp))
+		require.Empty(t, planResp.Explanations)
+
+		metrics := planResp.FailedTGAllocs[job.TaskGroups[0].Name]
+		require.NotNil(t, metrics, "job type %s", job.Type)
+		require.Len(t, metrics.NodeExplanations, 2, "job type %s", job.Type)
+		require.Contains(t, metrics.NodeExplanations[node1.ID].Filtered, "kernel.name")
+		require.Contains(t, metrics.NodeExplanations[node2.ID].Filtered, "kernel.name")
+	}
+}
+
 func TestJobEndpoint_Plan_NoDiff(t *testing.T) {
 	ci.Parallel(t)
 
@@ -7184,6 +7333,75 @@ func TestJobEndpoint_Dispatch_JobChildrenSummary(t *testing.T) {
 	require.Equal(t, structs.JobStatusDead, dispatchedStatus())
 }
 
+// TestJobEndpoint_Dispatch_Array asserts that array dispatches create a single
+// child job running each task group once per instance.
+func TestJobEndpoint_Dispatch_Array(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer cleanupS1()
+
+	state := s1.fsm.State()
+
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	parameterizedJob := mock.BatchJob()
+	parameterizedJob.TaskGroups[0].Count = 2
+	parameterizedJob.ParameterizedJob = &structs.ParameterizedJobConfig{}
+
+	regReq := &structs.JobRegisterRequest{
+		Job: parameterizedJob,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: parameterizedJob.Namespace,
+		},
+	}
+	var regResp structs.JobRegisterResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Register", regReq, &regResp))
+
+	dispatchReq := &structs.JobDispatchRequest{
+		JobID:      parameterizedJob.ID,
+		ArrayCount: -1,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: parameterizedJob.Namespace,
+		},
+	}
+
+	// Negative and oversized counts are rejected
+	var dispatchResp structs.JobDispatchResponse
+	err := msgpackrpc.CallWithCodec(codec, "Job.Dispatch", dispatchReq, &dispatchResp)
+	require.ErrorContains(t, err, "Array count can not be less than zero")
+
+	dispatchReq.ArrayCount = DispatchArrayCountLimit + 1
+	err = msgpackrpc.CallWithCodec(codec, "Job.Dispatch", dispatchReq, &dispatchResp)
+	require.ErrorContains(t, err, "Array count exceeds maximum")
+
+	// The group count of 2 makes this exceed the allocations limit
+	dispatchReq.ArrayCount = DispatchArrayAllocsLimit/2 + 1
+	err = msgpackrpc.CallWithCodec(codec, "Job.Dispatch", dispatchReq, &dispatchResp)
+	require.ErrorContains(t, err, "exceeds maximum allocations of the dispatched job")
+
+	dispatchReq.ArrayCount = 5
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Dispatch", dispatchReq, &dispatchResp))
+	require.NotEmpty(t, dispatchResp.EvalID)
+
+	dispatchedJob, err := state.JobByID(nil, parameterizedJob.Namespace, dispatchResp.DispatchedJobID)
+	require.NoError(t, err)
+	require.NotNil(t, dispatchedJob)
+	require.Equal(t, 5, dispatchedJob.ArrayCount)
+	require.Equal(t, 10, dispatchedJob.TaskGroups[0].Count)
+
+	// The parameterized job is left untouched
+	out, err := state.JobByID(nil, parameterizedJob.Namespace, parameterizedJob.ID)
+	require.NoError(t, err)
+	require.Zero(t, out.ArrayCount)
+	require.Equal(t, 2, out.TaskGroups[0].Count)
+}
+
 func TestJobEndpoint_Dispatch_ACL_RejectedBySchedulerConfig(t *testing.T) {
 	ci.Parallel(t)
 	s1, root, cleanupS1 := TestACLServer(t, nil)
@@ -8370,3 +8588,64 @@ func TestJob_GetServiceRegistrations(t *testing.T) {
 		})
 	}
 }
+
+func TestJobEndpoint_Register_NodePool(t *testing.T) {
+	ci.Parallel(t)
+
+	s, cleanupS := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0 // Prevent automatic dequeue
+	})
+	defer cleanupS()
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	// Jobs without a node pool use the namespace default.
+	job := mock.Job()
+	req := &structs.JobRegisterRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			Namespace: job.Namespace,
+		},
+	}
+	var resp structs.JobRegisterResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp))
+
+	out, err := s.fsm.State().JobByID(nil, job.Namespace, job.ID)
+	must.NoError(t, err)
+	must.Eq(t, structs.NodePoolDefault, out.NodePool)
+
+	// Jobs in a nonexistent node pool are rejected.
+	job = mock.Job()
+	job.NodePool = "nope"
+	req.Job = job
+	err = msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp)
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), "nonexistent node pool")
+
+	// Jobs in node pools not allowed by the namespace are rejected.
+	pool := mock.NodePool()
+	ns := mock.Namespace()
+	ns.NodePoolConfiguration = &structs.NamespaceNodePoolConfiguration{
+		Default: pool.Name,
+		Allowed: []string{pool.Name},
+	}
+	must.NoError(t, s.fsm.State().UpsertNodePools(structs.MsgTypeTestSetup, 1000, []*structs.NodePool{pool}))
+	must.NoError(t, s.fsm.State().UpsertNamespaces(1001, []*structs.Namespace{ns}))
+
+	job = mock.Job()
+	job.Namespace = ns.Name
+	job.NodePool = structs.NodePoolAll
+	req.Job = job
+	req.Namespace = ns.Name
+	err = msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp)
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), "is not allowed in namespace")
+
+	job.NodePool = ""
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "Job.Register", req, &resp))
+
+	out, err = s.fsm.State().JobByID(nil, job.Namespace, job.ID)
+	must.NoError(t, err)
+	must.Eq(t, pool.Name, out.NodePool)
+}
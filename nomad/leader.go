@@ -760,7 +760,7 @@ func (s *Server) restorePeriodicDispatcher() error {
 		}
 
 		// nextLaunch is the next launch that should occur.
-		nextLaunch, err := job.Periodic.Next(launch.Launch.In(job.Periodic.GetLocation()))
+		nextLaunch, err := nextLaunchTime(job, launch.Launch.In(job.Periodic.GetLocation()))
 		if err != nil {
 			logger.Error("failed to determine next periodic launch for job", "job", job.NamespacedID(), "error", err)
 			continue
@@ -773,6 +773,17 @@ func (s *Server) restorePeriodicDispatcher() error {
 			continue
 		}
 
+		// Jobs with a catchup window have each of their missed launches
+		// within the window dispatched, others are launched once.
+		if job.Periodic.CatchupWindow > 0 {
+			if _, err := s.periodicDispatcher.CatchUp(job.Namespace, job.ID, launch.Launch); err != nil {
+				logger.Error("catch up of periodic job failed", "job", job.NamespacedID(), "error", err)
+				return fmt.Errorf("catch up of periodic job %q failed: %v", job.NamespacedID(), err)
+			}
+			logger.Debug("periodic job caught up during leadership establishment", "job", job.NamespacedID())
+			continue
+		}
+
 		if _, err := s.periodicDispatcher.ForceRun(job.Namespace, job.ID); err != nil {
 			logger.Error("force run of periodic job failed", "job", job.NamespacedID(), "error", err)
 			return fmt.Errorf("force run of periodic job %q failed: %v", job.NamespacedID(), err)
@@ -803,6 +814,8 @@ func (s *Server) schedulePeriodic(stopCh chan struct{}) {
 	defer rootKeyGC.Stop()
 	variablesRekey := time.NewTicker(s.config.VariablesRekeyInterval)
 	defer variablesRekey.Stop()
+	rebalance := time.NewTicker(s.config.RebalanceInterval)
+	defer rebalance.Stop()
 
 	// Set up the expired ACL local token garbage collection timer.
 	localTokenExpiredGC, localTokenExpiredGCStop := helper.NewSafeTimer(s.config.ACLTokenExpirationGCInterval)
@@ -856,6 +869,10 @@ func (s *Server) schedulePeriodic(stopCh chan struct{}) {
 			if index, ok := s.getLatestIndex(); ok {
 				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobVariablesRekey, index))
 			}
+		case <-rebalance.C:
+			if index, ok := s.getLatestIndex(); ok {
+				s.evalBroker.Enqueue(s.coreJobEval(structs.CoreJobRebalance, index))
+			}
 		case <-stopCh:
 			return
 		}
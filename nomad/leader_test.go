@@ -438,6 +438,55 @@ func TestLeader_PeriodicDispatcher_Restore_NoEvals(t *testing.T) {
 	}
 }
 
+func TestLeader_PeriodicDispatcher_Restore_CatchUp(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0
+	})
+	defer cleanupS1()
+	testutil.WaitForLeader(t, s1.RPC)
+
+	// Inject a periodic job with a catchup window that will be triggered
+	// twice soon.
+	now := time.Now().Round(1 * time.Second)
+	launch1 := now.Add(1 * time.Second)
+	launch2 := now.Add(2 * time.Second)
+	job := testPeriodicJob(launch1, launch2)
+	job.Periodic.CatchupWindow = time.Hour
+	req := structs.JobRegisterRequest{
+		Job: job,
+		WriteRequest: structs.WriteRequest{
+			Namespace: job.Namespace,
+		},
+	}
+	_, _, err := s1.raftApply(structs.JobRegisterRequestType, req)
+	require.NoError(t, err)
+
+	// Flush the periodic dispatcher, ensuring that no evals will be created.
+	s1.periodicDispatcher.SetEnabled(false)
+
+	// Sleep till after the job should have been launched twice.
+	time.Sleep(3 * time.Second)
+
+	// Restore the periodic dispatcher.
+	s1.periodicDispatcher.SetEnabled(true)
+	require.NoError(t, s1.restorePeriodicDispatcher())
+
+	// Check that both missed launches were dispatched.
+	ws := memdb.NewWatchSet()
+	for _, launch := range []time.Time{launch1, launch2} {
+		id := s1.periodicDispatcher.derivedJobID(job, launch)
+		child, err := s1.fsm.State().JobByID(ws, job.Namespace, id)
+		require.NoError(t, err)
+		require.NotNil(t, child, "missed launch %v not dispatched", launch)
+	}
+
+	last, err := s1.fsm.State().PeriodicLaunchByID(ws, job.Namespace, job.ID)
+	require.NoError(t, err)
+	require.Equal(t, launch2.Unix(), last.Launch.Unix())
+}
+
 func TestLeader_PeriodicDispatcher_Restore_Evals(t *testing.T) {
 	ci.Parallel(t)
 
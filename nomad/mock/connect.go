@@ -206,6 +206,7 @@ func BatchConnectJob() *structs.Job {
 		Priority:    50,
 		AllAtOnce:   false,
 		Datacenters: []string{"dc1"},
+		NodePool:    structs.NodePoolDefault,
 		TaskGroups: []*structs.TaskGroup{{
 			Name:          "mock-connect-batch-job",
 			Count:         1,
@@ -18,6 +18,7 @@ func Job() *structs.Job {
 		Priority:    50,
 		AllAtOnce:   false,
 		Datacenters: []string{"dc1"},
+		NodePool:    structs.NodePoolDefault,
 		Constraints: []*structs.Constraint{
 			{
 				LTarget: "${attr.kernel.name}",
@@ -228,6 +229,7 @@ func SystemBatchJob() *structs.Job {
 		Type:        structs.JobTypeSysBatch,
 		Priority:    10,
 		Datacenters: []string{"dc1"},
+		NodePool:    structs.NodePoolDefault,
 		Constraints: []*structs.Constraint{
 			{
 				LTarget: "${attr.kernel.name}",
@@ -297,6 +299,7 @@ func BatchJob() *structs.Job {
 		Priority:    50,
 		AllAtOnce:   false,
 		Datacenters: []string{"dc1"},
+		NodePool:    structs.NodePoolDefault,
 		TaskGroups: []*structs.TaskGroup{
 			{
 				Name:  "web",
@@ -363,6 +366,7 @@ func SystemJob() *structs.Job {
 		Priority:    100,
 		AllAtOnce:   false,
 		Datacenters: []string{"dc1"},
+		NodePool:    structs.NodePoolDefault,
 		Constraints: []*structs.Constraint{
 			{
 				LTarget: "${attr.kernel.name}",
@@ -440,6 +444,7 @@ func MaxParallelJob() *structs.Job {
 		Priority:    50,
 		AllAtOnce:   false,
 		Datacenters: []string{"dc1"},
+		NodePool:    structs.NodePoolDefault,
 		Constraints: []*structs.Constraint{
 			{
 				LTarget: "${attr.kernel.name}",
@@ -182,6 +182,7 @@ func LifecycleJobWithPoststopDeploy() *structs.Job {
 		Priority:    50,
 		AllAtOnce:   false,
 		Datacenters: []string{"dc1"},
+		NodePool:    structs.NodePoolDefault,
 		Constraints: []*structs.Constraint{
 			{
 				LTarget: "${attr.kernel.name}",
@@ -286,6 +287,7 @@ func LifecycleJobWithPoststartDeploy() *structs.Job {
 		Priority:    50,
 		AllAtOnce:   false,
 		Datacenters: []string{"dc1"},
+		NodePool:    structs.NodePoolDefault,
 		Constraints: []*structs.Constraint{
 			{
 				LTarget: "${attr.kernel.name}",
@@ -552,6 +554,7 @@ func VariableLifecycleJob(resources structs.Resources, main int, init int, side
 		Priority:    50,
 		AllAtOnce:   false,
 		Datacenters: []string{"dc1"},
+		NodePool:    structs.NodePoolDefault,
 		Constraints: []*structs.Constraint{
 			{
 				LTarget: "${attr.kernel.name}",
@@ -589,6 +592,7 @@ func LifecycleJob() *structs.Job {
 		Priority:    50,
 		AllAtOnce:   false,
 		Datacenters: []string{"dc1"},
+		NodePool:    structs.NodePoolDefault,
 		Constraints: []*structs.Constraint{
 			{
 				LTarget: "${attr.kernel.name}",
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/structs"
 	psstructs "github.com/hashicorp/nomad/plugins/shared/structs"
@@ -11,6 +13,7 @@ func Node() *structs.Node {
 		ID:         uuid.Generate(),
 		SecretID:   uuid.Generate(),
 		Datacenter: "dc1",
+		NodePool:   structs.NodePoolDefault,
 		Name:       "foobar",
 		Drivers: map[string]*structs.DriverInfo{
 			"exec": {
@@ -152,3 +155,13 @@ func NvidiaNode() *structs.Node {
 	_ = n.ComputeClass()
 	return n
 }
+
+// NodePool returns a random node pool.
+func NodePool() *structs.NodePool {
+	id := uuid.Short()
+	return &structs.NodePool{
+		Name:        fmt.Sprintf("pool-%s", id),
+		Description: "test node pool",
+		Meta:        map[string]string{"team": id},
+	}
+}
@@ -137,6 +137,17 @@ func (n *Node) Register(args *structs.NodeRegisterRequest, reply *structs.NodeUp
 		args.Node.SchedulingEligibility = structs.NodeSchedulingEligible
 	}
 
+	// Default the node pool if none is given
+	if args.Node.NodePool == "" {
+		args.Node.NodePool = structs.NodePoolDefault
+	}
+	if !structs.ValidNodePoolName(args.Node.NodePool) {
+		return fmt.Errorf("invalid node pool %q for node", args.Node.NodePool)
+	}
+	if args.Node.NodePool == structs.NodePoolAll {
+		return fmt.Errorf("node is not allowed to register in node pool %q", structs.NodePoolAll)
+	}
+
 	// Set the timestamp when the node is registered
 	args.Node.StatusUpdatedAt = time.Now().Unix()
 
@@ -1202,6 +1213,14 @@ func (n *Node) UpdateAlloc(args *structs.AllocUpdateRequest, reply *structs.Gene
 			continue
 		}
 
+		// Allocations reaching the condition other task groups of the job
+		// depend on unblock the placements of those groups.
+		if allocToUpdate.ClientStatus != alloc.ClientStatus && alloc.ClientStatus != structs.AllocClientStatusUnknown {
+			if eval := n.groupDependencyEval(alloc, allocToUpdate.ClientStatus, now); eval != nil {
+				evals = append(evals, eval)
+			}
+		}
+
 		if !allocToUpdate.TerminalStatus() && alloc.ClientStatus != structs.AllocClientStatusUnknown {
 			continue
 		}
@@ -1318,6 +1337,42 @@ func (n *Node) UpdateAlloc(args *structs.AllocUpdateRequest, reply *structs.Gene
 	return nil
 }
 
+// groupDependencyEval returns an evaluation for the job of the allocation if
+// its new client status satisfies the dependency of another task group on the
+// allocation's group. Health changes of allocations placed by a deployment
+// are handled by the deployment watcher instead.
+func (n *Node) groupDependencyEval(alloc *structs.Allocation, clientStatus string, now time.Time) *structs.Evaluation {
+	var condition string
+	switch {
+	case clientStatus == structs.AllocClientStatusComplete:
+		condition = structs.GroupDependencyComplete
+	case clientStatus == structs.AllocClientStatusRunning && alloc.DeploymentID == "":
+		condition = structs.GroupDependencyHealthy
+	default:
+		return nil
+	}
+
+	job, err := n.srv.State().JobByID(nil, alloc.Namespace, alloc.JobID)
+	if err != nil || job == nil || job.Stopped() {
+		return nil
+	}
+	if !job.HasGroupDependency(alloc.TaskGroup, condition) {
+		return nil
+	}
+
+	return &structs.Evaluation{
+		ID:          uuid.Generate(),
+		Namespace:   alloc.Namespace,
+		TriggeredBy: structs.EvalTriggerGroupDependency,
+		JobID:       alloc.JobID,
+		Type:        job.Type,
+		Priority:    job.Priority,
+		Status:      structs.EvalStatusPending,
+		CreateTime:  now.UTC().UnixNano(),
+		ModifyTime:  now.UTC().UnixNano(),
+	}
+}
+
 // batchUpdate is used to update all the allocations
 func (n *Node) batchUpdate(future *structs.BatchFuture, updates []*structs.Allocation, evals []*structs.Evaluation) {
 	var mErr multierror.Error
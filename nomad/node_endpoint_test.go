@@ -2530,6 +2530,62 @@ func TestClientEndpoint_UpdateAlloc(t *testing.T) {
 
 }
 
+func TestClientEndpoint_UpdateAlloc_GroupDependency(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, cleanupS1 := TestServer(t, func(c *Config) {
+		c.NumSchedulers = 0
+	})
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+
+	node := mock.Node()
+	reg := &structs.NodeRegisterRequest{
+		Node:         node,
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var resp structs.GenericResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Node.Register", reg, &resp))
+
+	// Inject a batch job whose web group waits for the migrate group to
+	// complete
+	state := s1.fsm.State()
+	job := mock.BatchJob()
+	migrate := job.TaskGroups[0].Copy()
+	migrate.Name = "migrate"
+	migrate.Count = 1
+	job.TaskGroups = append(job.TaskGroups, migrate)
+	job.TaskGroups[0].DependsOn = []*structs.GroupDependency{
+		{Group: "migrate", Condition: structs.GroupDependencyComplete},
+	}
+	require.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, 101, job))
+
+	alloc := mock.Alloc()
+	alloc.Job = job
+	alloc.JobID = job.ID
+	alloc.NodeID = node.ID
+	alloc.TaskGroup = migrate.Name
+	alloc.ClientStatus = structs.AllocClientStatusRunning
+	require.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 102, []*structs.Allocation{alloc}))
+
+	// Complete the migrate allocation
+	clientAlloc := alloc.Copy()
+	clientAlloc.ClientStatus = structs.AllocClientStatusComplete
+	update := &structs.AllocUpdateRequest{
+		Alloc:        []*structs.Allocation{clientAlloc},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var resp2 structs.NodeAllocsResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Node.UpdateAlloc", update, &resp2))
+
+	// Ensure an eval was created to place the web group
+	evals, err := state.EvalsByJob(nil, job.Namespace, job.ID)
+	require.NoError(t, err)
+	require.Len(t, evals, 1)
+	require.Equal(t, structs.EvalTriggerGroupDependency, evals[0].TriggeredBy)
+}
+
 func TestClientEndpoint_UpdateAlloc_NodeNotReady(t *testing.T) {
 	ci.Parallel(t)
 
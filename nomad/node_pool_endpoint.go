@@ -0,0 +1,232 @@
+package nomad
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-memdb"
+	multierror "github.com/hashicorp/go-multierror"
+
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/state/paginator"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// NodePool endpoint is used for node pool management and interaction.
+type NodePool struct {
+	srv *Server
+	ctx *RPCContext
+}
+
+func NewNodePoolEndpoint(srv *Server, ctx *RPCContext) *NodePool {
+	return &NodePool{srv: srv, ctx: ctx}
+}
+
+// List is used to retrieve multiple node pools. It supports prefix listing,
+// pagination, and filtering.
+func (n *NodePool) List(args *structs.NodePoolListRequest, reply *structs.NodePoolListResponse) error {
+	if done, err := n.srv.forward("NodePool.List", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "node_pool", "list"}, time.Now())
+
+	// Node pools are a node concept, so reading them requires node read
+	// permissions.
+	if aclObj, err := n.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowNodeRead() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Setup blocking query.
+	sort := state.SortOption(args.Reverse)
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, store *state.StateStore) error {
+			var err error
+			var iter memdb.ResultIterator
+
+			if prefix := args.QueryOptions.Prefix; prefix != "" {
+				iter, err = store.NodePoolsByNamePrefix(ws, prefix, sort)
+			} else {
+				iter, err = store.NodePools(ws, sort)
+			}
+			if err != nil {
+				return err
+			}
+
+			tokenizer := paginator.NewStructsTokenizer(iter, paginator.StructsTokenizerOptions{WithID: true})
+
+			var pools []*structs.NodePool
+			pager, err := paginator.NewPaginator(iter, tokenizer, nil, args.QueryOptions,
+				func(raw interface{}) error {
+					pools = append(pools, raw.(*structs.NodePool))
+					return nil
+				})
+			if err != nil {
+				return structs.NewErrRPCCodedf(http.StatusBadRequest, "failed to create result paginator: %v", err)
+			}
+
+			nextToken, err := pager.Page()
+			if err != nil {
+				return structs.NewErrRPCCodedf(http.StatusBadRequest, "failed to read result page: %v", err)
+			}
+
+			reply.QueryMeta.NextToken = nextToken
+			reply.NodePools = pools
+
+			// Use the last index that affected the node pools table.
+			index, err := store.Index(state.TableNodePools)
+			if err != nil {
+				return err
+			}
+
+			// Ensure we never set the index to zero, otherwise a blocking
+			// query cannot be used.
+			if index == 0 {
+				index = 1
+			}
+			reply.Index = index
+			return nil
+		}}
+	return n.srv.blockingRPC(&opts)
+}
+
+// GetNodePool returns the node pool requested or nil if not found.
+func (n *NodePool) GetNodePool(args *structs.NodePoolSpecificRequest, reply *structs.SingleNodePoolResponse) error {
+	if done, err := n.srv.forward("NodePool.GetNodePool", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "node_pool", "get_node_pool"}, time.Now())
+
+	if aclObj, err := n.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.AllowNodeRead() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Setup the blocking query.
+	opts := blockingOptions{
+		queryOpts: &args.QueryOptions,
+		queryMeta: &reply.QueryMeta,
+		run: func(ws memdb.WatchSet, store *state.StateStore) error {
+			// Fetch node pool.
+			pool, err := store.NodePoolByName(ws, args.Name)
+			if err != nil {
+				return err
+			}
+
+			reply.NodePool = pool
+			if pool != nil {
+				reply.Index = pool.ModifyIndex
+			} else {
+				// Return the last index that affected the node pools table.
+				index, err := store.Index(state.TableNodePools)
+				if err != nil {
+					return err
+				}
+				if index == 0 {
+					index = 1
+				}
+				reply.Index = index
+			}
+			return nil
+		}}
+	return n.srv.blockingRPC(&opts)
+}
+
+// UpsertNodePools creates or updates the given node pools. Built-in node
+// pools cannot be updated.
+func (n *NodePool) UpsertNodePools(args *structs.NodePoolUpsertRequest, reply *structs.GenericResponse) error {
+	if done, err := n.srv.forward("NodePool.UpsertNodePools", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "node_pool", "upsert_node_pools"}, time.Now())
+
+	// Check management permissions
+	if aclObj, err := n.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Validate request.
+	if len(args.NodePools) == 0 {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "must specify at least one node pool")
+	}
+	for _, pool := range args.NodePools {
+		if err := pool.Validate(); err != nil {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "invalid node pool %q: %v", pool.Name, err)
+		}
+		if pool.IsBuiltIn() {
+			return structs.NewErrRPCCodedf(http.StatusBadRequest, "modifying node pool %q is not allowed", pool.Name)
+		}
+	}
+
+	// Update via Raft.
+	out, index, err := n.srv.raftApply(structs.NodePoolUpsertRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Check if there was an error when applying.
+	if err, ok := out.(error); ok && err != nil {
+		return err
+	}
+
+	reply.Index = index
+	return nil
+}
+
+// DeleteNodePools deletes the given node pools. Built-in node pools, and
+// node pools that still have nodes or non-terminal jobs, cannot be deleted.
+func (n *NodePool) DeleteNodePools(args *structs.NodePoolDeleteRequest, reply *structs.GenericResponse) error {
+	if done, err := n.srv.forward("NodePool.DeleteNodePools", args, args, reply); done {
+		return err
+	}
+	defer metrics.MeasureSince([]string{"nomad", "node_pool", "delete_node_pools"}, time.Now())
+
+	// Check management permissions
+	if aclObj, err := n.srv.ResolveToken(args.AuthToken); err != nil {
+		return err
+	} else if aclObj != nil && !aclObj.IsManagement() {
+		return structs.ErrPermissionDenied
+	}
+
+	// Validate request.
+	if len(args.Names) == 0 {
+		return structs.NewErrRPCCodedf(http.StatusBadRequest, "must specify at least one node pool to delete")
+	}
+
+	var mErr multierror.Error
+	for _, name := range args.Names {
+		if name == "" {
+			_ = multierror.Append(&mErr, fmt.Errorf("node pool name is empty"))
+			continue
+		}
+		if name == structs.NodePoolAll || name == structs.NodePoolDefault {
+			_ = multierror.Append(&mErr, fmt.Errorf("deleting built-in node pool %q is not allowed", name))
+		}
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return structs.NewErrRPCCoded(http.StatusBadRequest, err.Error())
+	}
+
+	// Update via Raft.
+	out, index, err := n.srv.raftApply(structs.NodePoolDeleteRequestType, args)
+	if err != nil {
+		return err
+	}
+
+	// Check if there was an error when applying, such as the node pool
+	// still being in use.
+	if err, ok := out.(error); ok && err != nil {
+		return err
+	}
+
+	reply.Index = index
+	return nil
+}
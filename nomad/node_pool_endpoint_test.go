@@ -0,0 +1,127 @@
+package nomad
+
+import (
+	"testing"
+
+	msgpackrpc "github.com/hashicorp/net-rpc-msgpackrpc"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/testutil"
+	"github.com/shoenig/test/must"
+)
+
+func TestNodePoolEndpoint_UpsertNodePools(t *testing.T) {
+	ci.Parallel(t)
+
+	s, cleanupS := TestServer(t, nil)
+	defer cleanupS()
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	pool := mock.NodePool()
+	req := &structs.NodePoolUpsertRequest{
+		NodePools:    []*structs.NodePool{pool},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var resp structs.GenericResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "NodePool.UpsertNodePools", req, &resp))
+	must.NonZero(t, resp.Index)
+
+	// Read the node pool back.
+	get := &structs.NodePoolSpecificRequest{
+		Name:         pool.Name,
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	}
+	var getResp structs.SingleNodePoolResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "NodePool.GetNodePool", get, &getResp))
+	must.NotNil(t, getResp.NodePool)
+	must.True(t, pool.Equal(getResp.NodePool))
+
+	// List node pools, which includes the built-in ones.
+	list := &structs.NodePoolListRequest{
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	}
+	var listResp structs.NodePoolListResponse
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "NodePool.List", list, &listResp))
+	must.Len(t, 3, listResp.NodePools)
+
+	// Built-in and invalid node pools are rejected.
+	req.NodePools = []*structs.NodePool{{Name: structs.NodePoolAll}}
+	err := msgpackrpc.CallWithCodec(codec, "NodePool.UpsertNodePools", req, &resp)
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), "is not allowed")
+
+	req.NodePools = []*structs.NodePool{{Name: "not valid"}}
+	err = msgpackrpc.CallWithCodec(codec, "NodePool.UpsertNodePools", req, &resp)
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), "invalid name")
+}
+
+func TestNodePoolEndpoint_DeleteNodePools(t *testing.T) {
+	ci.Parallel(t)
+
+	s, cleanupS := TestServer(t, nil)
+	defer cleanupS()
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	pool := mock.NodePool()
+	must.NoError(t, s.fsm.State().UpsertNodePools(structs.MsgTypeTestSetup, 1000, []*structs.NodePool{pool}))
+
+	req := &structs.NodePoolDeleteRequest{
+		Names:        []string{structs.NodePoolDefault},
+		WriteRequest: structs.WriteRequest{Region: "global"},
+	}
+	var resp structs.GenericResponse
+	err := msgpackrpc.CallWithCodec(codec, "NodePool.DeleteNodePools", req, &resp)
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), "is not allowed")
+
+	req.Names = []string{pool.Name}
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "NodePool.DeleteNodePools", req, &resp))
+
+	got, err := s.fsm.State().NodePoolByName(nil, pool.Name)
+	must.NoError(t, err)
+	must.Nil(t, got)
+}
+
+func TestNodePoolEndpoint_ACL(t *testing.T) {
+	ci.Parallel(t)
+
+	s, root, cleanupS := TestACLServer(t, nil)
+	defer cleanupS()
+	codec := rpcClient(t, s)
+	testutil.WaitForLeader(t, s.RPC)
+
+	store := s.fsm.State()
+	readToken := mock.CreatePolicyAndToken(t, store, 1000, "node-read", mock.NodePolicy("read"))
+	writeToken := mock.CreatePolicyAndToken(t, store, 1002, "node-write", mock.NodePolicy("write"))
+
+	// Listing requires node:read.
+	list := &structs.NodePoolListRequest{
+		QueryOptions: structs.QueryOptions{Region: "global"},
+	}
+	var listResp structs.NodePoolListResponse
+	err := msgpackrpc.CallWithCodec(codec, "NodePool.List", list, &listResp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	list.AuthToken = readToken.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "NodePool.List", list, &listResp))
+	must.Len(t, 2, listResp.NodePools)
+
+	// Writing requires a management token.
+	req := &structs.NodePoolUpsertRequest{
+		NodePools: []*structs.NodePool{mock.NodePool()},
+		WriteRequest: structs.WriteRequest{
+			Region:    "global",
+			AuthToken: writeToken.SecretID,
+		},
+	}
+	var resp structs.GenericResponse
+	err = msgpackrpc.CallWithCodec(codec, "NodePool.UpsertNodePools", req, &resp)
+	must.EqError(t, err, structs.ErrPermissionDenied.Error())
+
+	req.AuthToken = root.SecretID
+	must.NoError(t, msgpackrpc.CallWithCodec(codec, "NodePool.UpsertNodePools", req, &resp))
+}
@@ -384,6 +384,66 @@ func (op *Operator) SchedulerGetConfiguration(args *structs.GenericRequest, repl
 	return nil
 }
 
+// SchedulerRebalance computes the allocation migrations that reduce cluster
+// fragmentation and applies them unless the request is a dry run.
+func (op *Operator) SchedulerRebalance(args *structs.SchedulerRebalanceRequest, reply *structs.SchedulerRebalanceResponse) error {
+	if done, err := op.srv.forward("Operator.SchedulerRebalance", args, args, reply); done {
+		return err
+	}
+
+	// Dry runs require operator read access, applying the migrations
+	// requires operator write access.
+	rule, err := op.srv.ResolveToken(args.AuthToken)
+	if err != nil {
+		return err
+	} else if rule != nil {
+		if args.DryRun && !rule.AllowOperatorRead() || !args.DryRun && !rule.AllowOperatorWrite() {
+			return structs.ErrPermissionDenied
+		}
+	}
+
+	if args.MaxMigrations < 0 {
+		return fmt.Errorf("max migrations must not be negative")
+	}
+
+	snap, err := op.srv.fsm.State().Snapshot()
+	if err != nil {
+		return err
+	}
+	_, schedConfig, err := snap.SchedulerConfig()
+	if err != nil {
+		return err
+	}
+
+	r := newRebalancer(snap, schedConfig, args.MaxMigrations, op.logger)
+	reply.Migrations, err = r.compute()
+	if err != nil {
+		return err
+	}
+
+	if args.DryRun || len(reply.Migrations) == 0 {
+		index, err := snap.LatestIndex()
+		if err != nil {
+			return err
+		}
+		reply.Index = index
+		return nil
+	}
+
+	req := r.transitionRequest()
+	_, index, err := op.srv.raftApply(structs.AllocUpdateDesiredTransitionRequestType, req)
+	if err != nil {
+		op.logger.Error("failed applying rebalance migrations", "error", err)
+		return err
+	}
+
+	for _, eval := range req.Evals {
+		reply.EvalIDs = append(reply.EvalIDs, eval.ID)
+	}
+	reply.Index = index
+	return nil
+}
+
 func (op *Operator) forwardStreamingRPC(region string, method string, args interface{}, in io.ReadWriteCloser) error {
 	server, err := op.srv.findRegionServer(region)
 	if err != nil {
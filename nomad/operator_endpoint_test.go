@@ -977,3 +977,67 @@ func TestOperator_SnapshotRestore_ACL(t *testing.T) {
 		})
 	}
 }
+
+func TestOperator_SchedulerRebalance(t *testing.T) {
+	ci.Parallel(t)
+
+	s1, root, cleanupS1 := TestACLServer(t, nil)
+	defer cleanupS1()
+	codec := rpcClient(t, s1)
+	testutil.WaitForLeader(t, s1.RPC)
+	state := s1.fsm.State()
+
+	full, light := mock.Node(), mock.Node()
+	require.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 1000, full))
+	require.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, 1001, light))
+
+	job := mock.Job()
+	require.NoError(t, state.UpsertJob(structs.MsgTypeTestSetup, 1002, job))
+
+	fullAlloc := rebalanceTestAlloc(job, full, 2000, 4096)
+	lightAlloc := rebalanceTestAlloc(job, light, 250, 512)
+	require.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 1003,
+		[]*structs.Allocation{fullAlloc, lightAlloc}))
+
+	readToken := mock.CreatePolicyAndToken(t, state, 1004, "operator-read", mock.OperatorPolicy(acl.PolicyRead))
+
+	arg := structs.SchedulerRebalanceRequest{
+		DryRun: true,
+		WriteRequest: structs.WriteRequest{
+			Region:    s1.config.Region,
+			AuthToken: readToken.SecretID,
+		},
+	}
+
+	// A dry run reports the migration without applying it.
+	var reply structs.SchedulerRebalanceResponse
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.SchedulerRebalance", &arg, &reply))
+	require.Len(t, reply.Migrations, 1)
+	require.Equal(t, lightAlloc.ID, reply.Migrations[0].AllocID)
+	require.Equal(t, full.ID, reply.Migrations[0].TargetNodeID)
+	require.Empty(t, reply.EvalIDs)
+
+	alloc, err := state.AllocByID(nil, lightAlloc.ID)
+	require.NoError(t, err)
+	require.False(t, alloc.DesiredTransition.ShouldMigrate())
+
+	// Applying the migrations requires operator write access.
+	arg.DryRun = false
+	err = msgpackrpc.CallWithCodec(codec, "Operator.SchedulerRebalance", &arg, &reply)
+	require.EqualError(t, err, structs.ErrPermissionDenied.Error())
+
+	arg.AuthToken = root.SecretID
+	reply = structs.SchedulerRebalanceResponse{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.SchedulerRebalance", &arg, &reply))
+	require.Len(t, reply.Migrations, 1)
+	require.Len(t, reply.EvalIDs, 1)
+
+	alloc, err = state.AllocByID(nil, lightAlloc.ID)
+	require.NoError(t, err)
+	require.True(t, alloc.DesiredTransition.ShouldMigrate())
+
+	eval, err := state.EvalByID(nil, reply.EvalIDs[0])
+	require.NoError(t, err)
+	require.NotNil(t, eval)
+	require.Equal(t, job.ID, eval.JobID)
+}
@@ -3,7 +3,9 @@ package nomad
 import (
 	"container/heap"
 	"context"
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
 	"strconv"
 	"strings"
 	"sync"
@@ -41,6 +43,9 @@ type JobEvalDispatcher interface {
 
 	// RunningChildren returns whether the passed job has any running children.
 	RunningChildren(job *structs.Job) (bool, error)
+
+	// StopChildren stops the running children of the passed job.
+	StopChildren(job *structs.Job) error
 }
 
 // DispatchJob creates an evaluation for the passed job and commits both the
@@ -155,6 +160,56 @@ func (s *Server) RunningChildren(job *structs.Job) (bool, error) {
 	return false, nil
 }
 
+// StopChildren stops the children of the passed job that are not already
+// stopped or dead, creating an evaluation for each of them.
+func (s *Server) StopChildren(job *structs.Job) error {
+	state, err := s.fsm.State().Snapshot()
+	if err != nil {
+		return err
+	}
+
+	ws := memdb.NewWatchSet()
+	prefix := fmt.Sprintf("%s%s", job.ID, structs.PeriodicLaunchSuffix)
+	iter, err := state.JobsByIDPrefix(ws, job.Namespace, prefix)
+	if err != nil {
+		return err
+	}
+
+	for i := iter.Next(); i != nil; i = iter.Next() {
+		child := i.(*structs.Job)
+
+		// Ensure the job is actually a running child.
+		if child.ParentID != job.ID || child.Stopped() || child.Status == structs.JobStatusDead {
+			continue
+		}
+
+		now := time.Now().UTC().UnixNano()
+		eval := &structs.Evaluation{
+			ID:          uuid.Generate(),
+			Namespace:   child.Namespace,
+			Priority:    child.Priority,
+			Type:        child.Type,
+			TriggeredBy: structs.EvalTriggerJobDeregister,
+			JobID:       child.ID,
+			Status:      structs.EvalStatusPending,
+			CreateTime:  now,
+			ModifyTime:  now,
+		}
+		req := &structs.JobDeregisterRequest{
+			JobID: child.ID,
+			Eval:  eval,
+			WriteRequest: structs.WriteRequest{
+				Namespace: child.Namespace,
+			},
+		}
+		if _, _, err := s.raftApply(structs.JobDeregisterRequestType, req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // NewPeriodicDispatch returns a periodic dispatcher that is used to track and
 // launch periodic jobs.
 func NewPeriodicDispatch(logger log.Logger, dispatcher JobEvalDispatcher) *PeriodicDispatch {
@@ -234,7 +289,7 @@ func (p *PeriodicDispatch) Add(job *structs.Job) error {
 
 	// Add or update the job.
 	p.tracked[tuple] = job
-	next, err := job.Periodic.Next(time.Now().In(job.Periodic.GetLocation()))
+	next, err := nextLaunchTime(job, time.Now().In(job.Periodic.GetLocation()))
 	if err != nil {
 		return fmt.Errorf("failed adding job %s: %v", job.NamespacedID(), err)
 	}
@@ -323,6 +378,141 @@ func (p *PeriodicDispatch) ForceRun(namespace, jobID string) (*structs.Evaluatio
 	return p.createEval(job, time.Now().In(job.Periodic.GetLocation()))
 }
 
+// CatchUp dispatches the launches of the periodic job missed since its last
+// launch that are within the job's catchup window, oldest first. At most
+// PeriodicCatchupLimit of the latest missed launches are dispatched, and only
+// the latest one if the job's concurrency policy forbids or replaces running
+// instances.
+func (p *PeriodicDispatch) CatchUp(namespace, jobID string, lastLaunch time.Time) ([]*structs.Evaluation, error) {
+	p.l.Lock()
+
+	// Do nothing if not enabled
+	if !p.enabled {
+		p.l.Unlock()
+		return nil, fmt.Errorf("periodic dispatch disabled")
+	}
+
+	tuple := structs.NamespacedID{
+		ID:        jobID,
+		Namespace: namespace,
+	}
+	job, tracked := p.tracked[tuple]
+	if !tracked {
+		p.l.Unlock()
+		return nil, fmt.Errorf("can't catch up non-tracked job %q (%s)", jobID, namespace)
+	}
+	p.l.Unlock()
+
+	now := time.Now().In(job.Periodic.GetLocation())
+	missed, err := missedLaunches(job, lastLaunch.In(job.Periodic.GetLocation()), now)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := job.Periodic.Concurrency()
+	if policy == structs.PeriodicConcurrencyForbid && len(missed) > 0 {
+		running, err := p.dispatcher.RunningChildren(job)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine if periodic job %q (%s) has running children: %v",
+				jobID, namespace, err)
+		}
+		if running {
+			p.logger.Debug("skipping catch up of periodic job because job prohibits overlap", "job", job.NamespacedID())
+			return nil, nil
+		}
+	}
+	if policy != structs.PeriodicConcurrencyAllow && len(missed) > 1 {
+		missed = missed[len(missed)-1:]
+	}
+
+	evals := make([]*structs.Evaluation, 0, len(missed))
+	for _, launch := range missed {
+		p.logger.Debug("catching up missed launch of job", "job", job.NamespacedID(), "launch_time", launch)
+		eval, err := p.replaceAndCreateEval(job, launch)
+		if err != nil {
+			return evals, err
+		}
+		evals = append(evals, eval)
+	}
+	return evals, nil
+}
+
+// missedLaunches returns the launch times of the periodic job between its last
+// launch and now that are within the job's catchup window, bounded to the
+// latest PeriodicCatchupLimit ones.
+func missedLaunches(job *structs.Job, lastLaunch, now time.Time) ([]time.Time, error) {
+	// Launch times are recorded in seconds so start right before the window
+	from := lastLaunch
+	if cutoff := now.Add(-job.Periodic.CatchupWindow - time.Second); from.Before(cutoff) {
+		from = cutoff
+	}
+
+	var missed []time.Time
+	for {
+		launch, err := nextLaunchTime(job, from)
+		if err != nil {
+			return nil, err
+		}
+		if launch.IsZero() || !launch.Before(now) {
+			break
+		}
+
+		missed = append(missed, launch)
+		if len(missed) > structs.PeriodicCatchupLimit {
+			missed = missed[1:]
+		}
+		from = launch
+	}
+	return missed, nil
+}
+
+// nextLaunchTime returns the first launch time of the periodic job after the
+// passed time. Launches are delayed by the job's jitter, so the ones scheduled
+// up to the jitter before the passed time are considered too. A jitter longer
+// than the time between two scheduled launches can reorder them, so the
+// earliest launch is picked among every launch scheduled before it.
+func nextLaunchTime(job *structs.Job, from time.Time) (time.Time, error) {
+	var earliest time.Time
+	scheduled := from.Add(-job.Periodic.Jitter)
+	for {
+		next, err := job.Periodic.Next(scheduled)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		// Launches scheduled after the earliest one can't happen before it
+		if next.IsZero() || (!earliest.IsZero() && next.After(earliest)) {
+			return earliest, nil
+		}
+
+		launch := next.Add(launchJitter(job, next))
+		if launch.After(from) && (earliest.IsZero() || launch.Before(earliest)) {
+			earliest = launch
+		}
+		scheduled = next
+	}
+}
+
+// launchJitter returns the delay added to the launch of the periodic job
+// scheduled at the passed time. It is derived from the job and the scheduled
+// time rather than picked at random so that every leader computes the same
+// launch times, and is truncated to the second as launch times are.
+func launchJitter(job *structs.Job, scheduled time.Time) time.Duration {
+	seconds := uint64(job.Periodic.Jitter / time.Second)
+	if seconds == 0 {
+		return 0
+	}
+
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(scheduled.Unix()))
+
+	h := fnv.New64a()
+	h.Write([]byte(job.Namespace))
+	h.Write([]byte(job.ID))
+	h.Write(buf[:])
+	return time.Duration(h.Sum64()%seconds) * time.Second
+}
+
 // shouldRun returns whether the long lived run function should run.
 func (p *PeriodicDispatch) shouldRun() bool {
 	p.l.RLock()
@@ -360,7 +550,7 @@ func (p *PeriodicDispatch) run(ctx context.Context, updateCh <-chan struct{}) {
 func (p *PeriodicDispatch) dispatch(job *structs.Job, launchTime time.Time) {
 	p.l.Lock()
 
-	nextLaunch, err := job.Periodic.Next(launchTime)
+	nextLaunch, err := nextLaunchTime(job, launchTime)
 	if err != nil {
 		p.logger.Error("failed to parse next periodic launch", "job", job.NamespacedID(), "error", err)
 	} else if err := p.heap.Update(job, nextLaunch); err != nil {
@@ -369,7 +559,7 @@ func (p *PeriodicDispatch) dispatch(job *structs.Job, launchTime time.Time) {
 
 	// If the job prohibits overlapping and there are running children, we skip
 	// the launch.
-	if job.Periodic.ProhibitOverlap {
+	if job.Periodic.Concurrency() == structs.PeriodicConcurrencyForbid {
 		running, err := p.dispatcher.RunningChildren(job)
 		if err != nil {
 			p.logger.Error("failed to determine if periodic job has running children", "job", job.NamespacedID(), "error", err)
@@ -386,7 +576,20 @@ func (p *PeriodicDispatch) dispatch(job *structs.Job, launchTime time.Time) {
 
 	p.logger.Debug(" launching job", "job", job.NamespacedID(), "launch_time", launchTime)
 	p.l.Unlock()
-	p.createEval(job, launchTime)
+	p.replaceAndCreateEval(job, launchTime)
+}
+
+// replaceAndCreateEval stops the running children of the periodic job if its
+// concurrency policy replaces them, and launches the job. This should not be
+// called with the lock held.
+func (p *PeriodicDispatch) replaceAndCreateEval(job *structs.Job, launchTime time.Time) (*structs.Evaluation, error) {
+	if job.Periodic.Concurrency() == structs.PeriodicConcurrencyReplace {
+		if err := p.dispatcher.StopChildren(job); err != nil {
+			p.logger.Error("failed to stop running children of periodic job", "job", job.NamespacedID(), "error", err)
+			return nil, err
+		}
+	}
+	return p.createEval(job, launchTime)
 }
 
 // nextLaunch returns the next job to launch and when it should be launched. If
@@ -44,13 +44,24 @@ func (m *MockJobEvalDispatcher) RunningChildren(parent *structs.Job) (bool, erro
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	for _, job := range m.Jobs {
-		if job.ParentID == parent.ID && job.Namespace == parent.Namespace {
+		if job.ParentID == parent.ID && job.Namespace == parent.Namespace && !job.Stop {
 			return true, nil
 		}
 	}
 	return false, nil
 }
 
+func (m *MockJobEvalDispatcher) StopChildren(parent *structs.Job) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, job := range m.Jobs {
+		if job.ParentID == parent.ID && job.Namespace == parent.Namespace {
+			job.Stop = true
+		}
+	}
+	return nil
+}
+
 // LaunchTimes returns the launch times of child jobs in sorted order.
 func (m *MockJobEvalDispatcher) LaunchTimes(p *PeriodicDispatch, namespace, parentID string) ([]time.Time, error) {
 	m.lock.Lock()
@@ -394,6 +405,148 @@ func TestPeriodicDispatch_ForceRun_Tracked(t *testing.T) {
 	}
 }
 
+func TestPeriodicDispatch_CatchUp(t *testing.T) {
+	ci.Parallel(t)
+	p, m := testPeriodicDispatcher(t)
+
+	// Create a job that missed launches both before and within its catchup
+	// window.
+	now := time.Now().Round(1 * time.Second)
+	var launches []time.Time
+	for i := 20; i > 0; i-- {
+		launches = append(launches, now.Add(-time.Duration(i)*time.Minute))
+	}
+	job := testPeriodicJob(append(launches, now.Add(time.Hour))...)
+	job.Periodic.CatchupWindow = 15*time.Minute + 30*time.Second
+	require.NoError(t, p.Add(job))
+
+	// Only the latest missed launches up to the limit are dispatched
+	evals, err := p.CatchUp(job.Namespace, job.ID, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, evals, structs.PeriodicCatchupLimit)
+
+	times, err := m.LaunchTimes(p, job.Namespace, job.ID)
+	require.NoError(t, err)
+	require.Equal(t, launches[len(launches)-structs.PeriodicCatchupLimit:], times)
+
+	// Launches before the last launch are not dispatched again
+	m2 := NewMockJobEvalDispatcher()
+	p.dispatcher = m2
+	_, err = p.CatchUp(job.Namespace, job.ID, launches[17])
+	require.NoError(t, err)
+	times, err = m2.LaunchTimes(p, job.Namespace, job.ID)
+	require.NoError(t, err)
+	require.Equal(t, launches[18:], times)
+}
+
+func TestPeriodicDispatch_CatchUp_ProhibitOverlap(t *testing.T) {
+	ci.Parallel(t)
+	p, m := testPeriodicDispatcher(t)
+
+	now := time.Now().Round(1 * time.Second)
+	launch1 := now.Add(-3 * time.Minute)
+	launch2 := now.Add(-2 * time.Minute)
+	job := testPeriodicJob(launch1, launch2, now.Add(time.Hour))
+	job.Periodic.CatchupWindow = time.Hour
+	job.Periodic.ProhibitOverlap = true
+	require.NoError(t, p.Add(job))
+
+	// Only the latest missed launch is dispatched
+	_, err := p.CatchUp(job.Namespace, job.ID, now.Add(-time.Hour))
+	require.NoError(t, err)
+	times, err := m.LaunchTimes(p, job.Namespace, job.ID)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{launch2}, times)
+
+	// Nothing is dispatched while the job has running children
+	_, err = p.CatchUp(job.Namespace, job.ID, now.Add(-time.Hour))
+	require.NoError(t, err)
+	times, err = m.LaunchTimes(p, job.Namespace, job.ID)
+	require.NoError(t, err)
+	require.Len(t, times, 1)
+}
+
+func TestPeriodicDispatch_NextLaunchTime_Jitter(t *testing.T) {
+	ci.Parallel(t)
+
+	job := mock.PeriodicJob()
+	job.Periodic.Spec = "0 * * * *"
+	job.Periodic.Jitter = 30 * time.Minute
+
+	from := time.Date(2022, time.October, 10, 9, 45, 0, 0, time.UTC)
+	scheduled := time.Date(2022, time.October, 10, 10, 0, 0, 0, time.UTC)
+
+	launch, err := nextLaunchTime(job, from)
+	require.NoError(t, err)
+	require.False(t, launch.Before(scheduled))
+	require.True(t, launch.Before(scheduled.Add(job.Periodic.Jitter)))
+	require.Zero(t, launch.Nanosecond())
+
+	// The jitter is the same for every leader computing it
+	again, err := nextLaunchTime(job, from)
+	require.NoError(t, err)
+	require.Equal(t, launch, again)
+
+	// A launch delayed by the jitter is still upcoming past its scheduled time
+	again, err = nextLaunchTime(job, scheduled)
+	require.NoError(t, err)
+	if launch.After(scheduled) {
+		require.Equal(t, launch, again)
+	}
+
+	// The launch following a jittered one is the next scheduled launch
+	next, err := nextLaunchTime(job, launch)
+	require.NoError(t, err)
+	require.False(t, next.Before(scheduled.Add(time.Hour)))
+	require.True(t, next.Before(scheduled.Add(time.Hour+job.Periodic.Jitter)))
+
+	// Jobs sharing the same schedule are spread
+	spread := make(map[time.Time]struct{})
+	for i := 0; i < 10; i++ {
+		other := job.Copy()
+		other.ID = fmt.Sprintf("job-%d", i)
+		launch, err := nextLaunchTime(other, from)
+		require.NoError(t, err)
+		spread[launch] = struct{}{}
+	}
+	require.Greater(t, len(spread), 1)
+}
+
+func TestPeriodicDispatch_NextLaunchTime_JitterLongerThanInterval(t *testing.T) {
+	ci.Parallel(t)
+
+	job := mock.PeriodicJob()
+	job.Periodic.Spec = "* * * * *"
+	job.Periodic.Jitter = 10 * time.Minute
+
+	from := time.Date(2022, time.October, 10, 9, 0, 0, 0, time.UTC)
+	end := from.Add(time.Hour)
+
+	// Every launch scheduled up to the jitter before the start is expected
+	expected := make(map[time.Time]struct{})
+	for scheduled := from.Add(-job.Periodic.Jitter); !scheduled.After(end); scheduled = scheduled.Add(time.Minute) {
+		launch := scheduled.Add(launchJitter(job, scheduled))
+		if launch.After(from) && !launch.After(end) {
+			expected[launch] = struct{}{}
+		}
+	}
+
+	// The launches are returned in order without skipping any
+	launches := make(map[time.Time]struct{})
+	last := from
+	for {
+		launch, err := nextLaunchTime(job, last)
+		require.NoError(t, err)
+		require.True(t, launch.After(last))
+		if launch.After(end) {
+			break
+		}
+		launches[launch] = struct{}{}
+		last = launch
+	}
+	require.Equal(t, expected, launches)
+}
+
 func TestPeriodicDispatch_Run_DisallowOverlaps(t *testing.T) {
 	ci.Parallel(t)
 	p, m := testPeriodicDispatcher(t)
@@ -424,6 +577,32 @@ func TestPeriodicDispatch_Run_DisallowOverlaps(t *testing.T) {
 	}
 }
 
+func TestPeriodicDispatch_Run_ReplaceRunning(t *testing.T) {
+	ci.Parallel(t)
+	p, m := testPeriodicDispatcher(t)
+
+	// Create a job that will trigger two launches and replaces the running
+	// instance.
+	launch1 := time.Now().Round(1 * time.Second).Add(1 * time.Second)
+	launch2 := time.Now().Round(1 * time.Second).Add(2 * time.Second)
+	job := testPeriodicJob(launch1, launch2)
+	job.Periodic.ConcurrencyPolicy = structs.PeriodicConcurrencyReplace
+	require.NoError(t, p.Add(job))
+
+	time.Sleep(3 * time.Second)
+
+	// Check that both jobs were launched and the first one was stopped.
+	times, err := m.LaunchTimes(p, job.Namespace, job.ID)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{launch1, launch2}, times)
+
+	for _, child := range m.dispatchedJobs(job) {
+		launch, err := p.LaunchTime(child.ID)
+		require.NoError(t, err)
+		require.Equal(t, launch.Equal(launch1), child.Stop, "unexpected stop of child launched at %v", launch)
+	}
+}
+
 func TestPeriodicDispatch_Run_Multiple(t *testing.T) {
 	ci.Parallel(t)
 	p, m := testPeriodicDispatcher(t)
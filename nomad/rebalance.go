@@ -0,0 +1,568 @@
+package nomad
+
+import (
+	"sort"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	memdb "github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/helper/pointer"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/hashicorp/nomad/scheduler"
+)
+
+const (
+	// rebalanceUnderusedThreshold is the utilization below which the binpack
+	// rebalancer tries to empty a node.
+	rebalanceUnderusedThreshold = 0.5
+
+	// rebalanceMinImprovement is the minimum reduction of the utilization
+	// of the most used node in a pair that justifies a spread migration.
+	rebalanceMinImprovement = 0.05
+)
+
+// rebalancer computes a bounded set of allocation migrations that reduce
+// cluster fragmentation. With the binpack algorithm it empties lightly used
+// nodes by moving their allocations onto fuller nodes, so large task groups
+// find room to be placed. With the spread algorithm it moves allocations off
+// of the most used nodes onto the least used ones, such as nodes that
+// recently joined the cluster.
+//
+// The rebalancer only picks which allocations to migrate and where to. The
+// migrations are carried out by the scheduler, which honors each task group's
+// migrate block as it would for a node drain and prefers the target node
+// picked by the rebalancer for the replacement allocations.
+type rebalancer struct {
+	snap          *state.StateSnapshot
+	logger        log.Logger
+	algorithm     structs.SchedulerAlgorithm
+	maxMigrations int
+
+	nodes   []*rebalanceNode
+	jobs    map[structs.NamespacedID]*structs.Job
+	budgets map[rebalanceGroup]int
+
+	// plan holds the migrations computed so far, so the scheduler stacks
+	// used to check their feasibility account for them.
+	plan   *structs.Plan
+	stacks map[structs.NamespacedID]*scheduler.GenericStack
+
+	migrations []*structs.RebalanceMigration
+}
+
+// rebalanceGroup identifies the task group of an allocation.
+type rebalanceGroup struct {
+	namespace string
+	jobID     string
+	taskGroup string
+}
+
+// rebalanceNode tracks the allocations the rebalancer expects a node to run
+// once the migrations computed so far complete.
+type rebalanceNode struct {
+	node   *structs.Node
+	allocs []*structs.Allocation
+	util   float64
+
+	// emptied is set on nodes the rebalancer moves every allocation off of,
+	// so they aren't used as migration targets.
+	emptied bool
+}
+
+// newRebalancer returns a rebalancer for the given state snapshot. A
+// maxMigrations of zero uses the limit of the rebalance configuration.
+func newRebalancer(snap *state.StateSnapshot, config *structs.SchedulerConfiguration, maxMigrations int, logger log.Logger) *rebalancer {
+	if maxMigrations == 0 {
+		if config != nil {
+			maxMigrations = config.RebalanceConfig.EffectiveMaxMigrations()
+		} else {
+			maxMigrations = structs.DefaultRebalanceMaxMigrations
+		}
+	}
+
+	return &rebalancer{
+		snap:          snap,
+		logger:        logger,
+		algorithm:     config.EffectiveSchedulerAlgorithm(),
+		maxMigrations: maxMigrations,
+		jobs:          make(map[structs.NamespacedID]*structs.Job),
+		budgets:       make(map[rebalanceGroup]int),
+		plan: &structs.Plan{
+			EvalID:          uuid.Generate(),
+			NodeUpdate:      make(map[string][]*structs.Allocation),
+			NodeAllocation:  make(map[string][]*structs.Allocation),
+			NodePreemptions: make(map[string][]*structs.Allocation),
+		},
+		stacks: make(map[structs.NamespacedID]*scheduler.GenericStack),
+	}
+}
+
+// compute returns the allocation migrations that rebalance the cluster.
+func (r *rebalancer) compute() ([]*structs.RebalanceMigration, error) {
+	if err := r.loadNodes(); err != nil {
+		return nil, err
+	}
+
+	switch r.algorithm {
+	case structs.SchedulerAlgorithmSpread:
+		for len(r.migrations) < r.maxMigrations {
+			moved, err := r.spreadOnce()
+			if err != nil {
+				return nil, err
+			}
+			if !moved {
+				break
+			}
+		}
+	default:
+		if err := r.defragment(); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.migrations, nil
+}
+
+// loadNodes loads the ready nodes and their running allocations.
+func (r *rebalancer) loadNodes() error {
+	ws := memdb.NewWatchSet()
+	iter, err := r.snap.Nodes(ws)
+	if err != nil {
+		return err
+	}
+
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		node := raw.(*structs.Node)
+		if !node.Ready() {
+			continue
+		}
+
+		allocs, err := r.snap.AllocsByNode(ws, node.ID)
+		if err != nil {
+			return err
+		}
+
+		n := &rebalanceNode{node: node}
+		for _, alloc := range allocs {
+			if !alloc.TerminalStatus() {
+				n.allocs = append(n.allocs, alloc)
+			}
+		}
+		n.util = nodeUtilization(node, n.allocs)
+		r.nodes = append(r.nodes, n)
+	}
+
+	return nil
+}
+
+// defragment empties the least used nodes whose allocations all fit onto
+// fuller nodes.
+func (r *rebalancer) defragment() error {
+	sort.Slice(r.nodes, func(i, j int) bool {
+		if r.nodes[i].util == r.nodes[j].util {
+			return r.nodes[i].node.ID < r.nodes[j].node.ID
+		}
+		return r.nodes[i].util < r.nodes[j].util
+	})
+
+	for _, source := range r.nodes {
+		if len(r.migrations) >= r.maxMigrations {
+			break
+		}
+		if source.util >= rebalanceUnderusedThreshold {
+			break
+		}
+		if err := r.emptyNode(source); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// emptyNode migrates every allocation off of the source node if they all fit
+// onto nodes that are more used than the source. System and sysbatch
+// allocations run on every node and are left in place.
+func (r *rebalancer) emptyNode(source *rebalanceNode) error {
+	var movable []*structs.Allocation
+	groups := make(map[rebalanceGroup]int)
+	for _, alloc := range source.allocs {
+		job, err := r.job(alloc)
+		if err != nil {
+			return err
+		}
+		if job != nil && (job.Type == structs.JobTypeSystem || job.Type == structs.JobTypeSysBatch) {
+			continue
+		}
+
+		ok, err := r.movable(alloc)
+		if err != nil || !ok {
+			return err
+		}
+
+		group := allocRebalanceGroup(alloc)
+		groups[group]++
+		if groups[group] > r.budgets[group] {
+			return nil
+		}
+		movable = append(movable, alloc)
+	}
+	if len(movable) == 0 || len(movable)+len(r.migrations) > r.maxMigrations {
+		return nil
+	}
+
+	// Place the largest allocations first since they are the hardest to fit.
+	sort.Slice(movable, func(i, j int) bool {
+		return allocSize(movable[i]) > allocSize(movable[j])
+	})
+
+	// Plan the moves as they are picked, so that the feasibility of the
+	// next ones accounts for them, and undo them if the node can't be
+	// emptied.
+	proposed := make(map[*rebalanceNode][]*structs.Allocation)
+	targets := make([]*rebalanceNode, 0, len(movable))
+	undo := func() {
+		for i, target := range targets {
+			r.unplanMove(movable[i], target.node)
+		}
+	}
+	for _, alloc := range movable {
+		var best *rebalanceNode
+		var bestUtil float64
+		for _, target := range r.nodes {
+			if target == source || target.emptied || target.util < source.util {
+				continue
+			}
+
+			ok, err := r.feasible(alloc, target.node)
+			if err != nil {
+				undo()
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			allocs := append(append(target.allocs[:len(target.allocs):len(target.allocs)], proposed[target]...), alloc)
+			if util := nodeUtilization(target.node, allocs); best == nil || util > bestUtil {
+				best, bestUtil = target, util
+			}
+		}
+		if best == nil {
+			undo()
+			return nil
+		}
+
+		r.planMove(alloc, best.node)
+		proposed[best] = append(proposed[best], alloc)
+		targets = append(targets, best)
+	}
+
+	for i, alloc := range movable {
+		r.migrate(alloc, source, targets[i])
+	}
+	source.emptied = true
+
+	return nil
+}
+
+// spreadOnce migrates a single allocation from one of the most used nodes
+// onto one of the least used nodes. It returns false when no migration
+// reduces the utilization gap.
+func (r *rebalancer) spreadOnce() (bool, error) {
+	sort.Slice(r.nodes, func(i, j int) bool {
+		if r.nodes[i].util == r.nodes[j].util {
+			return r.nodes[i].node.ID < r.nodes[j].node.ID
+		}
+		return r.nodes[i].util > r.nodes[j].util
+	})
+
+	for _, source := range r.nodes {
+		for i := len(r.nodes) - 1; i >= 0; i-- {
+			target := r.nodes[i]
+			if target.util > source.util-rebalanceMinImprovement {
+				break
+			}
+
+			var best *structs.Allocation
+			var bestUtil float64
+			for j, alloc := range source.allocs {
+				ok, err := r.movable(alloc)
+				if err != nil {
+					return false, err
+				}
+				if !ok || r.budgets[allocRebalanceGroup(alloc)] <= 0 {
+					continue
+				}
+
+				ok, err = r.feasible(alloc, target.node)
+				if err != nil {
+					return false, err
+				}
+				if !ok {
+					continue
+				}
+
+				allocs := append(target.allocs[:len(target.allocs):len(target.allocs)], alloc)
+				remaining := append(source.allocs[:j:j], source.allocs[j+1:]...)
+				util := nodeUtilization(target.node, allocs)
+				if sourceUtil := nodeUtilization(source.node, remaining); sourceUtil > util {
+					util = sourceUtil
+				}
+				if util > source.util-rebalanceMinImprovement {
+					continue
+				}
+				if best == nil || util < bestUtil {
+					best, bestUtil = alloc, util
+				}
+			}
+
+			if best != nil {
+				r.planMove(best, target.node)
+				r.migrate(best, source, target)
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// migrate records the migration of alloc from source onto target.
+func (r *rebalancer) migrate(alloc *structs.Allocation, source, target *rebalanceNode) {
+	for i, a := range source.allocs {
+		if a.ID == alloc.ID {
+			source.allocs = append(source.allocs[:i:i], source.allocs[i+1:]...)
+			break
+		}
+	}
+	source.util = nodeUtilization(source.node, source.allocs)
+
+	target.allocs = append(target.allocs, alloc)
+	target.util = nodeUtilization(target.node, target.allocs)
+
+	r.budgets[allocRebalanceGroup(alloc)]--
+	r.migrations = append(r.migrations, &structs.RebalanceMigration{
+		AllocID:      alloc.ID,
+		AllocName:    alloc.Name,
+		Namespace:    alloc.Namespace,
+		JobID:        alloc.JobID,
+		TaskGroup:    alloc.TaskGroup,
+		SourceNodeID: source.node.ID,
+		TargetNodeID: target.node.ID,
+	})
+}
+
+// movable returns whether alloc is a running service allocation whose task
+// group has a migrate block with room for another migration.
+func (r *rebalancer) movable(alloc *structs.Allocation) (bool, error) {
+	if alloc.ClientStatus != structs.AllocClientStatusRunning ||
+		alloc.DesiredStatus != structs.AllocDesiredStatusRun ||
+		alloc.DesiredTransition.ShouldMigrate() {
+		return false, nil
+	}
+
+	job, err := r.job(alloc)
+	if err != nil || job == nil || job.Stopped() || job.Type != structs.JobTypeService {
+		return false, err
+	}
+	tg := job.LookupTaskGroup(alloc.TaskGroup)
+	if tg == nil || tg.Migrate == nil {
+		return false, nil
+	}
+
+	group := allocRebalanceGroup(alloc)
+	if _, ok := r.budgets[group]; !ok {
+		inflight, err := r.inflightMigrations(group)
+		if err != nil {
+			return false, err
+		}
+		r.budgets[group] = tg.Migrate.MaxParallel - inflight
+	}
+
+	return r.budgets[group] > 0, nil
+}
+
+// inflightMigrations returns the number of allocations of the task group
+// that are being migrated or have yet to start, which count against the
+// group's migrate max_parallel.
+func (r *rebalancer) inflightMigrations(group rebalanceGroup) (int, error) {
+	allocs, err := r.snap.AllocsByJob(nil, group.namespace, group.jobID, false)
+	if err != nil {
+		return 0, err
+	}
+
+	inflight := 0
+	for _, alloc := range allocs {
+		if alloc.TaskGroup != group.taskGroup || alloc.TerminalStatus() {
+			continue
+		}
+		if alloc.DesiredTransition.ShouldMigrate() || alloc.ClientStatus == structs.AllocClientStatusPending {
+			inflight++
+		}
+	}
+	return inflight, nil
+}
+
+// feasible returns whether alloc can be moved onto node once the migrations
+// planned so far complete. It runs the scheduler's feasibility checks and
+// bin packing for the task group against the node, so the replacement fits
+// the way the scheduler will place it.
+func (r *rebalancer) feasible(alloc *structs.Allocation, node *structs.Node) (bool, error) {
+	id := alloc.JobNamespacedID()
+	job := r.jobs[id]
+	tg := job.LookupTaskGroup(alloc.TaskGroup)
+
+	inDatacenter := false
+	for _, dc := range job.Datacenters {
+		if dc == node.Datacenter {
+			inDatacenter = true
+			break
+		}
+	}
+	if !inDatacenter {
+		return false, nil
+	}
+
+	// Each job gets its own stack and context, since both cache the
+	// feasibility of node classes for the job they are set up for.
+	stack, ok := r.stacks[id]
+	if !ok {
+		ctx := scheduler.NewEvalContext(nil, r.snap, r.plan, r.logger)
+		stack = scheduler.NewGenericStack(false, ctx)
+		stack.SetJob(job)
+		r.stacks[id] = stack
+	}
+
+	// The allocation no longer runs on its node once it is migrated.
+	if !r.planned(alloc) {
+		r.plan.NodeUpdate[alloc.NodeID] = append(r.plan.NodeUpdate[alloc.NodeID], alloc)
+		defer r.plan.RemoveUpdate(alloc)
+	}
+
+	stack.SetNodes([]*structs.Node{node})
+	option := stack.Select(tg, &scheduler.SelectOptions{AllocName: alloc.Name})
+	return option != nil, nil
+}
+
+// planMove adds the move of alloc onto node to the plan. The moved
+// allocation keeps its job so the scheduler stacks can match it against the
+// job of other allocations.
+func (r *rebalancer) planMove(alloc *structs.Allocation, node *structs.Node) {
+	r.plan.NodeUpdate[alloc.NodeID] = append(r.plan.NodeUpdate[alloc.NodeID], alloc)
+
+	moved := alloc.Copy()
+	moved.NodeID = node.ID
+	r.plan.NodeAllocation[node.ID] = append(r.plan.NodeAllocation[node.ID], moved)
+}
+
+// unplanMove removes the move of alloc onto node from the plan.
+func (r *rebalancer) unplanMove(alloc *structs.Allocation, node *structs.Node) {
+	r.plan.RemoveUpdate(alloc)
+
+	moved := *alloc
+	moved.NodeID = node.ID
+	r.plan.RemoveAlloc(&moved)
+}
+
+// planned returns whether the move of alloc is already in the plan.
+func (r *rebalancer) planned(alloc *structs.Allocation) bool {
+	for _, a := range r.plan.NodeUpdate[alloc.NodeID] {
+		if a.ID == alloc.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// job returns the current version of the job of alloc.
+func (r *rebalancer) job(alloc *structs.Allocation) (*structs.Job, error) {
+	id := alloc.JobNamespacedID()
+	if job, ok := r.jobs[id]; ok {
+		return job, nil
+	}
+
+	job, err := r.snap.JobByID(nil, alloc.Namespace, alloc.JobID)
+	if err != nil {
+		return nil, err
+	}
+	r.jobs[id] = job
+	return job, nil
+}
+
+// transitionRequest returns the request that marks the allocations of the
+// computed migrations for migration and creates an evaluation for each of
+// their jobs.
+func (r *rebalancer) transitionRequest() *structs.AllocUpdateDesiredTransitionRequest {
+	req := &structs.AllocUpdateDesiredTransitionRequest{
+		Allocs: make(map[string]*structs.DesiredTransition, len(r.migrations)),
+	}
+
+	now := time.Now().UTC().UnixNano()
+	seen := make(map[structs.NamespacedID]struct{})
+	for _, m := range r.migrations {
+		req.Allocs[m.AllocID] = &structs.DesiredTransition{
+			Migrate:             pointer.Of(true),
+			MigrateTargetNodeID: m.TargetNodeID,
+		}
+
+		id := structs.NewNamespacedID(m.JobID, m.Namespace)
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		job := r.jobs[id]
+		req.Evals = append(req.Evals, &structs.Evaluation{
+			ID:          uuid.Generate(),
+			Namespace:   job.Namespace,
+			Priority:    job.Priority,
+			Type:        job.Type,
+			TriggeredBy: structs.EvalTriggerRebalance,
+			JobID:       job.ID,
+			Status:      structs.EvalStatusPending,
+			CreateTime:  now,
+			ModifyTime:  now,
+		})
+	}
+
+	return req
+}
+
+// nodeUtilization returns the mean of the CPU and memory utilization of node
+// when running allocs.
+func nodeUtilization(node *structs.Node, allocs []*structs.Allocation) float64 {
+	available := node.ComparableResources()
+	available.Subtract(node.ComparableReservedResources())
+
+	used := new(structs.ComparableResources)
+	for _, alloc := range allocs {
+		used.Add(alloc.ComparableResources())
+	}
+
+	var util float64
+	if cpu := available.Flattened.Cpu.CpuShares; cpu > 0 {
+		util += float64(used.Flattened.Cpu.CpuShares) / float64(cpu)
+	}
+	if mem := available.Flattened.Memory.MemoryMB; mem > 0 {
+		util += float64(used.Flattened.Memory.MemoryMB) / float64(mem)
+	}
+	return util / 2
+}
+
+// allocSize returns a measure of the resources of alloc used to order
+// allocations from largest to smallest.
+func allocSize(alloc *structs.Allocation) int64 {
+	res := alloc.ComparableResources()
+	return res.Flattened.Cpu.CpuShares + res.Flattened.Memory.MemoryMB
+}
+
+func allocRebalanceGroup(alloc *structs.Allocation) rebalanceGroup {
+	return rebalanceGroup{
+		namespace: alloc.Namespace,
+		jobID:     alloc.JobID,
+		taskGroup: alloc.TaskGroup,
+	}
+}
@@ -0,0 +1,204 @@
+package nomad
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/helper/testlog"
+	"github.com/hashicorp/nomad/helper/uuid"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/state"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+// rebalanceTestAlloc returns a running allocation of the job's first task
+// group on node, without networks so that allocations never collide on
+// ports.
+func rebalanceTestAlloc(job *structs.Job, node *structs.Node, cpu, mem int64) *structs.Allocation {
+	alloc := mock.Alloc()
+	alloc.Job = job
+	alloc.JobID = job.ID
+	alloc.Namespace = job.Namespace
+	alloc.TaskGroup = job.TaskGroups[0].Name
+	alloc.NodeID = node.ID
+	alloc.ClientStatus = structs.AllocClientStatusRunning
+	alloc.AllocatedResources.Shared.Networks = nil
+	alloc.AllocatedResources.Tasks["web"].Networks = nil
+	alloc.AllocatedResources.Tasks["web"].Cpu.CpuShares = cpu
+	alloc.AllocatedResources.Tasks["web"].Memory.MemoryMB = mem
+	return alloc
+}
+
+func rebalanceTestState(t *testing.T, nodes []*structs.Node, jobs []*structs.Job, allocs []*structs.Allocation) *state.StateSnapshot {
+	store := state.TestStateStore(t)
+	for i, node := range nodes {
+		must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, uint64(100+i), node))
+	}
+	for i, job := range jobs {
+		must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, uint64(200+i), job))
+	}
+	must.NoError(t, store.UpsertAllocs(structs.MsgTypeTestSetup, 300, allocs))
+
+	snap, err := store.Snapshot()
+	must.NoError(t, err)
+	return snap
+}
+
+func TestRebalancer_Binpack(t *testing.T) {
+	ci.Parallel(t)
+
+	job := mock.Job()
+	job.TaskGroups[0].Migrate.MaxParallel = 10
+
+	full, half, light := mock.Node(), mock.Node(), mock.Node()
+	allocs := []*structs.Allocation{
+		rebalanceTestAlloc(job, full, 1000, 2048),
+		rebalanceTestAlloc(job, full, 1000, 2048),
+		rebalanceTestAlloc(job, half, 1000, 2048),
+		rebalanceTestAlloc(job, light, 250, 512),
+	}
+	snap := rebalanceTestState(t, []*structs.Node{full, half, light}, []*structs.Job{job}, allocs)
+
+	r := newRebalancer(snap, nil, 0, testlog.HCLogger(t))
+	migrations, err := r.compute()
+	must.NoError(t, err)
+
+	// The least used nodes are emptied onto the fullest node, starting
+	// with the least used one.
+	must.Len(t, 2, migrations)
+	must.Eq(t, allocs[3].ID, migrations[0].AllocID)
+	must.Eq(t, light.ID, migrations[0].SourceNodeID)
+	must.Eq(t, full.ID, migrations[0].TargetNodeID)
+	must.Eq(t, allocs[2].ID, migrations[1].AllocID)
+	must.Eq(t, half.ID, migrations[1].SourceNodeID)
+	must.Eq(t, full.ID, migrations[1].TargetNodeID)
+
+	req := r.transitionRequest()
+	must.MapLen(t, 2, req.Allocs)
+	must.True(t, req.Allocs[allocs[2].ID].ShouldMigrate())
+	must.Eq(t, full.ID, req.Allocs[allocs[2].ID].MigrateTargetNodeID)
+	must.Len(t, 1, req.Evals)
+	must.Eq(t, job.ID, req.Evals[0].JobID)
+	must.Eq(t, structs.EvalTriggerRebalance, req.Evals[0].TriggeredBy)
+}
+
+func TestRebalancer_Binpack_Limits(t *testing.T) {
+	ci.Parallel(t)
+
+	// The default migrate block only allows a single migration at a time.
+	job := mock.Job()
+	full, light := mock.Node(), mock.Node()
+	allocs := []*structs.Allocation{
+		rebalanceTestAlloc(job, full, 2000, 4096),
+		rebalanceTestAlloc(job, light, 250, 512),
+		rebalanceTestAlloc(job, light, 250, 512),
+	}
+
+	// Only a node whose allocations can all move is emptied.
+	snap := rebalanceTestState(t, []*structs.Node{full, light}, []*structs.Job{job}, allocs)
+	migrations, err := newRebalancer(snap, nil, 0, testlog.HCLogger(t)).compute()
+	must.NoError(t, err)
+	must.Len(t, 0, migrations)
+
+	// A raised max_parallel lets the node be emptied, unless the number of
+	// migrations exceeds the cluster-wide limit.
+	job.TaskGroups[0].Migrate.MaxParallel = 2
+	snap = rebalanceTestState(t, []*structs.Node{full, light}, []*structs.Job{job}, allocs)
+	migrations, err = newRebalancer(snap, nil, 0, testlog.HCLogger(t)).compute()
+	must.NoError(t, err)
+	must.Len(t, 2, migrations)
+
+	migrations, err = newRebalancer(snap, nil, 1, testlog.HCLogger(t)).compute()
+	must.NoError(t, err)
+	must.Len(t, 0, migrations)
+
+	// Allocations still starting count against max_parallel.
+	pending := rebalanceTestAlloc(job, full, 100, 128)
+	pending.ClientStatus = structs.AllocClientStatusPending
+	snap = rebalanceTestState(t, []*structs.Node{full, light}, []*structs.Job{job}, append(allocs, pending))
+	migrations, err = newRebalancer(snap, nil, 0, testlog.HCLogger(t)).compute()
+	must.NoError(t, err)
+	must.Len(t, 0, migrations)
+}
+
+func TestRebalancer_Spread(t *testing.T) {
+	ci.Parallel(t)
+
+	job := mock.Job()
+	job.TaskGroups[0].Migrate.MaxParallel = 4
+
+	busy, joined, otherDC := mock.Node(), mock.Node(), mock.Node()
+	otherDC.Datacenter = "dc2"
+
+	var allocs []*structs.Allocation
+	for i := 0; i < 4; i++ {
+		allocs = append(allocs, rebalanceTestAlloc(job, busy, 500, 1024))
+	}
+
+	// System allocations are never migrated.
+	system := mock.SystemJob()
+	systemAlloc := rebalanceTestAlloc(system, busy, 100, 128)
+	allocs = append(allocs, systemAlloc)
+
+	snap := rebalanceTestState(t, []*structs.Node{busy, joined, otherDC}, []*structs.Job{job, system}, allocs)
+	config := &structs.SchedulerConfiguration{SchedulerAlgorithm: structs.SchedulerAlgorithmSpread}
+
+	migrations, err := newRebalancer(snap, config, 0, testlog.HCLogger(t)).compute()
+	must.NoError(t, err)
+
+	// Half of the allocations move onto the node that joined the cluster,
+	// the node in a datacenter the job doesn't use is left alone.
+	must.Len(t, 2, migrations)
+	for _, m := range migrations {
+		must.Eq(t, busy.ID, m.SourceNodeID)
+		must.Eq(t, joined.ID, m.TargetNodeID)
+		must.NotEq(t, systemAlloc.ID, m.AllocID)
+	}
+}
+
+func TestRebalancer_DistinctHosts(t *testing.T) {
+	ci.Parallel(t)
+
+	job := mock.Job()
+	job.TaskGroups[0].Constraints = append(job.TaskGroups[0].Constraints, &structs.Constraint{
+		Operand: structs.ConstraintDistinctHosts,
+	})
+
+	full, light := mock.Node(), mock.Node()
+	allocs := []*structs.Allocation{
+		rebalanceTestAlloc(job, full, 2000, 4096),
+		rebalanceTestAlloc(job, light, 250, 512),
+	}
+	other := mock.Job()
+	other.ID = uuid.Generate()
+	allocs = append(allocs, rebalanceTestAlloc(other, light, 250, 512))
+
+	snap := rebalanceTestState(t, []*structs.Node{full, light}, []*structs.Job{job, other}, allocs)
+	migrations, err := newRebalancer(snap, nil, 0, testlog.HCLogger(t)).compute()
+	must.NoError(t, err)
+	must.Len(t, 0, migrations)
+}
+
+func TestRebalancer_Feasibility(t *testing.T) {
+	ci.Parallel(t)
+
+	job := mock.Job()
+
+	// The fuller node can't run the job's task driver, so the replacement
+	// can't be placed there even though it has room for it.
+	full, light := mock.Node(), mock.Node()
+	delete(full.Drivers, "exec")
+	delete(full.Attributes, "driver.exec")
+	must.NoError(t, full.ComputeClass())
+
+	allocs := []*structs.Allocation{
+		rebalanceTestAlloc(job, full, 2000, 4096),
+		rebalanceTestAlloc(job, light, 250, 512),
+	}
+
+	snap := rebalanceTestState(t, []*structs.Node{full, light}, []*structs.Job{job}, allocs)
+	migrations, err := newRebalancer(snap, nil, 0, testlog.HCLogger(t)).compute()
+	must.NoError(t, err)
+	must.Len(t, 0, migrations)
+}
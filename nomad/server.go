@@ -298,6 +298,7 @@ type endpoints struct {
 	Enterprise          *EnterpriseEndpoints
 	Event               *Event
 	Namespace           *Namespace
+	NodePool            *NodePool
 	Variables           *Variables
 	Keyring             *Keyring
 	ServiceRegistration *ServiceRegistration
@@ -428,6 +429,9 @@ func NewServer(config *Config, consulCatalog consul.CatalogAPI, consulConfigEntr
 		return nil, fmt.Errorf("Failed to start Raft: %v", err)
 	}
 
+	// Share eval processing between namespaces by their eval weight
+	s.evalBroker.SetNamespaceWeightFn(s.namespaceEvalWeight)
+
 	// Initialize the wan Serf
 	s.serf, err = s.setupSerf(config.SerfConfig, s.eventCh, serfSnapshot)
 	if err != nil {
@@ -1078,6 +1082,8 @@ func (s *Server) setupDeploymentWatcher() error {
 	s.deploymentWatcher = deploymentwatcher.NewDeploymentsWatcher(
 		s.logger,
 		raftShim,
+		&deploymentWatcherStatsShim{srv: s},
+		s.config.CanaryMetricsAllowlist,
 		NewDeploymentEndpoint(s, nil),
 		NewJobEndpoints(s, nil),
 		s.config.DeploymentQueryRateLimit,
@@ -1249,6 +1255,7 @@ func (s *Server) setupRpcServer(server *rpc.Server, ctx *RPCContext) {
 	_ = server.Register(NewKeyringEndpoint(s, ctx, s.encrypter))
 	_ = server.Register(NewNamespaceEndpoint(s, ctx))
 	_ = server.Register(NewNodeEndpoint(s, ctx))
+	_ = server.Register(NewNodePoolEndpoint(s, ctx))
 	_ = server.Register(NewPeriodicEndpoint(s, ctx))
 	_ = server.Register(NewPlanEndpoint(s, ctx))
 	_ = server.Register(NewRegionEndpoint(s, ctx))
@@ -1866,6 +1873,16 @@ func (s *Server) State() *state.StateStore {
 	return s.fsm.State()
 }
 
+// namespaceEvalWeight returns the eval weight of the namespace, or the
+// default weight if the namespace can't be found.
+func (s *Server) namespaceEvalWeight(namespace string) int {
+	ns, err := s.State().NamespaceByName(nil, namespace)
+	if err != nil {
+		return structs.DefaultNamespaceEvalWeight
+	}
+	return ns.GetEvalWeight()
+}
+
 // setLeaderAcl stores the given ACL token as the current leader's ACL token.
 func (s *Server) setLeaderAcl(token string) {
 	s.leaderAclLock.Lock()
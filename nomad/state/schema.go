@@ -20,6 +20,7 @@ const (
 	TableACLRoles             = "acl_roles"
 	TableACLAuthMethods       = "acl_auth_methods"
 	TableACLBindingRules      = "acl_binding_rules"
+	TableNodePools            = "node_pools"
 	TableAllocs               = "allocs"
 )
 
@@ -36,6 +37,7 @@ const (
 	indexName          = "name"
 	indexSigningKey    = "signing_key"
 	indexAuthMethod    = "auth_method"
+	indexNodePool      = "node_pool"
 )
 
 var (
@@ -90,6 +92,7 @@ func init() {
 		aclRolesTableSchema,
 		aclAuthMethodsTableSchema,
 		bindingRulesTableSchema,
+		nodePoolTableSchema,
 	}...)
 }
 
@@ -154,6 +157,14 @@ func nodeTableSchema() *memdb.TableSchema {
 					Field: "SecretID",
 				},
 			},
+			indexNodePool: {
+				Name:         indexNodePool,
+				AllowMissing: true,
+				Unique:       false,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "NodePool",
+				},
+			},
 		},
 	}
 }
@@ -1561,3 +1572,20 @@ func bindingRulesTableSchema() *memdb.TableSchema {
 		},
 	}
 }
+
+// nodePoolTableSchema returns the MemDB schema for the node pools table.
+func nodePoolTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: TableNodePools,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "Name",
+				},
+			},
+		},
+	}
+}
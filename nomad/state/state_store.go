@@ -154,6 +154,11 @@ func NewStateStore(config *StateStoreConfig) (*StateStore, error) {
 		return nil, fmt.Errorf("enterprise state store initialization failed: %v", err)
 	}
 
+	// Initialize the state store with the built-in node pools.
+	if err := s.nodePoolInit(); err != nil {
+		return nil, fmt.Errorf("node pool state store initialization failed: %v", err)
+	}
+
 	return s, nil
 }
 
@@ -934,6 +939,12 @@ func upsertNodeTxn(txn *txn, index uint64, node *structs.Node) error {
 		node.ModifyIndex = index
 	}
 
+	// Create the node pool the node belongs to if it doesn't exist yet, so
+	// that operators don't need to create a pool before registering clients.
+	if err := upsertNodePoolForNodeTxn(txn, index, node.NodePool); err != nil {
+		return fmt.Errorf("node pool upsert failed: %v", err)
+	}
+
 	// Insert the node
 	if err := txn.Insert("nodes", node); err != nil {
 		return fmt.Errorf("node insert failed: %v", err)
@@ -4599,6 +4610,17 @@ func (s *StateStore) UpdateDeploymentPromotion(msgType structs.MessageType, inde
 			continue
 		}
 
+		// Groups rolled out in stages advance one stage at a time once every
+		// allocation of the current stage is healthy. Promoting all groups
+		// only advances the stages that are healthy.
+		if dstate.StagedRollout() {
+			if !req.All && dstate.HasNextStage() && !dstate.StageHealthy() {
+				multierror.Append(&unhealthyErr, fmt.Errorf("Task group %q has %d/%d healthy allocations",
+					tg, dstate.HealthyAllocs, dstate.StageAllowance()))
+			}
+			continue
+		}
+
 		need := dstate.DesiredCanaries
 		if need == 0 {
 			continue
@@ -4622,11 +4644,19 @@ func (s *StateStore) UpdateDeploymentPromotion(msgType structs.MessageType, inde
 			continue
 		}
 
+		if status.StagedRollout() {
+			if !status.HasNextStage() || !status.StageHealthy() {
+				continue
+			}
+			status.Stage++
+		} else {
+			status.Promoted = true
+		}
+
 		// reset the progress deadline
 		if status.ProgressDeadline > 0 && !status.RequireProgressBy.IsZero() {
 			status.RequireProgressBy = time.Now().Add(status.ProgressDeadline)
 		}
-		status.Promoted = true
 	}
 
 	// If the deployment no longer needs promotion, update its status
@@ -0,0 +1,251 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// nodePoolInit creates the built-in node pools that must always be present in
+// the cluster. This is safe to do every time the state store is created,
+// since the built-in pools can't be modified and any snapshot restore will
+// carry the same objects.
+func (s *StateStore) nodePoolInit() error {
+	txn := s.db.WriteTxn(1)
+	defer txn.Abort()
+
+	for _, pool := range structs.BuiltInNodePools() {
+		if err := s.upsertNodePoolTxn(txn, 1, pool); err != nil {
+			return fmt.Errorf("inserting built-in node pools failed: %v", err)
+		}
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableNodePools, 1}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+
+	return txn.Commit()
+}
+
+// NodePools returns an iterator over all node pools.
+func (s *StateStore) NodePools(ws memdb.WatchSet, sort SortOption) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	var iter memdb.ResultIterator
+	var err error
+
+	switch sort {
+	case SortReverse:
+		iter, err = txn.GetReverse(TableNodePools, indexID)
+	default:
+		iter, err = txn.Get(TableNodePools, indexID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("node pools lookup failed: %v", err)
+	}
+
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// NodePoolByName returns the node pool that matches the given name or nil if
+// there is no match.
+func (s *StateStore) NodePoolByName(ws memdb.WatchSet, name string) (*structs.NodePool, error) {
+	txn := s.db.ReadTxn()
+	return s.nodePoolByNameTxn(txn, ws, name)
+}
+
+func (s *StateStore) nodePoolByNameTxn(txn ReadTxn, ws memdb.WatchSet, name string) (*structs.NodePool, error) {
+	watchCh, existing, err := txn.FirstWatch(TableNodePools, indexID, name)
+	if err != nil {
+		return nil, fmt.Errorf("node pool lookup failed: %v", err)
+	}
+	ws.Add(watchCh)
+
+	if existing == nil {
+		return nil, nil
+	}
+	return existing.(*structs.NodePool), nil
+}
+
+// NodePoolsByNamePrefix returns an iterator over all node pools that match
+// the given name prefix.
+func (s *StateStore) NodePoolsByNamePrefix(ws memdb.WatchSet, namePrefix string, sort SortOption) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	var iter memdb.ResultIterator
+	var err error
+
+	switch sort {
+	case SortReverse:
+		iter, err = txn.GetReverse(TableNodePools, indexID+"_prefix", namePrefix)
+	default:
+		iter, err = txn.Get(TableNodePools, indexID+"_prefix", namePrefix)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("node pools prefix lookup failed: %v", err)
+	}
+
+	ws.Add(iter.WatchCh())
+	return iter, nil
+}
+
+// NodesByNodePool returns an iterator over all nodes that are part of the
+// given node pool.
+func (s *StateStore) NodesByNodePool(ws memdb.WatchSet, pool string) (memdb.ResultIterator, error) {
+	txn := s.db.ReadTxn()
+
+	iter, err := txn.Get("nodes", indexNodePool, pool)
+	if err != nil {
+		return nil, fmt.Errorf("nodes lookup failed: %v", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	return iter, nil
+}
+
+// UpsertNodePools inserts or updates the given set of node pools.
+func (s *StateStore) UpsertNodePools(msgType structs.MessageType, index uint64, pools []*structs.NodePool) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	for _, pool := range pools {
+		if err := s.upsertNodePoolTxn(txn, index, pool); err != nil {
+			return err
+		}
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableNodePools, index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+
+	return txn.Commit()
+}
+
+func (s *StateStore) upsertNodePoolTxn(txn *txn, index uint64, pool *structs.NodePool) error {
+	if pool == nil {
+		return nil
+	}
+
+	existing, err := txn.First(TableNodePools, indexID, pool.Name)
+	if err != nil {
+		return fmt.Errorf("node pool lookup failed: %v", err)
+	}
+
+	if existing != nil {
+		// Prevent changes to built-in node pools.
+		if pool.IsBuiltIn() {
+			return fmt.Errorf("modifying node pool %q is not allowed", pool.Name)
+		}
+
+		exist := existing.(*structs.NodePool)
+		pool.CreateIndex = exist.CreateIndex
+		pool.ModifyIndex = index
+	} else {
+		pool.CreateIndex = index
+		pool.ModifyIndex = index
+	}
+
+	if err := txn.Insert(TableNodePools, pool); err != nil {
+		return fmt.Errorf("node pool insert failed: %v", err)
+	}
+
+	return nil
+}
+
+// upsertNodePoolForNodeTxn creates the node pool a node is registered in if
+// it doesn't exist yet. Existing pools are left untouched.
+func upsertNodePoolForNodeTxn(txn *txn, index uint64, poolName string) error {
+	if poolName == "" {
+		return nil
+	}
+
+	existing, err := txn.First(TableNodePools, indexID, poolName)
+	if err != nil {
+		return fmt.Errorf("node pool lookup failed: %v", err)
+	}
+	if existing != nil {
+		return nil
+	}
+
+	pool := &structs.NodePool{
+		Name:        poolName,
+		CreateIndex: index,
+		ModifyIndex: index,
+	}
+	if err := txn.Insert(TableNodePools, pool); err != nil {
+		return fmt.Errorf("node pool insert failed: %v", err)
+	}
+	if err := txn.Insert(tableIndex, &IndexEntry{TableNodePools, index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+
+	return nil
+}
+
+// DeleteNodePools removes the given set of node pools.
+func (s *StateStore) DeleteNodePools(msgType structs.MessageType, index uint64, names []string) error {
+	txn := s.db.WriteTxnMsgT(msgType, index)
+	defer txn.Abort()
+
+	for _, n := range names {
+		if err := s.deleteNodePoolTxn(txn, n); err != nil {
+			return err
+		}
+	}
+
+	if err := txn.Insert(tableIndex, &IndexEntry{TableNodePools, index}); err != nil {
+		return fmt.Errorf("index update failed: %v", err)
+	}
+
+	return txn.Commit()
+}
+
+func (s *StateStore) deleteNodePoolTxn(txn *txn, name string) error {
+	// Check if pool exists.
+	existing, err := txn.First(TableNodePools, indexID, name)
+	if err != nil {
+		return fmt.Errorf("node pool lookup failed: %v", err)
+	}
+	if existing == nil {
+		return fmt.Errorf("node pool %s not found", name)
+	}
+
+	pool := existing.(*structs.NodePool)
+	if pool.IsBuiltIn() {
+		return fmt.Errorf("deleting built-in node pool %q is not allowed", pool.Name)
+	}
+
+	// Ensure that the node pool doesn't have any registered nodes, since
+	// those would be left without a pool.
+	nodeIter, err := txn.Get("nodes", indexNodePool, name)
+	if err != nil {
+		return fmt.Errorf("nodes lookup failed: %v", err)
+	}
+	if raw := nodeIter.Next(); raw != nil {
+		node := raw.(*structs.Node)
+		return fmt.Errorf("node pool %q contains at least one node %q. "+
+			"All nodes must be removed from the node pool before it can be deleted", name, node.ID)
+	}
+
+	// Ensure that the node pool isn't used by any non-terminal jobs.
+	jobIter, err := txn.Get("jobs", "id")
+	if err != nil {
+		return fmt.Errorf("jobs lookup failed: %v", err)
+	}
+	for raw := jobIter.Next(); raw != nil; raw = jobIter.Next() {
+		job := raw.(*structs.Job)
+		if job.NodePool == name && job.Status != structs.JobStatusDead {
+			return fmt.Errorf("node pool %q is used by at least one non-terminal job %q in namespace %q",
+				name, job.ID, job.Namespace)
+		}
+	}
+
+	// Delete node pool.
+	if err := txn.Delete(TableNodePools, pool); err != nil {
+		return fmt.Errorf("node pool deletion failed: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+func TestStateStore_NodePools_BuiltIn(t *testing.T) {
+	ci.Parallel(t)
+	store := testStateStore(t)
+
+	// The built-in node pools must be present in a new state store.
+	for _, name := range []string{structs.NodePoolAll, structs.NodePoolDefault} {
+		pool, err := store.NodePoolByName(nil, name)
+		must.NoError(t, err)
+		must.NotNil(t, pool)
+		must.True(t, pool.IsBuiltIn())
+	}
+
+	// Built-in node pools cannot be modified or deleted.
+	pool := &structs.NodePool{Name: structs.NodePoolDefault, Description: "changed"}
+	err := store.UpsertNodePools(structs.MsgTypeTestSetup, 1000, []*structs.NodePool{pool})
+	must.EqError(t, err, `modifying node pool "default" is not allowed`)
+
+	err = store.DeleteNodePools(structs.MsgTypeTestSetup, 1000, []string{structs.NodePoolAll})
+	must.EqError(t, err, `deleting built-in node pool "all" is not allowed`)
+}
+
+func TestStateStore_UpsertNodePools(t *testing.T) {
+	ci.Parallel(t)
+	store := testStateStore(t)
+
+	pool1 := mock.NodePool()
+	pool2 := mock.NodePool()
+	must.NoError(t, store.UpsertNodePools(structs.MsgTypeTestSetup, 1000, []*structs.NodePool{pool1, pool2}))
+
+	index, err := store.Index(TableNodePools)
+	must.NoError(t, err)
+	must.Eq(t, 1000, index)
+
+	got, err := store.NodePoolByName(nil, pool1.Name)
+	must.NoError(t, err)
+	must.Eq(t, 1000, got.CreateIndex)
+	must.Eq(t, 1000, got.ModifyIndex)
+
+	// Update a node pool and make sure the create index is kept.
+	update := pool1.Copy()
+	update.Description = "updated"
+	must.NoError(t, store.UpsertNodePools(structs.MsgTypeTestSetup, 1001, []*structs.NodePool{update}))
+
+	got, err = store.NodePoolByName(nil, pool1.Name)
+	must.NoError(t, err)
+	must.Eq(t, "updated", got.Description)
+	must.Eq(t, 1000, got.CreateIndex)
+	must.Eq(t, 1001, got.ModifyIndex)
+
+	// List all pools, including the built-in ones.
+	iter, err := store.NodePools(memdb.NewWatchSet(), SortDefault)
+	must.NoError(t, err)
+
+	var count int
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		count++
+	}
+	must.Eq(t, 4, count)
+
+	// Prefix listing only returns the matching pool.
+	iter, err = store.NodePoolsByNamePrefix(memdb.NewWatchSet(), pool2.Name[:8], SortDefault)
+	must.NoError(t, err)
+	raw := iter.Next()
+	must.NotNil(t, raw)
+	must.Eq(t, pool2.Name, raw.(*structs.NodePool).Name)
+}
+
+func TestStateStore_UpsertNode_CreatesNodePool(t *testing.T) {
+	ci.Parallel(t)
+	store := testStateStore(t)
+
+	node := mock.Node()
+	node.NodePool = "dev"
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1000, node))
+
+	pool, err := store.NodePoolByName(nil, "dev")
+	must.NoError(t, err)
+	must.NotNil(t, pool)
+	must.Eq(t, 1000, pool.CreateIndex)
+
+	iter, err := store.NodesByNodePool(nil, "dev")
+	must.NoError(t, err)
+	raw := iter.Next()
+	must.NotNil(t, raw)
+	must.Eq(t, node.ID, raw.(*structs.Node).ID)
+}
+
+func TestStateStore_DeleteNodePools(t *testing.T) {
+	ci.Parallel(t)
+	store := testStateStore(t)
+
+	pool := mock.NodePool()
+	must.NoError(t, store.UpsertNodePools(structs.MsgTypeTestSetup, 1000, []*structs.NodePool{pool}))
+
+	// Deleting a nonexistent pool fails.
+	err := store.DeleteNodePools(structs.MsgTypeTestSetup, 1001, []string{"nope"})
+	must.EqError(t, err, "node pool nope not found")
+
+	// Pools with nodes cannot be deleted.
+	node := mock.Node()
+	node.NodePool = pool.Name
+	must.NoError(t, store.UpsertNode(structs.MsgTypeTestSetup, 1001, node))
+
+	err = store.DeleteNodePools(structs.MsgTypeTestSetup, 1002, []string{pool.Name})
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), "contains at least one node")
+	must.NoError(t, store.DeleteNode(structs.MsgTypeTestSetup, 1003, []string{node.ID}))
+
+	// Pools with non-terminal jobs cannot be deleted.
+	job := mock.Job()
+	job.NodePool = pool.Name
+	must.NoError(t, store.UpsertJob(structs.MsgTypeTestSetup, 1004, job))
+
+	err = store.DeleteNodePools(structs.MsgTypeTestSetup, 1005, []string{pool.Name})
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), "non-terminal job")
+
+	must.NoError(t, store.DeleteJob(1006, job.Namespace, job.ID))
+
+	must.NoError(t, store.DeleteNodePools(structs.MsgTypeTestSetup, 1007, []string{pool.Name}))
+
+	got, err := store.NodePoolByName(nil, pool.Name)
+	must.NoError(t, err)
+	must.Nil(t, got)
+
+	index, err := store.Index(TableNodePools)
+	must.NoError(t, err)
+	must.Eq(t, 1007, index)
+}
@@ -7901,6 +7901,65 @@ func TestStateStore_UpsertDeploymentPromotion_Subset(t *testing.T) {
 	require.True(aout3.DeploymentStatus.Canary)
 }
 
+// Test promoting a deployment rolled out in stages advances it one stage at a
+// time once the allocations of the stage are healthy
+func TestStateStore_UpsertDeploymentPromotion_Stages(t *testing.T) {
+	ci.Parallel(t)
+	require := require.New(t)
+
+	state := testStateStore(t)
+
+	j := mock.Job()
+	require.Nil(state.UpsertJob(structs.MsgTypeTestSetup, 1, j))
+
+	// Create a deployment in the first stage of its rollout
+	d := mock.Deployment()
+	d.JobID = j.ID
+	d.TaskGroups = map[string]*structs.DeploymentState{
+		"web": {
+			DesiredTotal:  10,
+			HealthyAllocs: 1,
+			Stages: []*structs.UpdateStage{
+				{Percent: 20},
+				{Percent: 100},
+			},
+		},
+	}
+	require.Nil(state.UpsertDeployment(2, d))
+
+	req := &structs.ApplyDeploymentPromoteRequest{
+		DeploymentPromoteRequest: structs.DeploymentPromoteRequest{
+			DeploymentID: d.ID,
+			Groups:       []string{"web"},
+		},
+	}
+
+	// The stage is not healthy yet
+	err := state.UpdateDeploymentPromotion(structs.MsgTypeTestSetup, 3, req)
+	require.Error(err)
+	require.Contains(err.Error(), `Task group "web" has 1/2 healthy allocations`)
+
+	// Promoting all groups skips the unhealthy stage
+	req.All = true
+	require.Nil(state.UpdateDeploymentPromotion(structs.MsgTypeTestSetup, 4, req))
+	ws := memdb.NewWatchSet()
+	dout, err := state.DeploymentByID(ws, d.ID)
+	require.Nil(err)
+	require.Equal(0, dout.TaskGroups["web"].Stage)
+
+	// Advance to the next stage once healthy
+	d = dout.Copy()
+	d.TaskGroups["web"].HealthyAllocs = 2
+	require.Nil(state.UpsertDeployment(5, d))
+	req.All = false
+	require.Nil(state.UpdateDeploymentPromotion(structs.MsgTypeTestSetup, 6, req))
+
+	dout, err = state.DeploymentByID(ws, d.ID)
+	require.Nil(err)
+	require.Equal(1, dout.TaskGroups["web"].Stage)
+	require.False(dout.TaskGroups["web"].HasNextStage())
+}
+
 // Test that allocation health can't be set against a nonexistent deployment
 func TestStateStore_UpsertDeploymentAllocHealth_Nonexistent(t *testing.T) {
 	ci.Parallel(t)
@@ -0,0 +1,155 @@
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	// AllocAffinityAllNamespaces is the namespace value used by allocation
+	// affinities to match allocations in every namespace.
+	AllocAffinityAllNamespaces = "*"
+)
+
+// AllocAffinity is used to place allocations relative to the allocations
+// already running on a node, instead of the node attributes. A node matches
+// the rule when it runs at least one allocation that matches every field set.
+type AllocAffinity struct {
+	// Namespace is the namespace of the allocations to match. An empty value
+	// matches the namespace of the job being placed and "*" matches every
+	// namespace.
+	Namespace string
+
+	// JobID is the ID of the job of the allocations to match.
+	JobID string
+
+	// TaskGroup is the task group of the allocations to match.
+	TaskGroup string
+
+	// JobMeta is a set of key/value pairs that must all be present in the
+	// metadata of the job of the allocations to match.
+	JobMeta map[string]string
+
+	// Anti inverts the rule so that nodes running matching allocations are
+	// avoided instead of preferred.
+	Anti bool
+
+	// Required makes the rule a hard constraint. Nodes that don't satisfy a
+	// required rule are not considered for placement.
+	Required bool
+
+	// Weight is the weight applied to nodes that satisfy the rule when it is
+	// not required.
+	Weight int8
+}
+
+// Copy returns a deep copy of the allocation affinity.
+func (a *AllocAffinity) Copy() *AllocAffinity {
+	if a == nil {
+		return nil
+	}
+	na := new(AllocAffinity)
+	*na = *a
+	na.JobMeta = maps.Clone(a.JobMeta)
+	return na
+}
+
+// Equal checks if two allocation affinities are equal.
+func (a *AllocAffinity) Equal(o *AllocAffinity) bool {
+	if a == nil || o == nil {
+		return a == o
+	}
+	return a.Namespace == o.Namespace &&
+		a.JobID == o.JobID &&
+		a.TaskGroup == o.TaskGroup &&
+		maps.Equal(a.JobMeta, o.JobMeta) &&
+		a.Anti == o.Anti &&
+		a.Required == o.Required &&
+		a.Weight == o.Weight
+}
+
+func (a *AllocAffinity) String() string {
+	kind := "alloc_affinity"
+	if a.Anti {
+		kind = "alloc_anti_affinity"
+	}
+
+	var targets []string
+	if a.Namespace != "" {
+		targets = append(targets, fmt.Sprintf("namespace=%s", a.Namespace))
+	}
+	if a.JobID != "" {
+		targets = append(targets, fmt.Sprintf("job_id=%s", a.JobID))
+	}
+	if a.TaskGroup != "" {
+		targets = append(targets, fmt.Sprintf("task_group=%s", a.TaskGroup))
+	}
+	keys := maps.Keys(a.JobMeta)
+	sort.Strings(keys)
+	for _, k := range keys {
+		targets = append(targets, fmt.Sprintf("job_meta.%s=%s", k, a.JobMeta[k]))
+	}
+	return fmt.Sprintf("%s %s", kind, strings.Join(targets, ","))
+}
+
+// Validate returns an error if the allocation affinity is invalid.
+func (a *AllocAffinity) Validate() error {
+	var mErr multierror.Error
+
+	if a.JobID == "" && len(a.JobMeta) == 0 {
+		mErr.Errors = append(mErr.Errors, errors.New("Allocation affinity must set a job ID or job meta"))
+	}
+	if a.TaskGroup != "" && a.JobID == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("Allocation affinity task group requires a job ID"))
+	}
+	if a.Namespace != "" && a.Namespace != AllocAffinityAllNamespaces &&
+		!validNamespaceName.MatchString(a.Namespace) {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("Invalid allocation affinity namespace %q", a.Namespace))
+	}
+	if !a.Required && (a.Weight < 1 || a.Weight > 100) {
+		mErr.Errors = append(mErr.Errors, errors.New("Allocation affinity weight must be within the range [1,100]"))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// MatchesAlloc returns true if the allocation, which belongs to job, matches
+// the rule. The namespace argument is the namespace of the job being placed
+// and is used when the rule doesn't set one.
+func (a *AllocAffinity) MatchesAlloc(namespace string, alloc *Allocation, job *Job) bool {
+	switch a.Namespace {
+	case AllocAffinityAllNamespaces:
+	case "":
+		if alloc.Namespace != namespace {
+			return false
+		}
+	default:
+		if alloc.Namespace != a.Namespace {
+			return false
+		}
+	}
+
+	if a.JobID != "" && alloc.JobID != a.JobID {
+		return false
+	}
+	if a.TaskGroup != "" && alloc.TaskGroup != a.TaskGroup {
+		return false
+	}
+	if len(a.JobMeta) == 0 {
+		return true
+	}
+	if job == nil {
+		return false
+	}
+	for k, v := range a.JobMeta {
+		if mv, ok := job.Meta[k]; !ok || mv != v {
+			return false
+		}
+	}
+	return true
+}
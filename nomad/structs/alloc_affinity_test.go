@@ -0,0 +1,140 @@
+package structs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestAllocAffinity_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name     string
+		affinity *AllocAffinity
+		expErr   string
+	}{
+		{
+			name:     "valid job",
+			affinity: &AllocAffinity{JobID: "cache", TaskGroup: "redis", Weight: 50},
+		},
+		{
+			name:     "valid required meta",
+			affinity: &AllocAffinity{Namespace: "*", JobMeta: map[string]string{"team": "a"}, Required: true},
+		},
+		{
+			name:     "no target",
+			affinity: &AllocAffinity{Weight: 50},
+			expErr:   "must set a job ID or job meta",
+		},
+		{
+			name:     "task group without job",
+			affinity: &AllocAffinity{TaskGroup: "redis", JobMeta: map[string]string{"team": "a"}, Weight: 50},
+			expErr:   "task group requires a job ID",
+		},
+		{
+			name:     "bad namespace",
+			affinity: &AllocAffinity{Namespace: "bad namespace", JobID: "cache", Weight: 50},
+			expErr:   `Invalid allocation affinity namespace "bad namespace"`,
+		},
+		{
+			name:     "bad weight",
+			affinity: &AllocAffinity{JobID: "cache", Weight: -10},
+			expErr:   "weight must be within the range [1,100]",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.affinity.Validate()
+			if tc.expErr == "" {
+				must.NoError(t, err)
+				return
+			}
+			must.Error(t, err)
+			must.StrContains(t, err.Error(), tc.expErr)
+		})
+	}
+}
+
+func TestAllocAffinity_MatchesAlloc(t *testing.T) {
+	ci.Parallel(t)
+
+	job := &Job{
+		Namespace: "prod",
+		ID:        "cache",
+		Meta:      map[string]string{"team": "a", "tier": "1"},
+	}
+	alloc := &Allocation{
+		Namespace: "prod",
+		JobID:     "cache",
+		TaskGroup: "redis",
+	}
+
+	cases := []struct {
+		name      string
+		affinity  *AllocAffinity
+		namespace string
+		job       *Job
+		expected  bool
+	}{
+		{
+			name:      "job in placing namespace",
+			affinity:  &AllocAffinity{JobID: "cache"},
+			namespace: "prod",
+			job:       job,
+			expected:  true,
+		},
+		{
+			name:      "job in other namespace",
+			affinity:  &AllocAffinity{JobID: "cache"},
+			namespace: "dev",
+			job:       job,
+		},
+		{
+			name:      "explicit namespace",
+			affinity:  &AllocAffinity{Namespace: "prod", JobID: "cache"},
+			namespace: "dev",
+			job:       job,
+			expected:  true,
+		},
+		{
+			name:      "all namespaces",
+			affinity:  &AllocAffinity{Namespace: AllocAffinityAllNamespaces, JobID: "cache", TaskGroup: "redis"},
+			namespace: "dev",
+			job:       job,
+			expected:  true,
+		},
+		{
+			name:      "other task group",
+			affinity:  &AllocAffinity{JobID: "cache", TaskGroup: "memcached"},
+			namespace: "prod",
+			job:       job,
+		},
+		{
+			name:      "job meta",
+			affinity:  &AllocAffinity{JobMeta: map[string]string{"team": "a"}},
+			namespace: "prod",
+			job:       job,
+			expected:  true,
+		},
+		{
+			name:      "job meta mismatch",
+			affinity:  &AllocAffinity{JobMeta: map[string]string{"team": "a", "tier": "2"}},
+			namespace: "prod",
+			job:       job,
+		},
+		{
+			name:      "job meta without job",
+			affinity:  &AllocAffinity{JobMeta: map[string]string{"team": "a"}},
+			namespace: "prod",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			must.Eq(t, tc.expected, tc.affinity.MatchesAlloc(tc.namespace, alloc, tc.job))
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package structs
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"golang.org/x/exp/maps"
+)
+
+// validCustomResourceName is the pattern custom resource names must match.
+var validCustomResourceName = regexp.MustCompile(`^[a-zA-Z0-9_\-]+$`)
+
+// CustomResources are countable resources declared by operators on clients,
+// such as license seats or hardware tokens, mapped by name to their count.
+// They are requested by tasks and accounted for like cpu and memory.
+type CustomResources map[string]uint64
+
+// Copy returns a copy of the custom resources.
+func (c CustomResources) Copy() CustomResources {
+	if c == nil {
+		return nil
+	}
+	return maps.Clone(c)
+}
+
+// Equal returns true if both sets of custom resources have the same counts.
+// Resources with a count of zero are ignored.
+func (c CustomResources) Equal(o CustomResources) bool {
+	for name, count := range c {
+		if o[name] != count {
+			return false
+		}
+	}
+	for name, count := range o {
+		if c[name] != count {
+			return false
+		}
+	}
+	return true
+}
+
+// Add adds the counts of delta to the custom resources, which must not be nil
+// if delta has resources.
+func (c CustomResources) Add(delta CustomResources) {
+	for name, count := range delta {
+		c[name] += count
+	}
+}
+
+// Subtract subtracts the counts of delta from the custom resources.
+func (c CustomResources) Subtract(delta CustomResources) {
+	for name, count := range delta {
+		if c[name] <= count {
+			delete(c, name)
+		} else {
+			c[name] -= count
+		}
+	}
+}
+
+// Max sets the count of each custom resource to the largest of its count
+// and the count in other.
+func (c CustomResources) Max(other CustomResources) {
+	for name, count := range other {
+		if count > c[name] {
+			c[name] = count
+		}
+	}
+}
+
+// Superset returns whether the custom resources have at least the counts of
+// other. If not, the name of the first exhausted resource is returned.
+func (c CustomResources) Superset(other CustomResources) (bool, string) {
+	names := maps.Keys(other)
+	sort.Strings(names)
+	for _, name := range names {
+		if c[name] < other[name] {
+			return false, name
+		}
+	}
+	return true, ""
+}
+
+// Validate returns an error if the custom resources have invalid names.
+func (c CustomResources) Validate() error {
+	var mErr multierror.Error
+	for name := range c {
+		if !validCustomResourceName.MatchString(name) {
+			_ = multierror.Append(&mErr, fmt.Errorf("custom resource name %q is invalid", name))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
@@ -0,0 +1,57 @@
+package structs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestCustomResources_Equal(t *testing.T) {
+	ci.Parallel(t)
+
+	a := CustomResources{"license_seat": 2, "token": 0}
+	must.True(t, a.Equal(CustomResources{"license_seat": 2}))
+	must.True(t, CustomResources(nil).Equal(CustomResources{"token": 0}))
+	must.False(t, a.Equal(CustomResources{"license_seat": 1}))
+	must.False(t, a.Equal(nil))
+}
+
+func TestCustomResources_AddSubtract(t *testing.T) {
+	ci.Parallel(t)
+
+	c := CustomResources{"license_seat": 2}
+	c.Add(CustomResources{"license_seat": 1, "token": 1})
+	must.Eq(t, CustomResources{"license_seat": 3, "token": 1}, c)
+
+	c.Subtract(CustomResources{"license_seat": 1, "token": 2})
+	must.Eq(t, CustomResources{"license_seat": 2}, c)
+
+	c.Max(CustomResources{"license_seat": 1, "token": 4})
+	must.Eq(t, CustomResources{"license_seat": 2, "token": 4}, c)
+}
+
+func TestCustomResources_Superset(t *testing.T) {
+	ci.Parallel(t)
+
+	c := CustomResources{"license_seat": 2, "token": 1}
+
+	ok, name := c.Superset(CustomResources{"license_seat": 2})
+	must.True(t, ok)
+	must.Eq(t, "", name)
+
+	ok, name = c.Superset(CustomResources{"license_seat": 3, "token": 2})
+	must.False(t, ok)
+	must.Eq(t, "license_seat", name)
+
+	ok, name = c.Superset(CustomResources{"build_slot": 1})
+	must.False(t, ok)
+	must.Eq(t, "build_slot", name)
+}
+
+func TestCustomResources_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	must.NoError(t, CustomResources{"license_seat": 1, "build-slot": 2}.Validate())
+	must.Error(t, CustomResources{"license seat": 1}.Validate())
+}
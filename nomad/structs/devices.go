@@ -17,6 +17,11 @@ type DeviceAccounterInstance struct {
 	// Instances is a mapping of the device IDs to their usage.
 	// Only a value of 0 indicates that the instance is unused.
 	Instances map[string]int
+
+	// Shares is a mapping of the device IDs of shareable devices to the
+	// amount of their capacity that is allocated. Instances that are
+	// allocated shares of are not counted in Instances.
+	Shares map[string]uint64
 }
 
 // NewDeviceAccounter returns a new device accounter. The node is used to
@@ -41,6 +46,7 @@ func NewDeviceAccounter(n *Node) *DeviceAccounter {
 		d.Devices[id] = &DeviceAccounterInstance{
 			Device:    dev,
 			Instances: make(map[string]int, len(dev.Instances)),
+			Shares:    make(map[string]uint64),
 		}
 		for _, instance := range dev.Instances {
 			// Skip unhealthy devices as they aren't allocatable
@@ -79,21 +85,18 @@ func (d *DeviceAccounter) AddAllocs(allocs []*Allocation) (collision bool) {
 			for _, device := range tr.Devices {
 				devID := device.ID()
 
+				// Mark that we are using the devices. The device may not be
+				// in the map if it is no longer being fingerprinted, is
+				// unhealthy, etc.
+				devInst, ok := d.Devices[*devID]
+				if !ok {
+					continue
+				}
+
 				// Go through each assigned device
 				for _, instanceID := range device.DeviceIDs {
-
-					// Mark that we are using the device. It may not be in the
-					// map if the device is no longer being fingerprinted, is
-					// unhealthy, etc.
-					if devInst, ok := d.Devices[*devID]; ok {
-						if i, ok := devInst.Instances[instanceID]; ok {
-							// Mark that the device is in use
-							devInst.Instances[instanceID]++
-
-							if i != 0 {
-								collision = true
-							}
-						}
+					if devInst.add(instanceID, device.Share) {
+						collision = true
 					}
 				}
 			}
@@ -114,29 +117,55 @@ func (d *DeviceAccounter) AddReserved(res *AllocatedDeviceResource) (collision b
 
 	// For each reserved instance, mark it as used
 	for _, id := range res.DeviceIDs {
-		cur, ok := devInst.Instances[id]
-		if !ok {
-			continue
-		}
-
-		// It has already been used, so mark that there is a collision
-		if cur != 0 {
+		if devInst.add(id, res.Share) {
 			collision = true
 		}
-
-		devInst.Instances[id]++
 	}
 
 	return
 }
 
+// add marks the device instance as used, either whole or by the given share of
+// its capacity, and returns if there is a collision.
+func (i *DeviceAccounterInstance) add(id string, share uint64) (collision bool) {
+	cur, ok := i.Instances[id]
+	if !ok {
+		return false
+	}
+
+	// A share of the device collides with whole use of it, or with other
+	// shares when its capacity is exceeded
+	if share != 0 {
+		i.Shares[id] += share
+		return cur != 0 || i.Shares[id] > i.Device.Capacity
+	}
+
+	i.Instances[id]++
+	return cur != 0 || i.Shares[id] != 0
+}
+
 // FreeCount returns the number of free device instances
 func (i *DeviceAccounterInstance) FreeCount() int {
 	count := 0
-	for _, c := range i.Instances {
-		if c == 0 {
+	for id, c := range i.Instances {
+		if c == 0 && i.Shares[id] == 0 {
 			count++
 		}
 	}
 	return count
 }
+
+// RemainingCapacity returns the amount of the capacity of a shareable device
+// instance that isn't allocated. It is zero for instances that are used whole
+// or that aren't shareable.
+func (i *DeviceAccounterInstance) RemainingCapacity(id string) uint64 {
+	if c, ok := i.Instances[id]; !ok || c != 0 {
+		return 0
+	}
+
+	used := i.Shares[id]
+	if used >= i.Device.Capacity {
+		return 0
+	}
+	return i.Device.Capacity - used
+}
@@ -259,3 +259,37 @@ func TestDeviceAccounter_AddReserved_Collision(t *testing.T) {
 	res.DeviceIDs = []string{nvidiaDev0ID}
 	require.True(d.AddReserved(res))
 }
+
+// Test that shares of a device are accounted against its capacity
+func TestDeviceAccounter_AddReserved_Shares(t *testing.T) {
+	ci.Parallel(t)
+
+	n := devNode()
+	n.NodeResources.Devices[0].Capacity = 10
+	d := NewDeviceAccounter(n)
+
+	nvidiaDev0ID := n.NodeResources.Devices[0].Instances[0].ID
+	nvidiaDevice := d.Devices[*n.NodeResources.Devices[0].ID()]
+
+	// Shares of the device fit up to its capacity
+	res := nvidiaAllocatedDevice()
+	res.DeviceIDs = []string{nvidiaDev0ID}
+	res.Share = 4
+	must.False(t, d.AddReserved(res))
+	must.False(t, d.AddReserved(res))
+	must.Eq(t, 2, nvidiaDevice.RemainingCapacity(nvidiaDev0ID))
+	must.Eq(t, 0, nvidiaDevice.Instances[nvidiaDev0ID])
+	must.Eq(t, 1, nvidiaDevice.FreeCount())
+
+	// Exceeding the capacity collides
+	must.True(t, d.AddReserved(res))
+
+	// Using a shared device whole collides
+	d = NewDeviceAccounter(n)
+	nvidiaDevice = d.Devices[*n.NodeResources.Devices[0].ID()]
+	must.False(t, d.AddReserved(res))
+	res = nvidiaAllocatedDevice()
+	res.DeviceIDs = []string{nvidiaDev0ID}
+	must.True(t, d.AddReserved(res))
+	must.Eq(t, 0, nvidiaDevice.RemainingCapacity(nvidiaDev0ID))
+}
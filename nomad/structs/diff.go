@@ -123,6 +123,17 @@ func (j *Job) Diff(other *Job, contextual bool) (*JobDiff, error) {
 		diff.Objects = append(diff.Objects, affinitiesDiff...)
 	}
 
+	// Allocation affinities diff
+	allocAffinitiesDiff := primitiveObjectSetDiff(
+		interfaceSlice(j.AllocAffinities),
+		interfaceSlice(other.AllocAffinities),
+		nil,
+		"AllocAffinity",
+		contextual)
+	if allocAffinitiesDiff != nil {
+		diff.Objects = append(diff.Objects, allocAffinitiesDiff...)
+	}
+
 	// Task groups diff
 	tgs, err := taskGroupDiffs(j.TaskGroups, other.TaskGroups, contextual)
 	if err != nil {
@@ -131,7 +142,7 @@ func (j *Job) Diff(other *Job, contextual bool) (*JobDiff, error) {
 	diff.TaskGroups = tgs
 
 	// Periodic diff
-	if pDiff := primitiveObjectDiff(j.Periodic, other.Periodic, nil, "Periodic", contextual); pDiff != nil {
+	if pDiff := periodicDiff(j.Periodic, other.Periodic, contextual); pDiff != nil {
 		diff.Objects = append(diff.Objects, pDiff)
 	}
 
@@ -145,6 +156,11 @@ func (j *Job) Diff(other *Job, contextual bool) (*JobDiff, error) {
 		diff.Objects = append(diff.Objects, mrDiff)
 	}
 
+	// Scheduling diff
+	if sDiff := primitiveObjectDiff(j.Scheduling, other.Scheduling, nil, "Scheduling", contextual); sDiff != nil {
+		diff.Objects = append(diff.Objects, sDiff)
+	}
+
 	// Check to see if there is a diff. We don't use reflect because we are
 	// filtering quite a few fields that will change on each diff.
 	if diff.Type == DiffTypeNone {
@@ -304,14 +320,62 @@ func (tg *TaskGroup) Diff(other *TaskGroup, contextual bool) (*TaskGroupDiff, er
 		diff.Objects = append(diff.Objects, affinitiesDiff...)
 	}
 
+	// Allocation affinities diff
+	allocAffinitiesDiff := primitiveObjectSetDiff(
+		interfaceSlice(tg.AllocAffinities),
+		interfaceSlice(other.AllocAffinities),
+		nil,
+		"AllocAffinity",
+		contextual)
+	if allocAffinitiesDiff != nil {
+		diff.Objects = append(diff.Objects, allocAffinitiesDiff...)
+	}
+
+	// Group dependencies diff
+	dependsOnDiff := primitiveObjectSetDiff(
+		interfaceSlice(tg.DependsOn),
+		interfaceSlice(other.DependsOn),
+		nil,
+		"DependsOn",
+		contextual)
+	if dependsOnDiff != nil {
+		diff.Objects = append(diff.Objects, dependsOnDiff...)
+	}
+
 	// Restart policy diff
 	rDiff := primitiveObjectDiff(tg.RestartPolicy, other.RestartPolicy, nil, "RestartPolicy", contextual)
+	var oldRestartRules, newRestartRules ExitRules
+	if tg.RestartPolicy != nil {
+		oldRestartRules = tg.RestartPolicy.OnExit
+	}
+	if other.RestartPolicy != nil {
+		newRestartRules = other.RestartPolicy.OnExit
+	}
+	if eDiffs := exitRulesDiff(oldRestartRules, newRestartRules, contextual); eDiffs != nil {
+		if rDiff == nil {
+			rDiff = &ObjectDiff{Type: DiffTypeEdited, Name: "RestartPolicy"}
+		}
+		rDiff.Objects = append(rDiff.Objects, eDiffs...)
+	}
 	if rDiff != nil {
 		diff.Objects = append(diff.Objects, rDiff)
 	}
 
 	// Reschedule policy diff
 	reschedDiff := primitiveObjectDiff(tg.ReschedulePolicy, other.ReschedulePolicy, nil, "ReschedulePolicy", contextual)
+	var oldRescheduleRules, newRescheduleRules ExitRules
+	if tg.ReschedulePolicy != nil {
+		oldRescheduleRules = tg.ReschedulePolicy.OnExit
+	}
+	if other.ReschedulePolicy != nil {
+		newRescheduleRules = other.ReschedulePolicy.OnExit
+	}
+	if eDiffs := exitRulesDiff(oldRescheduleRules, newRescheduleRules, contextual); eDiffs != nil {
+		if reschedDiff == nil {
+			reschedDiff = &ObjectDiff{Type: DiffTypeEdited, Name: "ReschedulePolicy"}
+		}
+		reschedDiff.Objects = append(reschedDiff.Objects, eDiffs...)
+	}
 	if reschedDiff != nil {
 		diff.Objects = append(diff.Objects, reschedDiff)
 	}
@@ -327,6 +391,11 @@ func (tg *TaskGroup) Diff(other *TaskGroup, contextual bool) (*TaskGroupDiff, er
 		diff.Objects = append(diff.Objects, consulDiff)
 	}
 
+	// Scheduling diff
+	if sDiff := primitiveObjectDiff(tg.Scheduling, other.Scheduling, nil, "Scheduling", contextual); sDiff != nil {
+		diff.Objects = append(diff.Objects, sDiff)
+	}
+
 	// Update diff
 	// COMPAT: Remove "Stagger" in 0.7.0.
 	if uDiff := primitiveObjectDiff(tg.Update, other.Update, []string{"Stagger"}, "Update", contextual); uDiff != nil {
@@ -516,6 +585,11 @@ func (t *Task) Diff(other *Task, contextual bool) (*TaskDiff, error) {
 		diff.Objects = append(diff.Objects, dDiff)
 	}
 
+	// Prestop diff
+	if pDiff := primitiveObjectDiff(t.Prestop, other.Prestop, nil, "Prestop", contextual); pDiff != nil {
+		diff.Objects = append(diff.Objects, pDiff)
+	}
+
 	// Artifacts diff
 	diffs := primitiveObjectSetDiff(
 		interfaceSlice(t.Artifacts),
@@ -1823,6 +1897,29 @@ func findTemplateMatch(template *Template, newTemplates []*Template, newTemplate
 // parameterizedJobDiff returns the diff of two parameterized job objects. If
 // contextual diff is enabled, all fields will be returned, even if no diff
 // occurred.
+func periodicDiff(old, new *PeriodicConfig, contextual bool) *ObjectDiff {
+	diff := primitiveObjectDiff(old, new, nil, "Periodic", contextual)
+	if old == nil {
+		old = &PeriodicConfig{}
+	}
+	if new == nil {
+		new = &PeriodicConfig{}
+	}
+
+	// Specs diff
+	if specsDiff := stringSetDiff(old.Specs, new.Specs, "Specs", contextual); specsDiff != nil {
+		if diff == nil {
+			diff = &ObjectDiff{Type: DiffTypeEdited, Name: "Periodic"}
+			if contextual {
+				diff.Fields = fieldDiffs(flatmap.Flatten(old, nil, true), flatmap.Flatten(new, nil, true), contextual)
+			}
+		}
+		diff.Objects = append(diff.Objects, specsDiff)
+	}
+
+	return diff
+}
+
 func parameterizedJobDiff(old, new *ParameterizedJobConfig, contextual bool) *ObjectDiff {
 	diff := &ObjectDiff{Type: DiffTypeNone, Name: "ParameterizedJob"}
 	var oldPrimitiveFlat, newPrimitiveFlat map[string]string
@@ -2099,6 +2196,11 @@ func (r *Resources) Diff(other *Resources, contextual bool) *ObjectDiff {
 	// Diff the primitive fields.
 	diff.Fields = fieldDiffs(oldPrimitiveFlat, newPrimitiveFlat, contextual)
 
+	// NUMA diff
+	if nDiff := primitiveObjectDiff(r.NUMA, other.NUMA, nil, "NUMA", contextual); nDiff != nil {
+		diff.Objects = append(diff.Objects, nDiff)
+	}
+
 	// Network Resources diff
 	if nDiffs := networkResourceDiffs(r.Networks, other.Networks, contextual); nDiffs != nil {
 		diff.Objects = append(diff.Objects, nDiffs...)
@@ -36,6 +36,29 @@ func TestJobDiff(t *testing.T) {
 			},
 			Error: true,
 		},
+		{
+			// Node pool edited
+			Old: &Job{
+				ID:       "foo",
+				NodePool: "default",
+			},
+			New: &Job{
+				ID:       "foo",
+				NodePool: "gpu",
+			},
+			Expected: &JobDiff{
+				Type: DiffTypeEdited,
+				ID:   "foo",
+				Fields: []*FieldDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "NodePool",
+						Old:  "default",
+						New:  "gpu",
+					},
+				},
+			},
+		},
 		{
 			// Primitive only that is the same
 			Old: &Job{
@@ -156,6 +179,12 @@ func TestJobDiff(t *testing.T) {
 						Old:  "true",
 						New:  "",
 					},
+					{
+						Type: DiffTypeDeleted,
+						Name: "ArrayCount",
+						Old:  "0",
+						New:  "",
+					},
 					{
 						Type: DiffTypeDeleted,
 						Name: "Dispatched",
@@ -225,6 +254,12 @@ func TestJobDiff(t *testing.T) {
 						Old:  "",
 						New:  "true",
 					},
+					{
+						Type: DiffTypeAdded,
+						Name: "ArrayCount",
+						Old:  "",
+						New:  "0",
+					},
 					{
 						Type: DiffTypeAdded,
 						Name: "Dispatched",
@@ -457,12 +492,30 @@ func TestJobDiff(t *testing.T) {
 						Type: DiffTypeAdded,
 						Name: "Periodic",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "CatchupWindow",
+								Old:  "",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "Enabled",
 								Old:  "",
 								New:  "false",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "FailedHistoryLimit",
+								Old:  "",
+								New:  "0",
+							},
+							{
+								Type: DiffTypeAdded,
+								Name: "Jitter",
+								Old:  "",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "ProhibitOverlap",
@@ -481,6 +534,12 @@ func TestJobDiff(t *testing.T) {
 								Old:  "",
 								New:  "foo",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "SuccessfulHistoryLimit",
+								Old:  "",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "TimeZone",
@@ -511,12 +570,30 @@ func TestJobDiff(t *testing.T) {
 						Type: DiffTypeDeleted,
 						Name: "Periodic",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeDeleted,
+								Name: "CatchupWindow",
+								Old:  "0",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "Enabled",
 								Old:  "false",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "FailedHistoryLimit",
+								Old:  "0",
+								New:  "",
+							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "Jitter",
+								Old:  "0",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "ProhibitOverlap",
@@ -535,6 +612,12 @@ func TestJobDiff(t *testing.T) {
 								Old:  "foo",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "SuccessfulHistoryLimit",
+								Old:  "0",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "TimeZone",
@@ -636,12 +719,36 @@ func TestJobDiff(t *testing.T) {
 						Type: DiffTypeEdited,
 						Name: "Periodic",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeNone,
+								Name: "CatchupWindow",
+								Old:  "0",
+								New:  "0",
+							},
+							{
+								Type: DiffTypeNone,
+								Name: "ConcurrencyPolicy",
+								Old:  "",
+								New:  "",
+							},
 							{
 								Type: DiffTypeEdited,
 								Name: "Enabled",
 								Old:  "false",
 								New:  "true",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "FailedHistoryLimit",
+								Old:  "0",
+								New:  "0",
+							},
+							{
+								Type: DiffTypeNone,
+								Name: "Jitter",
+								Old:  "0",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeNone,
 								Name: "ProhibitOverlap",
@@ -660,6 +767,12 @@ func TestJobDiff(t *testing.T) {
 								Old:  "foo",
 								New:  "foo",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "SuccessfulHistoryLimit",
+								Old:  "0",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeNone,
 								Name: "TimeZone",
@@ -671,6 +784,48 @@ func TestJobDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Periodic crons edited
+			Old: &Job{
+				Periodic: &PeriodicConfig{
+					Specs: []string{"0 2 * * *", "0 6 * * *"},
+				},
+			},
+			New: &Job{
+				Periodic: &PeriodicConfig{
+					Specs: []string{"0 2 * * *", "0 8 * * *"},
+				},
+			},
+			Expected: &JobDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Periodic",
+						Objects: []*ObjectDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "Specs",
+								Fields: []*FieldDiff{
+									{
+										Type: DiffTypeAdded,
+										Name: "Specs",
+										Old:  "",
+										New:  "0 8 * * *",
+									},
+									{
+										Type: DiffTypeDeleted,
+										Name: "Specs",
+										Old:  "0 6 * * *",
+										New:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			// Constraints edited
 			Old: &Job{
@@ -902,6 +1057,12 @@ func TestJobDiff(t *testing.T) {
 								Old:  "",
 								New:  "1",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "Gang",
+								Old:  "",
+								New:  "false",
+							},
 						},
 					},
 					{
@@ -930,6 +1091,12 @@ func TestJobDiff(t *testing.T) {
 								Old:  "1",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "Gang",
+								Old:  "false",
+								New:  "",
+							},
 						},
 					},
 				},
@@ -1782,6 +1949,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "",
 								New:  "1000000000",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "MaxDelay",
+								Old:  "",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "Mode",
@@ -1829,6 +2002,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "1000000000",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "MaxDelay",
+								Old:  "0",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "Mode",
@@ -1932,12 +2111,24 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "1000000000",
 								New:  "1000000000",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "DelayFunction",
+								Old:  "",
+								New:  "",
+							},
 							{
 								Type: DiffTypeEdited,
 								Name: "Interval",
 								Old:  "1000000000",
 								New:  "2000000000",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "MaxDelay",
+								Old:  "0",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeNone,
 								Name: "Mode",
@@ -2231,6 +2422,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "0",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "CanaryPercent",
+								Old:  "0",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "HealthyDeadline",
@@ -2243,6 +2440,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "0",
 								New:  "",
 							},
+							{
+								Type: DiffTypeDeleted,
+								Name: "MaxSurge",
+								Old:  "0",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "MinHealthyTime",
@@ -2293,6 +2496,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "",
 								New:  "0",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "CanaryPercent",
+								Old:  "",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "HealthyDeadline",
@@ -2305,6 +2514,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "",
 								New:  "0",
 							},
+							{
+								Type: DiffTypeAdded,
+								Name: "MaxSurge",
+								Old:  "",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "MinHealthyTime",
@@ -2460,6 +2675,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "2",
 								New:  "2",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "CanaryPercent",
+								Old:  "0",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeNone,
 								Name: "HealthCheck",
@@ -2478,6 +2699,12 @@ func TestTaskGroupDiff(t *testing.T) {
 								Old:  "5",
 								New:  "7",
 							},
+							{
+								Type: DiffTypeNone,
+								Name: "MaxSurge",
+								Old:  "0",
+								New:  "0",
+							},
 							{
 								Type: DiffTypeNone,
 								Name: "MinHealthyTime",
@@ -4514,6 +4741,46 @@ func TestTaskDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Prestop edited",
+			Old: &Task{
+				Prestop: &TaskPrestop{
+					Command: "nginx",
+					Args:    []string{"-s", "quit"},
+					Timeout: 10 * time.Second,
+				},
+			},
+			New: &Task{
+				Prestop: &TaskPrestop{
+					Command: "/usr/sbin/nginx",
+					Args:    []string{"-s", "quit"},
+					Timeout: 20 * time.Second,
+				},
+			},
+			Expected: &TaskDiff{
+				Type: DiffTypeEdited,
+				Objects: []*ObjectDiff{
+					{
+						Type: DiffTypeEdited,
+						Name: "Prestop",
+						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "Command",
+								Old:  "nginx",
+								New:  "/usr/sbin/nginx",
+							},
+							{
+								Type: DiffTypeEdited,
+								Name: "Timeout",
+								Old:  "10000000000",
+								New:  "20000000000",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:       "LogConfig edited with context",
 			Contextual: true,
@@ -5157,6 +5424,12 @@ func TestTaskDiff(t *testing.T) {
 										Old:  "",
 										New:  "bam",
 									},
+									{
+										Type: DiffTypeAdded,
+										Name: "Share",
+										Old:  "",
+										New:  "0",
+									},
 								},
 							},
 							{
@@ -5175,6 +5448,12 @@ func TestTaskDiff(t *testing.T) {
 										Old:  "baz",
 										New:  "",
 									},
+									{
+										Type: DiffTypeDeleted,
+										Name: "Share",
+										Old:  "0",
+										New:  "",
+									},
 								},
 							},
 						},
@@ -5288,6 +5567,12 @@ func TestTaskDiff(t *testing.T) {
 										Old:  "bar",
 										New:  "bar",
 									},
+									{
+										Type: DiffTypeNone,
+										Name: "Share",
+										Old:  "0",
+										New:  "0",
+									},
 								},
 							},
 							{
@@ -5306,6 +5591,12 @@ func TestTaskDiff(t *testing.T) {
 										Old:  "",
 										New:  "bam",
 									},
+									{
+										Type: DiffTypeAdded,
+										Name: "Share",
+										Old:  "",
+										New:  "0",
+									},
 								},
 							},
 							{
@@ -5324,6 +5615,12 @@ func TestTaskDiff(t *testing.T) {
 										Old:  "baz",
 										New:  "",
 									},
+									{
+										Type: DiffTypeDeleted,
+										Name: "Share",
+										Old:  "0",
+										New:  "",
+									},
 								},
 							},
 						},
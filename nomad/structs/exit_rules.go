@@ -0,0 +1,189 @@
+package structs
+
+import (
+	"fmt"
+	"strconv"
+	"syscall"
+
+	"github.com/hashicorp/consul-template/signals"
+	multierror "github.com/hashicorp/go-multierror"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// ExitActionRetry restarts or reschedules the task according to the
+	// policy, even if it exited successfully.
+	ExitActionRetry = "retry"
+
+	// ExitActionFail fails the task without any further restarts or
+	// reschedules.
+	ExitActionFail = "fail"
+
+	// ExitActionSuccess treats the exit of the task as successful.
+	ExitActionSuccess = "success"
+)
+
+// ExitRule classifies the exits of a task by their exit code or the signal
+// that terminated the task.
+type ExitRule struct {
+	// ExitCodes are the exit codes the rule applies to.
+	ExitCodes []int
+
+	// Signals are the names of the signals the rule applies to.
+	Signals []string
+
+	// Action is how exits that match the rule are handled.
+	Action string
+}
+
+func (e *ExitRule) Copy() *ExitRule {
+	if e == nil {
+		return nil
+	}
+	ne := new(ExitRule)
+	*ne = *e
+	ne.ExitCodes = slices.Clone(e.ExitCodes)
+	ne.Signals = slices.Clone(e.Signals)
+	return ne
+}
+
+func (e *ExitRule) Validate() error {
+	var mErr multierror.Error
+	switch e.Action {
+	case ExitActionRetry, ExitActionFail, ExitActionSuccess:
+	default:
+		_ = multierror.Append(&mErr, fmt.Errorf("Unsupported exit action: %q", e.Action))
+	}
+
+	if len(e.ExitCodes) == 0 && len(e.Signals) == 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Exit rule must specify exit codes or signals"))
+	}
+
+	for _, s := range e.Signals {
+		if _, err := signals.Parse(s); err != nil {
+			_ = multierror.Append(&mErr, err)
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// Matches returns whether the rule applies to an exit with the given exit
+// code and signal. A signal of zero means the task was not terminated by a
+// signal.
+func (e *ExitRule) Matches(exitCode, signal int) bool {
+	if signal != 0 {
+		for _, s := range e.Signals {
+			sig, err := signals.Parse(s)
+			if err != nil {
+				continue
+			}
+			if sysSig, ok := sig.(syscall.Signal); ok && int(sysSig) == signal {
+				return true
+			}
+		}
+	}
+	return slices.Contains(e.ExitCodes, exitCode)
+}
+
+// Diff returns a diff of two exit rules. If contextual diff is enabled,
+// unchanged fields will still be returned.
+func (e *ExitRule) Diff(other *ExitRule, contextual bool) *ObjectDiff {
+	diff := primitiveObjectDiff(e, other, nil, "OnExit", contextual)
+	if diff == nil {
+		diff = &ObjectDiff{Type: DiffTypeNone, Name: "OnExit"}
+	}
+
+	var oldCodes, newCodes, oldSignals, newSignals []string
+	if e != nil {
+		oldCodes = exitCodeStrings(e.ExitCodes)
+		oldSignals = e.Signals
+	}
+	if other != nil {
+		newCodes = exitCodeStrings(other.ExitCodes)
+		newSignals = other.Signals
+	}
+	if codesDiff := stringSetDiff(oldCodes, newCodes, "ExitCodes", contextual); codesDiff != nil {
+		diff.Objects = append(diff.Objects, codesDiff)
+	}
+	if signalsDiff := stringSetDiff(oldSignals, newSignals, "Signals", contextual); signalsDiff != nil {
+		diff.Objects = append(diff.Objects, signalsDiff)
+	}
+
+	if diff.Type == DiffTypeNone {
+		if len(diff.Objects) == 0 {
+			return nil
+		}
+		switch {
+		case e == nil:
+			diff.Type = DiffTypeAdded
+		case other == nil:
+			diff.Type = DiffTypeDeleted
+		default:
+			diff.Type = DiffTypeEdited
+		}
+	}
+	return diff
+}
+
+func exitCodeStrings(codes []int) []string {
+	strs := make([]string, len(codes))
+	for i, c := range codes {
+		strs[i] = strconv.Itoa(c)
+	}
+	return strs
+}
+
+// ExitRules are an ordered list of rules classifying the exits of a task. The
+// first matching rule applies.
+type ExitRules []*ExitRule
+
+func (r ExitRules) Copy() ExitRules {
+	if r == nil {
+		return nil
+	}
+	nr := make(ExitRules, len(r))
+	for i, e := range r {
+		nr[i] = e.Copy()
+	}
+	return nr
+}
+
+func (r ExitRules) Validate() error {
+	var mErr multierror.Error
+	for i, e := range r {
+		if err := e.Validate(); err != nil {
+			_ = multierror.Append(&mErr, multierror.Prefix(err, fmt.Sprintf("on_exit %d:", i+1)))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// Action returns the action of the first rule matching an exit with the
+// given exit code and signal, or an empty string if no rule matches.
+func (r ExitRules) Action(exitCode, signal int) string {
+	for _, e := range r {
+		if e.Matches(exitCode, signal) {
+			return e.Action
+		}
+	}
+	return ""
+}
+
+// exitRulesDiff diffs two sets of exit rules by their position. If
+// contextual diff is enabled, unchanged fields will still be returned.
+func exitRulesDiff(old, new ExitRules, contextual bool) []*ObjectDiff {
+	var diffs []*ObjectDiff
+	for i := 0; i < len(old) || i < len(new); i++ {
+		var o, n *ExitRule
+		if i < len(old) {
+			o = old[i]
+		}
+		if i < len(new) {
+			n = new[i]
+		}
+		if diff := o.Diff(n, contextual); diff != nil {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,141 @@
+package structs
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestExitRules_Action(t *testing.T) {
+	ci.Parallel(t)
+
+	rules := ExitRules{
+		{ExitCodes: []int{2, 3}, Action: ExitActionFail},
+		{Signals: []string{"sigterm"}, Action: ExitActionSuccess},
+		{ExitCodes: []int{2}, Action: ExitActionRetry},
+	}
+
+	must.Eq(t, ExitActionFail, rules.Action(2, 0))
+	must.Eq(t, ExitActionFail, rules.Action(3, 0))
+	must.Eq(t, ExitActionSuccess, rules.Action(-1, int(syscall.SIGTERM)))
+	must.Eq(t, "", rules.Action(1, 0))
+	must.Eq(t, "", ExitRules(nil).Action(1, 0))
+}
+
+func TestExitRules_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	must.NoError(t, ExitRules{
+		{ExitCodes: []int{2}, Action: ExitActionFail},
+		{Signals: []string{"SIGKILL"}, Action: ExitActionRetry},
+	}.Validate())
+
+	err := ExitRules{
+		{ExitCodes: []int{2}, Action: "ignore"},
+		{Action: ExitActionFail},
+		{Signals: []string{"SIGNOPE"}, Action: ExitActionSuccess},
+	}.Validate()
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), `Unsupported exit action: "ignore"`)
+	must.StrContains(t, err.Error(), "must specify exit codes or signals")
+	must.StrContains(t, err.Error(), `invalid signal "SIGNOPE"`)
+}
+
+func TestAllocation_ShouldReschedule_OnExit(t *testing.T) {
+	ci.Parallel(t)
+
+	now := time.Now()
+	policy := &ReschedulePolicy{
+		Attempts: 2,
+		Interval: 10 * time.Minute,
+		OnExit: ExitRules{
+			{ExitCodes: []int{2}, Action: ExitActionFail},
+		},
+	}
+
+	allocWithExit := func(exitCode int) *Allocation {
+		return &Allocation{
+			ClientStatus: AllocClientStatusFailed,
+			TaskStates: map[string]*TaskState{
+				"web": {
+					State:  TaskStateDead,
+					Failed: true,
+					Events: []*TaskEvent{
+						NewTaskEvent(TaskTerminated).SetExitCode(exitCode),
+						NewTaskEvent(TaskNotRestarting).SetFailsTask(),
+					},
+				},
+			},
+		}
+	}
+
+	must.True(t, allocWithExit(1).ShouldReschedule(policy, now))
+	must.False(t, allocWithExit(2).ShouldReschedule(policy, now))
+
+	// Allocations without a task exit are rescheduled
+	must.True(t, (&Allocation{ClientStatus: AllocClientStatusFailed}).ShouldReschedule(policy, now))
+}
+
+func TestExitRules_Diff(t *testing.T) {
+	ci.Parallel(t)
+
+	old := &TaskGroup{
+		RestartPolicy: &RestartPolicy{
+			Attempts: 1,
+			OnExit: ExitRules{
+				{ExitCodes: []int{2}, Action: ExitActionFail},
+			},
+		},
+	}
+	new := &TaskGroup{
+		RestartPolicy: &RestartPolicy{
+			Attempts: 1,
+			OnExit: ExitRules{
+				{ExitCodes: []int{2, 3}, Action: ExitActionFail},
+				{Signals: []string{"SIGTERM"}, Action: ExitActionSuccess},
+			},
+		},
+	}
+
+	diff, err := old.Diff(new, false)
+	must.NoError(t, err)
+	must.Len(t, 1, diff.Objects)
+
+	rDiff := diff.Objects[0]
+	must.Eq(t, "RestartPolicy", rDiff.Name)
+	must.Eq(t, DiffTypeEdited, rDiff.Type)
+	must.Eq(t, []*ObjectDiff{
+		{
+			Type: DiffTypeEdited,
+			Name: "OnExit",
+			Objects: []*ObjectDiff{
+				{
+					Type: DiffTypeAdded,
+					Name: "ExitCodes",
+					Fields: []*FieldDiff{
+						{Type: DiffTypeAdded, Name: "ExitCodes", New: "3"},
+					},
+				},
+			},
+		},
+		{
+			Type: DiffTypeAdded,
+			Name: "OnExit",
+			Fields: []*FieldDiff{
+				{Type: DiffTypeAdded, Name: "Action", New: ExitActionSuccess},
+			},
+			Objects: []*ObjectDiff{
+				{
+					Type: DiffTypeAdded,
+					Name: "Signals",
+					Fields: []*FieldDiff{
+						{Type: DiffTypeAdded, Name: "Signals", New: "SIGTERM"},
+					},
+				},
+			},
+		},
+	}, rDiff.Objects)
+}
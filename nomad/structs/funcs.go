@@ -325,6 +325,19 @@ func CopySliceAffinities(s []*Affinity) []*Affinity {
 	return c
 }
 
+func CopySliceAllocAffinities(s []*AllocAffinity) []*AllocAffinity {
+	l := len(s)
+	if l == 0 {
+		return nil
+	}
+
+	c := make([]*AllocAffinity, l)
+	for i, v := range s {
+		c[i] = v.Copy()
+	}
+	return c
+}
+
 func CopySliceSpreads(s []*Spread) []*Spread {
 	l := len(s)
 	if l == 0 {
@@ -741,6 +741,52 @@ func TestAllocsFit_Devices(t *testing.T) {
 	require.True(fit)
 }
 
+func TestAllocsFit_Custom(t *testing.T) {
+	ci.Parallel(t)
+
+	require := require.New(t)
+
+	n := MockNode()
+	n.NodeResources.Custom = CustomResources{"license_seat": 2}
+	a1 := &Allocation{
+		AllocatedResources: &AllocatedResources{
+			Tasks: map[string]*AllocatedTaskResources{
+				"web": {
+					Cpu: AllocatedCpuResources{
+						CpuShares: 1000,
+					},
+					Memory: AllocatedMemoryResources{
+						MemoryMB: 1024,
+					},
+					Custom: CustomResources{"license_seat": 1},
+				},
+			},
+		},
+	}
+	a2 := a1.Copy()
+	a2.AllocatedResources.Tasks["web"].Custom["license_seat"] = 2
+
+	// Should fit one allocation
+	fit, _, used, err := AllocsFit(n, []*Allocation{a1}, nil, false)
+	require.NoError(err)
+	require.True(fit)
+	require.Equal(CustomResources{"license_seat": 1}, used.Flattened.Custom)
+
+	// Should not fit second allocation
+	fit, msg, used, err := AllocsFit(n, []*Allocation{a1, a2}, nil, false)
+	require.NoError(err)
+	require.False(fit)
+	require.Equal("custom resource license_seat", msg)
+	require.Equal(CustomResources{"license_seat": 3}, used.Flattened.Custom)
+
+	// Should not fit on a node without the resource
+	n.NodeResources.Custom = nil
+	fit, msg, _, err = AllocsFit(n, []*Allocation{a1}, nil, false)
+	require.NoError(err)
+	require.False(fit)
+	require.Equal("custom resource license_seat", msg)
+}
+
 // TestAllocsFit_MemoryOversubscription asserts that only reserved memory is
 // used for capacity
 func TestAllocsFit_MemoryOversubscription(t *testing.T) {
@@ -0,0 +1,152 @@
+package structs
+
+import (
+	"errors"
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const (
+	// GroupDependencyHealthy waits for every allocation of the group to be
+	// running and, when placed by a deployment, healthy.
+	GroupDependencyHealthy = "healthy"
+
+	// GroupDependencyComplete waits for every allocation of the group to
+	// complete successfully.
+	GroupDependencyComplete = "complete"
+)
+
+// GroupDependency defers the placement of a task group until another task
+// group of the same job reaches the required condition.
+type GroupDependency struct {
+	// Group is the name of the task group depended on.
+	Group string
+
+	// Condition is the state the group must reach, either healthy or
+	// complete.
+	Condition string
+}
+
+// Copy returns a copy of the group dependency.
+func (d *GroupDependency) Copy() *GroupDependency {
+	if d == nil {
+		return nil
+	}
+	nd := new(GroupDependency)
+	*nd = *d
+	return nd
+}
+
+// Equal checks if two group dependencies are equal.
+func (d *GroupDependency) Equal(o *GroupDependency) bool {
+	if d == nil || o == nil {
+		return d == o
+	}
+	return d.Group == o.Group && d.Condition == o.Condition
+}
+
+func (d *GroupDependency) String() string {
+	return fmt.Sprintf("%s (%s)", d.Group, d.Condition)
+}
+
+// Validate returns an error if the group dependency is invalid for a job of
+// the given type.
+func (d *GroupDependency) Validate(jobType string) error {
+	var mErr multierror.Error
+
+	if d.Group == "" {
+		mErr.Errors = append(mErr.Errors, errors.New("Missing dependency group"))
+	}
+	switch d.Condition {
+	case GroupDependencyHealthy:
+	case GroupDependencyComplete:
+		// Only batch allocations stay complete, service allocations are
+		// replaced when they exit.
+		if jobType != JobTypeBatch {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("Dependency condition %q is only supported for batch jobs", d.Condition))
+		}
+	default:
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("Invalid dependency condition %q", d.Condition))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+func CopySliceGroupDependencies(s []*GroupDependency) []*GroupDependency {
+	if s == nil {
+		return nil
+	}
+	c := make([]*GroupDependency, len(s))
+	for i, d := range s {
+		c[i] = d.Copy()
+	}
+	return c
+}
+
+// validateGroupDependencies checks that the task groups of the job only
+// depend on other existing groups of the job and that there are no cycles.
+func (j *Job) validateGroupDependencies() error {
+	var mErr multierror.Error
+
+	deps := make(map[string][]string)
+	for _, tg := range j.TaskGroups {
+		for _, d := range tg.DependsOn {
+			if d.Group == tg.Name {
+				mErr.Errors = append(mErr.Errors, fmt.Errorf("Task group %s cannot depend on itself", tg.Name))
+				continue
+			}
+			if j.LookupTaskGroup(d.Group) == nil {
+				mErr.Errors = append(mErr.Errors, fmt.Errorf("Task group %s depends on unknown task group %q", tg.Name, d.Group))
+				continue
+			}
+			deps[tg.Name] = append(deps[tg.Name], d.Group)
+		}
+	}
+
+	// Walk the dependencies of every group, a group seen again while its
+	// own dependencies are still being walked closes a cycle.
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int)
+	var visit func(group string) bool
+	visit = func(group string) bool {
+		switch state[group] {
+		case visiting:
+			return false
+		case visited:
+			return true
+		}
+		state[group] = visiting
+		for _, dep := range deps[group] {
+			if !visit(dep) {
+				return false
+			}
+		}
+		state[group] = visited
+		return true
+	}
+	for _, tg := range j.TaskGroups {
+		if !visit(tg.Name) {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("Task group %s has a dependency cycle", tg.Name))
+			break
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// HasGroupDependency returns whether a task group of the job waits for the
+// given group to reach the condition.
+func (j *Job) HasGroupDependency(group, condition string) bool {
+	for _, tg := range j.TaskGroups {
+		for _, d := range tg.DependsOn {
+			if d.Group == group && d.Condition == condition {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package structs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+// testDependencyJob returns a job with the web group and copies of it named
+// after groups.
+func testDependencyJob(groups ...string) *Job {
+	j := testJob()
+	for _, name := range groups {
+		tg := j.TaskGroups[0].Copy()
+		tg.Name = name
+		j.TaskGroups = append(j.TaskGroups, tg)
+	}
+	return j
+}
+
+func TestGroupDependency_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	d := &GroupDependency{Group: "migrate", Condition: GroupDependencyHealthy}
+	must.NoError(t, d.Validate(JobTypeService))
+
+	d = &GroupDependency{Group: "migrate", Condition: GroupDependencyComplete}
+	must.NoError(t, d.Validate(JobTypeBatch))
+
+	err := d.Validate(JobTypeService)
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), `"complete" is only supported for batch jobs`)
+
+	err = (&GroupDependency{Condition: "started"}).Validate(JobTypeBatch)
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), "Missing dependency group")
+	must.StrContains(t, err.Error(), `Invalid dependency condition "started"`)
+}
+
+func TestJob_Validate_GroupDependencies(t *testing.T) {
+	ci.Parallel(t)
+
+	// A chain of dependencies is valid
+	j := testDependencyJob("api", "migrate")
+	j.TaskGroups[0].DependsOn = []*GroupDependency{{Group: "api", Condition: GroupDependencyHealthy}}
+	j.TaskGroups[1].DependsOn = []*GroupDependency{{Group: "migrate", Condition: GroupDependencyHealthy}}
+	must.NoError(t, j.Validate())
+
+	// Closing the chain is a cycle
+	j.TaskGroups[2].DependsOn = []*GroupDependency{{Group: "web", Condition: GroupDependencyHealthy}}
+	err := j.Validate()
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), "has a dependency cycle")
+
+	// Groups can only depend on other existing groups
+	j = testDependencyJob()
+	j.TaskGroups[0].DependsOn = []*GroupDependency{
+		{Group: "web", Condition: GroupDependencyHealthy},
+		{Group: "db", Condition: GroupDependencyHealthy},
+	}
+	err = j.Validate()
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), "Task group web cannot depend on itself")
+	must.StrContains(t, err.Error(), `Task group web depends on unknown task group "db"`)
+
+	// System jobs place every group on every node
+	j = testDependencyJob("migrate")
+	j.Type = JobTypeSystem
+	for _, tg := range j.TaskGroups {
+		tg.Count = 1
+		tg.ReschedulePolicy = nil
+	}
+	j.TaskGroups[0].DependsOn = []*GroupDependency{{Group: "migrate", Condition: GroupDependencyHealthy}}
+	err = j.Validate()
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), "system jobs may not have a depends_on stanza")
+}
+
+func TestJob_HasGroupDependency(t *testing.T) {
+	ci.Parallel(t)
+
+	j := testDependencyJob("migrate")
+	j.TaskGroups[0].DependsOn = []*GroupDependency{{Group: "migrate", Condition: GroupDependencyComplete}}
+
+	must.True(t, j.HasGroupDependency("migrate", GroupDependencyComplete))
+	must.False(t, j.HasGroupDependency("migrate", GroupDependencyHealthy))
+	must.False(t, j.HasGroupDependency("web", GroupDependencyComplete))
+}
+
+func TestTaskGroupDiff_DependsOn(t *testing.T) {
+	ci.Parallel(t)
+
+	old := &TaskGroup{
+		DependsOn: []*GroupDependency{{Group: "migrate", Condition: GroupDependencyHealthy}},
+	}
+	new := &TaskGroup{
+		DependsOn: []*GroupDependency{{Group: "migrate", Condition: GroupDependencyComplete}},
+	}
+
+	diff, err := old.Diff(new, false)
+	must.NoError(t, err)
+	must.Eq(t, DiffTypeEdited, diff.Type)
+	must.Len(t, 2, diff.Objects)
+	for _, o := range diff.Objects {
+		must.Eq(t, "DependsOn", o.Name)
+	}
+}
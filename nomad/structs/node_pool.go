@@ -0,0 +1,241 @@
+package structs
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// NodePoolAll is a built-in node pool that always includes all nodes in
+	// the cluster. Jobs that use this pool are not restricted to any subset
+	// of nodes.
+	NodePoolAll            = "all"
+	nodePoolAllDescription = "Node pool with all nodes in the cluster."
+
+	// NodePoolDefault is a built-in node pool for nodes that don't specify a
+	// node pool in their configuration.
+	NodePoolDefault            = "default"
+	nodePoolDefaultDescription = "Default node pool."
+
+	// maxNodePoolDescriptionLength is the maximum length allowed for a node
+	// pool description.
+	maxNodePoolDescriptionLength = 256
+)
+
+var (
+	// validNodePoolName is the rule used to validate a node pool name.
+	validNodePoolName = regexp.MustCompile("^[a-zA-Z0-9_-]{1,128}$")
+)
+
+// ValidNodePoolName returns true if the given name is a valid node pool name.
+func ValidNodePoolName(name string) bool {
+	return validNodePoolName.MatchString(name)
+}
+
+// NodePool allows partitioning infrastructure, so that jobs can be restricted
+// to a subset of the clients in the cluster. There is no pool-scoped ACL
+// capability: reading node pools requires node read access, writing them a
+// management token, and the pools a job may use are restricted through the
+// node pool configuration of its namespace.
+type NodePool struct {
+	// Name is the node pool name. It must be unique.
+	Name string
+
+	// Description is the human-friendly description of the node pool.
+	Description string
+
+	// Meta is a set of user-provided metadata for the node pool.
+	Meta map[string]string
+
+	// Raft indexes.
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// BuiltInNodePools returns the set of node pools that are always present in
+// the cluster and that cannot be modified or deleted.
+func BuiltInNodePools() []*NodePool {
+	return []*NodePool{
+		{
+			Name:        NodePoolAll,
+			Description: nodePoolAllDescription,
+		},
+		{
+			Name:        NodePoolDefault,
+			Description: nodePoolDefaultDescription,
+		},
+	}
+}
+
+// GetID is a helper for getting the name when the object may be nil and is
+// required for pagination.
+func (n *NodePool) GetID() string {
+	if n == nil {
+		return ""
+	}
+	return n.Name
+}
+
+// Validate returns an error if the node pool is invalid.
+func (n *NodePool) Validate() error {
+	var mErr *multierror.Error
+
+	if !validNodePoolName.MatchString(n.Name) {
+		mErr = multierror.Append(mErr, fmt.Errorf("invalid name %q, must match regex %s", n.Name, validNodePoolName))
+	}
+	if len(n.Description) > maxNodePoolDescriptionLength {
+		mErr = multierror.Append(mErr, fmt.Errorf("description longer than %d", maxNodePoolDescriptionLength))
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// Copy returns a deep copy of the node pool.
+func (n *NodePool) Copy() *NodePool {
+	if n == nil {
+		return nil
+	}
+
+	nc := new(NodePool)
+	*nc = *n
+	nc.Meta = maps.Clone(n.Meta)
+	return nc
+}
+
+// IsBuiltIn returns true if the node pool is one of the built-in pools.
+// Built-in node pools are created automatically by Nomad and can never be
+// modified or deleted.
+func (n *NodePool) IsBuiltIn() bool {
+	switch n.Name {
+	case NodePoolAll, NodePoolDefault:
+		return true
+	default:
+		return false
+	}
+}
+
+// Equal returns true if the user-provided fields of both node pools match.
+func (n *NodePool) Equal(o *NodePool) bool {
+	if n == nil || o == nil {
+		return n == o
+	}
+	return n.Name == o.Name &&
+		n.Description == o.Description &&
+		maps.Equal(n.Meta, o.Meta)
+}
+
+// NodePoolMatches returns true if a node registered in nodePool can run
+// workloads that target jobPool. An empty job pool, as used by jobs that were
+// registered before node pools existed, and the built-in "all" pool match
+// every node. Nodes without a pool belong to the "default" pool.
+func NodePoolMatches(jobPool, nodePool string) bool {
+	if jobPool == "" || jobPool == NodePoolAll {
+		return true
+	}
+	if nodePool == "" {
+		nodePool = NodePoolDefault
+	}
+	return jobPool == nodePool
+}
+
+// NamespaceNodePoolConfiguration stores the node pool rules for a namespace.
+type NamespaceNodePoolConfiguration struct {
+	// Default is the node pool used by jobs in this namespace that don't
+	// specify a node pool of their own.
+	Default string
+
+	// Allowed specifies the node pools that jobs in this namespace are allowed
+	// to use. An empty list allows all node pools. The default node pool of
+	// the namespace is always allowed.
+	Allowed []string
+}
+
+// Copy returns a deep copy of the namespace node pool configuration.
+func (c *NamespaceNodePoolConfiguration) Copy() *NamespaceNodePoolConfiguration {
+	if c == nil {
+		return nil
+	}
+
+	nc := new(NamespaceNodePoolConfiguration)
+	*nc = *c
+	nc.Allowed = slices.Clone(c.Allowed)
+	return nc
+}
+
+// Validate returns an error if the namespace node pool configuration is
+// invalid.
+func (c *NamespaceNodePoolConfiguration) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	var mErr *multierror.Error
+	if c.Default != "" && !validNodePoolName.MatchString(c.Default) {
+		mErr = multierror.Append(mErr, fmt.Errorf("invalid default node pool %q", c.Default))
+	}
+	for _, pool := range c.Allowed {
+		if !validNodePoolName.MatchString(pool) {
+			mErr = multierror.Append(mErr, fmt.Errorf("invalid allowed node pool %q", pool))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// DefaultPool returns the node pool to use for jobs that don't set one.
+func (c *NamespaceNodePoolConfiguration) DefaultPool() string {
+	if c == nil || c.Default == "" {
+		return NodePoolDefault
+	}
+	return c.Default
+}
+
+// IsAllowed returns true if jobs in the namespace are allowed to use the
+// given node pool.
+func (c *NamespaceNodePoolConfiguration) IsAllowed(pool string) bool {
+	if c == nil || len(c.Allowed) == 0 {
+		return true
+	}
+	if pool == c.DefaultPool() {
+		return true
+	}
+	return slices.Contains(c.Allowed, pool)
+}
+
+// NodePoolUpsertRequest is used to upsert a set of node pools.
+type NodePoolUpsertRequest struct {
+	NodePools []*NodePool
+	WriteRequest
+}
+
+// NodePoolDeleteRequest is used to delete a set of node pools.
+type NodePoolDeleteRequest struct {
+	Names []string
+	WriteRequest
+}
+
+// NodePoolListRequest is used to list node pools.
+type NodePoolListRequest struct {
+	QueryOptions
+}
+
+// NodePoolListResponse is the response for a node pool list request.
+type NodePoolListResponse struct {
+	NodePools []*NodePool
+	QueryMeta
+}
+
+// NodePoolSpecificRequest is used to make a request for a single node pool.
+type NodePoolSpecificRequest struct {
+	Name string
+	QueryOptions
+}
+
+// SingleNodePoolResponse is the response for a single node pool request.
+type SingleNodePoolResponse struct {
+	NodePool *NodePool
+	QueryMeta
+}
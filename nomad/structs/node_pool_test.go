@@ -0,0 +1,139 @@
+package structs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestNodePool_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name        string
+		pool        *NodePool
+		expectedErr string
+	}{
+		{
+			name: "valid pool",
+			pool: &NodePool{
+				Name:        "valid",
+				Description: "just a valid pool",
+			},
+		},
+		{
+			name: "invalid name",
+			pool: &NodePool{
+				Name: "not-valid-😢",
+			},
+			expectedErr: "invalid name",
+		},
+		{
+			name: "invalid name too long",
+			pool: &NodePool{
+				Name: strings.Repeat("a", 129),
+			},
+			expectedErr: "invalid name",
+		},
+		{
+			name: "description too long",
+			pool: &NodePool{
+				Name:        "valid",
+				Description: strings.Repeat("a", 300),
+			},
+			expectedErr: "description longer than",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pool.Validate()
+			if tc.expectedErr != "" {
+				must.Error(t, err)
+				must.StrContains(t, err.Error(), tc.expectedErr)
+			} else {
+				must.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNodePool_Copy(t *testing.T) {
+	ci.Parallel(t)
+
+	pool := &NodePool{
+		Name:        "original",
+		Description: "original node pool",
+		Meta:        map[string]string{"original": "true"},
+	}
+	poolCopy := pool.Copy()
+	must.True(t, pool.Equal(poolCopy))
+
+	poolCopy.Name = "copy"
+	poolCopy.Description = "copy of original pool"
+	poolCopy.Meta["original"] = "false"
+	poolCopy.Meta["new_key"] = "true"
+
+	must.Eq(t, "original", pool.Name)
+	must.Eq(t, "original node pool", pool.Description)
+	must.Eq(t, map[string]string{"original": "true"}, pool.Meta)
+}
+
+func TestNodePool_IsBuiltIn(t *testing.T) {
+	ci.Parallel(t)
+
+	must.True(t, (&NodePool{Name: NodePoolAll}).IsBuiltIn())
+	must.True(t, (&NodePool{Name: NodePoolDefault}).IsBuiltIn())
+	must.False(t, (&NodePool{Name: "dev"}).IsBuiltIn())
+}
+
+func TestNodePoolMatches(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		jobPool  string
+		nodePool string
+		expected bool
+	}{
+		{jobPool: "", nodePool: "dev", expected: true},
+		{jobPool: NodePoolAll, nodePool: "dev", expected: true},
+		{jobPool: NodePoolDefault, nodePool: "", expected: true},
+		{jobPool: NodePoolDefault, nodePool: NodePoolDefault, expected: true},
+		{jobPool: NodePoolDefault, nodePool: "dev", expected: false},
+		{jobPool: "dev", nodePool: "dev", expected: true},
+		{jobPool: "dev", nodePool: "", expected: false},
+		{jobPool: "dev", nodePool: "prod", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.jobPool+"/"+tc.nodePool, func(t *testing.T) {
+			must.Eq(t, tc.expected, NodePoolMatches(tc.jobPool, tc.nodePool))
+		})
+	}
+}
+
+func TestNamespaceNodePoolConfiguration(t *testing.T) {
+	ci.Parallel(t)
+
+	var nilConfig *NamespaceNodePoolConfiguration
+	must.Eq(t, NodePoolDefault, nilConfig.DefaultPool())
+	must.True(t, nilConfig.IsAllowed("dev"))
+	must.NoError(t, nilConfig.Validate())
+
+	config := &NamespaceNodePoolConfiguration{
+		Default: "dev",
+		Allowed: []string{"prod"},
+	}
+	must.NoError(t, config.Validate())
+	must.Eq(t, "dev", config.DefaultPool())
+	must.True(t, config.IsAllowed("dev"))
+	must.True(t, config.IsAllowed("prod"))
+	must.False(t, config.IsAllowed(NodePoolDefault))
+
+	config.Allowed = append(config.Allowed, "not valid")
+	err := config.Validate()
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), "invalid allowed node pool")
+}
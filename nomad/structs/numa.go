@@ -0,0 +1,123 @@
+package structs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/nomad/lib/cpuset"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// NUMAAffinityNone disables NUMA awareness when reserving cores, so
+	// cores may be picked from any NUMA node of the client.
+	NUMAAffinityNone = "none"
+
+	// NUMAAffinityPrefer makes the scheduler prefer cores and memory from a
+	// single NUMA node, but still allows the reserved cores to span NUMA
+	// nodes if no single NUMA node has enough capacity.
+	NUMAAffinityPrefer = "prefer"
+
+	// NUMAAffinityRequire makes the scheduler only place the task on clients
+	// where all the reserved cores and the task memory fit in a single NUMA
+	// node.
+	NUMAAffinityRequire = "require"
+)
+
+// NUMA is used to configure the NUMA placement of the cores reserved for a
+// task.
+type NUMA struct {
+	// Affinity is one of "none", "prefer" or "require".
+	Affinity string
+}
+
+// Copy returns a deep copy of the NUMA block.
+func (n *NUMA) Copy() *NUMA {
+	if n == nil {
+		return nil
+	}
+	nn := *n
+	return &nn
+}
+
+// Equal returns true if both NUMA blocks are equal.
+func (n *NUMA) Equal(o *NUMA) bool {
+	if n == nil || o == nil {
+		return n == o
+	}
+	return n.Affinity == o.Affinity
+}
+
+// Canonicalize sets the default NUMA affinity.
+func (n *NUMA) Canonicalize() {
+	if n == nil {
+		return
+	}
+	if n.Affinity == "" {
+		n.Affinity = NUMAAffinityPrefer
+	}
+}
+
+// Validate returns an error if the NUMA block is invalid.
+func (n *NUMA) Validate() error {
+	if n == nil {
+		return nil
+	}
+	switch n.Affinity {
+	case NUMAAffinityNone, NUMAAffinityPrefer, NUMAAffinityRequire:
+		return nil
+	default:
+		return fmt.Errorf("invalid NUMA affinity %q; must be one of %q, %q or %q",
+			n.Affinity, NUMAAffinityNone, NUMAAffinityPrefer, NUMAAffinityRequire)
+	}
+}
+
+// GetAffinity returns the NUMA affinity, defaulting to "prefer" when the
+// NUMA block is not set.
+func (n *NUMA) GetAffinity() string {
+	if n == nil || n.Affinity == "" {
+		return NUMAAffinityPrefer
+	}
+	return n.Affinity
+}
+
+// NodeNUMANode describes a NUMA node of a client, with the cores and memory
+// that are local to it.
+type NodeNUMANode struct {
+	// ID is the NUMA node identifier as reported by the operating system.
+	ID uint8
+
+	// Socket is the physical CPU package the NUMA node belongs to.
+	Socket uint8
+
+	// Cores is the set of core IDs that are local to the NUMA node.
+	Cores []uint16
+
+	// MemoryMB is the amount of memory local to the NUMA node.
+	MemoryMB int64
+}
+
+// Copy returns a deep copy of the NUMA node.
+func (n *NodeNUMANode) Copy() *NodeNUMANode {
+	if n == nil {
+		return nil
+	}
+	nn := *n
+	nn.Cores = slices.Clone(n.Cores)
+	return &nn
+}
+
+// Equal returns true if both NUMA nodes are equal.
+func (n *NodeNUMANode) Equal(o *NodeNUMANode) bool {
+	if n == nil || o == nil {
+		return n == o
+	}
+	return n.ID == o.ID &&
+		n.Socket == o.Socket &&
+		n.MemoryMB == o.MemoryMB &&
+		slices.Equal(n.Cores, o.Cores)
+}
+
+// CPUSet returns the cores of the NUMA node as a CPU set.
+func (n *NodeNUMANode) CPUSet() cpuset.CPUSet {
+	return cpuset.New(n.Cores...)
+}
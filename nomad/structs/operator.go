@@ -171,6 +171,10 @@ type SchedulerConfiguration struct {
 	// during leadership transitions.
 	PauseEvalBroker bool `hcl:"pause_eval_broker"`
 
+	// RebalanceConfig controls whether the leader periodically migrates
+	// allocations to reduce cluster fragmentation.
+	RebalanceConfig RebalanceConfig `hcl:"rebalance_config"`
+
 	// CreateIndex/ModifyIndex store the create/modify indexes of this configuration.
 	CreateIndex uint64
 	ModifyIndex uint64
@@ -210,6 +214,10 @@ func (s *SchedulerConfiguration) Validate() error {
 		return fmt.Errorf("invalid scheduler algorithm: %v", s.SchedulerAlgorithm)
 	}
 
+	if s.RebalanceConfig.MaxMigrations < 0 {
+		return fmt.Errorf("rebalance max migrations must not be negative")
+	}
+
 	return nil
 }
 
@@ -246,6 +254,33 @@ type PreemptionConfig struct {
 	ServiceSchedulerEnabled bool `hcl:"service_scheduler_enabled"`
 }
 
+const (
+	// DefaultRebalanceMaxMigrations is the number of allocations a single
+	// rebalance pass may migrate when the configuration doesn't set a limit.
+	DefaultRebalanceMaxMigrations = 10
+)
+
+// RebalanceConfig configures the rebalance core job, which migrates
+// allocations off of lightly used nodes when using the binpack algorithm or
+// off of heavily used nodes when using the spread algorithm.
+type RebalanceConfig struct {
+	// Enabled specifies whether the leader periodically rebalances the
+	// cluster.
+	Enabled bool `hcl:"enabled"`
+
+	// MaxMigrations is the cluster-wide limit of allocations migrated by a
+	// single rebalance pass.
+	MaxMigrations int `hcl:"max_migrations"`
+}
+
+// EffectiveMaxMigrations returns the migration limit of a rebalance pass.
+func (r RebalanceConfig) EffectiveMaxMigrations() int {
+	if r.MaxMigrations == 0 {
+		return DefaultRebalanceMaxMigrations
+	}
+	return r.MaxMigrations
+}
+
 // SchedulerSetConfigRequest is used by the Operator endpoint to update the
 // current Scheduler configuration of the cluster.
 type SchedulerSetConfigRequest struct {
@@ -259,6 +294,50 @@ type SchedulerSetConfigRequest struct {
 	WriteRequest
 }
 
+// SchedulerRebalanceRequest is used by the Operator endpoint to compute and
+// optionally apply the allocation migrations that rebalance the cluster.
+type SchedulerRebalanceRequest struct {
+	// DryRun computes the migrations without applying them.
+	DryRun bool
+
+	// MaxMigrations overrides the limit set in the rebalance configuration
+	// when non-zero.
+	MaxMigrations int
+
+	WriteRequest
+}
+
+// SchedulerRebalanceResponse is the response to a SchedulerRebalanceRequest.
+type SchedulerRebalanceResponse struct {
+	// Migrations are the allocations moved, or that would be moved on a dry
+	// run, to rebalance the cluster.
+	Migrations []*RebalanceMigration
+
+	// EvalIDs are the evaluations created for the jobs of the migrated
+	// allocations.
+	EvalIDs []string
+
+	WriteMeta
+}
+
+// RebalanceMigration describes an allocation migrated to rebalance the
+// cluster.
+type RebalanceMigration struct {
+	AllocID   string
+	AllocName string
+	Namespace string
+	JobID     string
+	TaskGroup string
+
+	// SourceNodeID is the node the allocation is migrated off of.
+	SourceNodeID string
+
+	// TargetNodeID is the node the replacement allocation is placed on. The
+	// scheduler only falls back to another node if the target can no longer
+	// run the replacement when it is placed.
+	TargetNodeID string
+}
+
 // SnapshotSaveRequest is used by the Operator endpoint to get a Raft snapshot
 type SnapshotSaveRequest struct {
 	QueryOptions
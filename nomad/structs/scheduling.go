@@ -0,0 +1,77 @@
+package structs
+
+import (
+	"fmt"
+)
+
+// Scheduling is used to override cluster-wide scheduler behavior for a job,
+// a task group or all the jobs in a namespace.
+type Scheduling struct {
+	// Algorithm is the scheduler algorithm used to rank nodes. An empty value
+	// inherits the algorithm from the enclosing level.
+	Algorithm SchedulerAlgorithm
+}
+
+// Copy returns a copy of the scheduling configuration.
+func (s *Scheduling) Copy() *Scheduling {
+	if s == nil {
+		return nil
+	}
+	ns := *s
+	return &ns
+}
+
+// Equal returns true if both scheduling configurations are the same.
+func (s *Scheduling) Equal(o *Scheduling) bool {
+	if s == nil || o == nil {
+		return s == o
+	}
+	return s.Algorithm == o.Algorithm
+}
+
+// Validate returns an error if the scheduling configuration is invalid.
+func (s *Scheduling) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	switch s.Algorithm {
+	case "", SchedulerAlgorithmBinpack, SchedulerAlgorithmSpread:
+	default:
+		return fmt.Errorf("invalid scheduler algorithm %q, must be one of %q or %q",
+			s.Algorithm, SchedulerAlgorithmBinpack, SchedulerAlgorithmSpread)
+	}
+	return nil
+}
+
+// GetAlgorithm returns the scheduler algorithm, or an empty string if the
+// scheduling configuration is not set.
+func (s *Scheduling) GetAlgorithm() SchedulerAlgorithm {
+	if s == nil {
+		return ""
+	}
+	return s.Algorithm
+}
+
+// EffectiveSchedulerAlgorithm returns the scheduler algorithm to use when
+// placing the task group. The most specific setting wins, in order: the task
+// group, the job, the job namespace and finally the cluster scheduler
+// configuration. Any of the arguments may be nil.
+func EffectiveSchedulerAlgorithm(config *SchedulerConfiguration, ns *Namespace, job *Job, tg *TaskGroup) SchedulerAlgorithm {
+	if tg != nil {
+		if algorithm := tg.Scheduling.GetAlgorithm(); algorithm != "" {
+			return algorithm
+		}
+	}
+	if job != nil {
+		if algorithm := job.Scheduling.GetAlgorithm(); algorithm != "" {
+			return algorithm
+		}
+	}
+	if ns != nil {
+		if algorithm := ns.Scheduling.GetAlgorithm(); algorithm != "" {
+			return algorithm
+		}
+	}
+	return config.EffectiveSchedulerAlgorithm()
+}
@@ -0,0 +1,80 @@
+package structs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/shoenig/test/must"
+)
+
+func TestScheduling_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	var nilScheduling *Scheduling
+	must.NoError(t, nilScheduling.Validate())
+	must.NoError(t, (&Scheduling{}).Validate())
+	must.NoError(t, (&Scheduling{Algorithm: SchedulerAlgorithmBinpack}).Validate())
+	must.NoError(t, (&Scheduling{Algorithm: SchedulerAlgorithmSpread}).Validate())
+
+	err := (&Scheduling{Algorithm: "random"}).Validate()
+	must.Error(t, err)
+	must.StrContains(t, err.Error(), `invalid scheduler algorithm "random"`)
+}
+
+func TestEffectiveSchedulerAlgorithm(t *testing.T) {
+	ci.Parallel(t)
+
+	spread := &Scheduling{Algorithm: SchedulerAlgorithmSpread}
+	binpack := &Scheduling{Algorithm: SchedulerAlgorithmBinpack}
+	spreadConfig := &SchedulerConfiguration{SchedulerAlgorithm: SchedulerAlgorithmSpread}
+
+	cases := []struct {
+		name     string
+		config   *SchedulerConfiguration
+		ns       *Namespace
+		job      *Job
+		tg       *TaskGroup
+		expected SchedulerAlgorithm
+	}{
+		{
+			name:     "nothing set",
+			expected: SchedulerAlgorithmBinpack,
+		},
+		{
+			name:     "cluster",
+			config:   spreadConfig,
+			ns:       &Namespace{},
+			job:      &Job{},
+			tg:       &TaskGroup{},
+			expected: SchedulerAlgorithmSpread,
+		},
+		{
+			name:     "namespace overrides cluster",
+			config:   spreadConfig,
+			ns:       &Namespace{Scheduling: binpack},
+			job:      &Job{},
+			expected: SchedulerAlgorithmBinpack,
+		},
+		{
+			name:     "job overrides namespace",
+			ns:       &Namespace{Scheduling: binpack},
+			job:      &Job{Scheduling: spread},
+			tg:       &TaskGroup{Scheduling: &Scheduling{}},
+			expected: SchedulerAlgorithmSpread,
+		},
+		{
+			name:     "group overrides job",
+			config:   spreadConfig,
+			job:      &Job{Scheduling: spread},
+			tg:       &TaskGroup{Scheduling: binpack},
+			expected: SchedulerAlgorithmBinpack,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := EffectiveSchedulerAlgorithm(tc.config, tc.ns, tc.job, tc.tg)
+			must.Eq(t, tc.expected, got)
+		})
+	}
+}
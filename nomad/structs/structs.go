@@ -16,6 +16,7 @@ import (
 	"hash/crc32"
 	"math"
 	"net"
+	"net/url"
 	"os"
 	"reflect"
 	"regexp"
@@ -121,6 +122,8 @@ const (
 	ACLAuthMethodsDeleteRequestType              MessageType = 56
 	ACLBindingRulesUpsertRequestType             MessageType = 57
 	ACLBindingRulesDeleteRequestType             MessageType = 58
+	NodePoolUpsertRequestType                    MessageType = 59
+	NodePoolDeleteRequestType                    MessageType = 60
 
 	// Namespace types were moved from enterprise and therefore start at 64
 	NamespaceUpsertRequestType MessageType = 64
@@ -167,6 +170,13 @@ const (
 	// maxNamespaceDescriptionLength limits a namespace description length
 	maxNamespaceDescriptionLength = 256
 
+	// DefaultNamespaceEvalWeight is the eval weight of namespaces that don't
+	// set one.
+	DefaultNamespaceEvalWeight = 1
+
+	// maxNamespaceEvalWeight limits the eval weight of a namespace
+	maxNamespaceEvalWeight = 1000
+
 	// JitterFraction is a the limit to the amount of jitter we apply
 	// to a user specified MaxQueryTime. We divide the specified time by
 	// the fraction. So 16 == 6.25% limit of jitter. This jitter is also
@@ -759,6 +769,9 @@ type JobPlanRequest struct {
 	Diff bool // Toggles an annotated diff
 	// PolicyOverride is set when the user is attempting to override any policies
 	PolicyOverride bool
+	// Explain toggles recording why each node was filtered or how it was
+	// scored for every placement
+	Explain bool
 	WriteRequest
 }
 
@@ -831,6 +844,10 @@ type JobDispatchRequest struct {
 	Meta    map[string]string
 	WriteRequest
 	IdPrefixTemplate string
+
+	// ArrayCount is the number of indexed instances of the dispatched job to
+	// create. Zero dispatches a single job which is not an array.
+	ArrayCount int
 }
 
 // JobValidateRequest is used to validate a job
@@ -1581,9 +1598,30 @@ type JobPlanResponse struct {
 	// deprecation warnings.
 	Warnings string
 
+	// Explanations describes how the node of each placement was chosen. It is
+	// only set when the plan was requested with Explain. Task groups that
+	// failed to place are explained in the metrics of FailedTGAllocs instead.
+	Explanations []*PlacementExplanation
+
 	WriteMeta
 }
 
+// PlacementExplanation describes how the node of a placement was chosen.
+type PlacementExplanation struct {
+	// AllocName is the name of the placed allocation
+	AllocName string
+
+	// TaskGroup is the task group of the placed allocation
+	TaskGroup string
+
+	// NodeID is the node the allocation was placed on
+	NodeID string
+
+	// Metrics are the metrics of the placement, including the explanation
+	// of every node considered
+	Metrics *AllocMetric
+}
+
 // SingleAllocResponse is used to return a single allocation
 type SingleAllocResponse struct {
 	Alloc *Allocation
@@ -1977,6 +2015,9 @@ type Node struct {
 	// Datacenter for this node
 	Datacenter string
 
+	// NodePool is the node pool the node belongs to.
+	NodePool string
+
 	// Node name
 	Name string
 
@@ -2118,6 +2159,12 @@ func (n *Node) Canonicalize() {
 		n.SchedulingEligibility = NodeSchedulingEligible
 	}
 
+	// Nodes that don't set a node pool, including clients older than the
+	// introduction of node pools, are placed in the default node pool.
+	if n.NodePool == "" {
+		n.NodePool = NodePoolDefault
+	}
+
 	// COMPAT remove in 1.0
 	// In v0.12.0 we introduced a separate node specific network resource struct
 	// so we need to covert any pre 0.12 clients to the correct struct
@@ -2246,6 +2293,7 @@ func (n *Node) Stub(fields *NodeStubFields) *NodeListStub {
 		Address:               addr,
 		ID:                    n.ID,
 		Datacenter:            n.Datacenter,
+		NodePool:              n.NodePool,
 		Name:                  n.Name,
 		NodeClass:             n.NodeClass,
 		Version:               n.Attributes["nomad.version"],
@@ -2284,6 +2332,7 @@ type NodeListStub struct {
 	ID                    string
 	Attributes            map[string]string `json:",omitempty"`
 	Datacenter            string
+	NodePool              string
 	Name                  string
 	NodeClass             string
 	Version               string
@@ -2311,12 +2360,14 @@ type NodeStubFields struct {
 type Resources struct {
 	CPU         int
 	Cores       int
+	NUMA        *NUMA
 	MemoryMB    int
 	MemoryMaxMB int
 	DiskMB      int
 	IOPS        int // COMPAT(0.10): Only being used to issue warnings
 	Networks    Networks
 	Devices     ResourceDevices
+	Custom      CustomResources
 }
 
 const (
@@ -2360,6 +2411,15 @@ func (r *Resources) Validate() error {
 		mErr.Errors = append(mErr.Errors, errors.New("Task can only ask for 'cpu' or 'cores' resource, not both."))
 	}
 
+	if r.NUMA != nil {
+		if r.Cores == 0 {
+			mErr.Errors = append(mErr.Errors, errors.New("Task can only set 'numa' when asking for 'cores'."))
+		}
+		if err := r.NUMA.Validate(); err != nil {
+			mErr.Errors = append(mErr.Errors, err)
+		}
+	}
+
 	if err := r.MeetsMinResources(); err != nil {
 		mErr.Errors = append(mErr.Errors, err)
 	}
@@ -2379,6 +2439,10 @@ func (r *Resources) Validate() error {
 		mErr.Errors = append(mErr.Errors, fmt.Errorf("MemoryMaxMB value (%d) should be larger than MemoryMB value (%d)", r.MemoryMaxMB, r.MemoryMB))
 	}
 
+	if err := r.Custom.Validate(); err != nil {
+		mErr.Errors = append(mErr.Errors, err)
+	}
+
 	return mErr.ErrorOrNil()
 }
 
@@ -2391,6 +2455,9 @@ func (r *Resources) Merge(other *Resources) {
 	if other.Cores != 0 {
 		r.Cores = other.Cores
 	}
+	if other.NUMA != nil {
+		r.NUMA = other.NUMA.Copy()
+	}
 	if other.MemoryMB != 0 {
 		r.MemoryMB = other.MemoryMB
 	}
@@ -2406,6 +2473,9 @@ func (r *Resources) Merge(other *Resources) {
 	if len(other.Devices) != 0 {
 		r.Devices = other.Devices
 	}
+	if len(other.Custom) != 0 {
+		r.Custom = other.Custom
+	}
 }
 
 // Equal Resources.
@@ -2420,12 +2490,14 @@ func (r *Resources) Equal(o *Resources) bool {
 	}
 	return r.CPU == o.CPU &&
 		r.Cores == o.Cores &&
+		r.NUMA.Equal(o.NUMA) &&
 		r.MemoryMB == o.MemoryMB &&
 		r.MemoryMaxMB == o.MemoryMaxMB &&
 		r.DiskMB == o.DiskMB &&
 		r.IOPS == o.IOPS &&
 		r.Networks.Equal(&o.Networks) &&
-		r.Devices.Equal(&o.Devices)
+		r.Devices.Equal(&o.Devices) &&
+		r.Custom.Equal(o.Custom)
 }
 
 // ResourceDevices are part of Resources.
@@ -2471,10 +2543,15 @@ func (r *Resources) Canonicalize() {
 	if len(r.Devices) == 0 {
 		r.Devices = nil
 	}
+	if len(r.Custom) == 0 {
+		r.Custom = nil
+	}
 
 	for _, n := range r.Networks {
 		n.Canonicalize()
 	}
+
+	r.NUMA.Canonicalize()
 }
 
 // MeetsMinResources returns an error if the resources specified are less than
@@ -2501,6 +2578,8 @@ func (r *Resources) Copy() *Resources {
 	newR := new(Resources)
 	*newR = *r
 
+	newR.NUMA = r.NUMA.Copy()
+
 	// Copy the network objects
 	newR.Networks = r.Networks.Copy()
 
@@ -2513,6 +2592,8 @@ func (r *Resources) Copy() *Resources {
 		}
 	}
 
+	newR.Custom = r.Custom.Copy()
+
 	return newR
 }
 
@@ -2839,6 +2920,10 @@ type RequestedDevice struct {
 	// Count is the number of requested devices
 	Count uint64
 
+	// Share is the amount of the capacity of each shareable device that is
+	// requested. When set, the devices may be shared with other tasks.
+	Share uint64
+
 	// Constraints are a set of constraints to apply when selecting the device
 	// to use.
 	Constraints Constraints
@@ -2857,6 +2942,7 @@ func (r *RequestedDevice) Equal(o *RequestedDevice) bool {
 	}
 	return r.Name == o.Name &&
 		r.Count == o.Count &&
+		r.Share == o.Share &&
 		r.Constraints.Equal(&o.Constraints) &&
 		r.Affinities.Equal(&o.Affinities)
 }
@@ -2938,6 +3024,10 @@ type NodeResources struct {
 	Disk    NodeDiskResources
 	Devices []*NodeDeviceResource
 
+	// Custom are the countable resources declared in the client
+	// configuration.
+	Custom CustomResources
+
 	// NodeNetworks was added in Nomad 0.12 to support multiple interfaces.
 	// It is the superset of host_networks, fingerprinted networks, and the
 	// node's default interface.
@@ -2980,6 +3070,8 @@ func (n *NodeResources) Copy() *NodeResources {
 		}
 	}
 
+	newN.Custom = n.Custom.Copy()
+
 	return newN
 }
 
@@ -3000,6 +3092,7 @@ func (n *NodeResources) Comparable() *ComparableResources {
 				MemoryMB: n.Memory.MemoryMB,
 			},
 			Networks: n.Networks,
+			Custom:   n.Custom.Copy(),
 		},
 		Shared: AllocatedSharedResources{
 			DiskMB: n.Disk.DiskMB,
@@ -3025,6 +3118,10 @@ func (n *NodeResources) Merge(o *NodeResources) {
 		n.Devices = o.Devices
 	}
 
+	if len(o.Custom) != 0 {
+		n.Custom = o.Custom
+	}
+
 	if len(o.NodeNetworks) != 0 {
 		for _, nw := range o.NodeNetworks {
 			if i, nnw := lookupNetworkByDevice(n.NodeNetworks, nw.Device); nnw != nil {
@@ -3072,6 +3169,10 @@ func (n *NodeResources) Equal(o *NodeResources) bool {
 		return false
 	}
 
+	if !n.Custom.Equal(o.Custom) {
+		return false
+	}
+
 	if !NodeNetworksEquals(n.NodeNetworks, o.NodeNetworks) {
 		return false
 	}
@@ -3153,6 +3254,10 @@ type NodeCpuResources struct {
 	// This value is currently only reported on Linux platforms which support cgroups and is
 	// discovered by inspecting the cpuset of the agent's cgroup.
 	ReservableCpuCores []uint16
+
+	// NUMANodes is the NUMA topology of the node. It is only reported on
+	// Linux platforms that expose it through sysfs.
+	NUMANodes []*NodeNUMANode
 }
 
 func (n NodeCpuResources) Copy() NodeCpuResources {
@@ -3162,6 +3267,13 @@ func (n NodeCpuResources) Copy() NodeCpuResources {
 		copy(newN.ReservableCpuCores, n.ReservableCpuCores)
 	}
 
+	if n.NUMANodes != nil {
+		newN.NUMANodes = make([]*NodeNUMANode, len(n.NUMANodes))
+		for i, numaNode := range n.NUMANodes {
+			newN.NUMANodes[i] = numaNode.Copy()
+		}
+	}
+
 	return newN
 }
 
@@ -3181,6 +3293,10 @@ func (n *NodeCpuResources) Merge(o *NodeCpuResources) {
 	if len(o.ReservableCpuCores) != 0 {
 		n.ReservableCpuCores = o.ReservableCpuCores
 	}
+
+	if len(o.NUMANodes) != 0 {
+		n.NUMANodes = o.NUMANodes
+	}
 }
 
 func (n *NodeCpuResources) Equal(o *NodeCpuResources) bool {
@@ -3208,6 +3324,15 @@ func (n *NodeCpuResources) Equal(o *NodeCpuResources) bool {
 			return false
 		}
 	}
+
+	if len(n.NUMANodes) != len(o.NUMANodes) {
+		return false
+	}
+	for i := range n.NUMANodes {
+		if !n.NUMANodes[i].Equal(o.NUMANodes[i]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -3333,6 +3458,15 @@ type NodeDeviceResource struct {
 	Name       string
 	Instances  []*NodeDevice
 	Attributes map[string]*psstructs.Attribute
+
+	// Capacity marks the device instances as shareable when set. It is the
+	// amount of each instance that can be split between tasks.
+	Capacity uint64
+}
+
+// Shareable returns whether the device instances can be shared by tasks.
+func (n *NodeDeviceResource) Shareable() bool {
+	return n != nil && n.Capacity != 0
 }
 
 func (n *NodeDeviceResource) ID() *DeviceIdTuple {
@@ -3384,6 +3518,8 @@ func (n *NodeDeviceResource) Equal(o *NodeDeviceResource) bool {
 		return false
 	} else if n.Name != o.Name {
 		return false
+	} else if n.Capacity != o.Capacity {
+		return false
 	}
 
 	// Check the attributes
@@ -3692,6 +3828,7 @@ type AllocatedTaskResources struct {
 	Memory   AllocatedMemoryResources
 	Networks Networks
 	Devices  []*AllocatedDeviceResource
+	Custom   CustomResources
 }
 
 func (a *AllocatedTaskResources) Copy() *AllocatedTaskResources {
@@ -3713,6 +3850,8 @@ func (a *AllocatedTaskResources) Copy() *AllocatedTaskResources {
 		}
 	}
 
+	newA.Custom = a.Custom.Copy()
+
 	return newA
 }
 
@@ -3748,6 +3887,13 @@ func (a *AllocatedTaskResources) Add(delta *AllocatedTaskResources) {
 			a.Devices[idx].Add(d)
 		}
 	}
+
+	if len(delta.Custom) != 0 {
+		if a.Custom == nil {
+			a.Custom = make(CustomResources, len(delta.Custom))
+		}
+		a.Custom.Add(delta.Custom)
+	}
 }
 
 func (a *AllocatedTaskResources) Max(other *AllocatedTaskResources) {
@@ -3777,6 +3923,13 @@ func (a *AllocatedTaskResources) Max(other *AllocatedTaskResources) {
 			a.Devices[idx].Add(d)
 		}
 	}
+
+	if len(other.Custom) != 0 {
+		if a.Custom == nil {
+			a.Custom = make(CustomResources, len(other.Custom))
+		}
+		a.Custom.Max(other.Custom)
+	}
 }
 
 // Comparable turns AllocatedTaskResources into ComparableResources
@@ -3792,14 +3945,15 @@ func (a *AllocatedTaskResources) Comparable() *ComparableResources {
 				MemoryMB:    a.Memory.MemoryMB,
 				MemoryMaxMB: a.Memory.MemoryMaxMB,
 			},
+			Custom: a.Custom.Copy(),
 		},
 	}
 	ret.Flattened.Networks = append(ret.Flattened.Networks, a.Networks...)
 	return ret
 }
 
-// Subtract only subtracts CPU and Memory resources. Network utilization
-// is managed separately in NetworkIndex
+// Subtract only subtracts CPU, Memory and custom resources. Network
+// utilization is managed separately in NetworkIndex
 func (a *AllocatedTaskResources) Subtract(delta *AllocatedTaskResources) {
 	if delta == nil {
 		return
@@ -3807,6 +3961,7 @@ func (a *AllocatedTaskResources) Subtract(delta *AllocatedTaskResources) {
 
 	a.Cpu.Subtract(&delta.Cpu)
 	a.Memory.Subtract(&delta.Memory)
+	a.Custom.Subtract(delta.Custom)
 }
 
 // AllocatedSharedResources are the set of resources allocated to a task group.
@@ -3981,6 +4136,10 @@ type AllocatedDeviceResource struct {
 
 	// DeviceIDs is the set of allocated devices
 	DeviceIDs []string
+
+	// Share is the amount of the capacity of each device that is allocated.
+	// It is zero when the devices are allocated whole.
+	Share uint64
 }
 
 func (a *AllocatedDeviceResource) ID() *DeviceIdTuple {
@@ -4047,6 +4206,7 @@ func (c *ComparableResources) Copy() *ComparableResources {
 	}
 	newR := new(ComparableResources)
 	*newR = *c
+	newR.Flattened.Custom = c.Flattened.Custom.Copy()
 	return newR
 }
 
@@ -4066,6 +4226,9 @@ func (c *ComparableResources) Superset(other *ComparableResources) (bool, string
 	if c.Shared.DiskMB < other.Shared.DiskMB {
 		return false, "disk"
 	}
+	if ok, name := c.Flattened.Custom.Superset(other.Flattened.Custom); !ok {
+		return false, fmt.Sprintf("custom resource %s", name)
+	}
 	return true, ""
 }
 
@@ -4161,6 +4324,11 @@ type Job struct {
 	// Datacenters contains all the datacenters this job is allowed to span
 	Datacenters []string
 
+	// NodePool specifies the node pool this job is allowed to run on. An
+	// empty value is set to the default node pool of the job namespace when
+	// the job is registered.
+	NodePool string
+
 	// Constraints can be specified at a job level and apply to
 	// all the task groups and tasks.
 	Constraints []*Constraint
@@ -4169,10 +4337,18 @@ type Job struct {
 	// scheduling preferences that apply to all groups and tasks
 	Affinities []*Affinity
 
+	// AllocAffinities can be specified at the job level to place all groups
+	// relative to the allocations of other jobs.
+	AllocAffinities []*AllocAffinity
+
 	// Spread can be specified at the job level to express spreading
 	// allocations across a desired attribute, such as datacenter
 	Spreads []*Spread
 
+	// Scheduling overrides the cluster and namespace scheduler algorithm
+	// for all the task groups of the job.
+	Scheduling *Scheduling
+
 	// TaskGroups are the collections of task groups that this job needs
 	// to run. Each task group is an atomic unit of scheduling and placement.
 	TaskGroups []*TaskGroup
@@ -4198,6 +4374,12 @@ type Job struct {
 	// non-terminal siblings which have the same token value.
 	DispatchIdempotencyToken string
 
+	// ArrayCount is the number of indexed instances of an array dispatched
+	// job. The count of each task group is multiplied by the number of
+	// instances, and the allocations of instance i are the ones whose index
+	// modulo ArrayCount is i.
+	ArrayCount int
+
 	// Payload is the payload supplied when the job was dispatched.
 	Payload []byte
 
@@ -4335,7 +4517,9 @@ func (j *Job) Copy() *Job {
 	nj.Datacenters = slices.Clone(nj.Datacenters)
 	nj.Constraints = CopySliceConstraints(nj.Constraints)
 	nj.Affinities = CopySliceAffinities(nj.Affinities)
+	nj.AllocAffinities = CopySliceAllocAffinities(nj.AllocAffinities)
 	nj.Multiregion = nj.Multiregion.Copy()
+	nj.Scheduling = nj.Scheduling.Copy()
 
 	if j.TaskGroups != nil {
 		tgs := make([]*TaskGroup, len(nj.TaskGroups))
@@ -4392,6 +4576,12 @@ func (j *Job) Validate() error {
 			}
 		}
 	}
+	if j.NodePool != "" && !validNodePoolName.MatchString(j.NodePool) {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("Invalid node pool %q, must match regex %s", j.NodePool, validNodePoolName))
+	}
+	if err := j.Scheduling.Validate(); err != nil {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("Scheduling validation failed: %v", err))
+	}
 	if len(j.TaskGroups) == 0 {
 		mErr.Errors = append(mErr.Errors, errors.New("Missing job task groups"))
 	}
@@ -4414,6 +4604,19 @@ func (j *Job) Validate() error {
 		}
 	}
 
+	if j.Type == JobTypeSystem || j.Type == JobTypeSysBatch {
+		if j.AllocAffinities != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("%s jobs may not have an alloc_affinity stanza", j.Type))
+		}
+	} else {
+		for idx, affinity := range j.AllocAffinities {
+			if err := affinity.Validate(); err != nil {
+				outer := fmt.Errorf("Allocation affinity %d validation failed: %s", idx+1, err)
+				mErr.Errors = append(mErr.Errors, outer)
+			}
+		}
+	}
+
 	if j.Type == JobTypeSystem {
 		if j.Spreads != nil {
 			mErr.Errors = append(mErr.Errors, fmt.Errorf("System jobs may not have a spread stanza"))
@@ -4458,6 +4661,10 @@ func (j *Job) Validate() error {
 		}
 	}
 
+	if err := j.validateGroupDependencies(); err != nil {
+		mErr.Errors = append(mErr.Errors, err)
+	}
+
 	// Validate the task group
 	for _, tg := range j.TaskGroups {
 		if err := tg.Validate(j); err != nil {
@@ -4912,6 +5119,11 @@ type UpdateStrategy struct {
 	// MaxParallel is how many updates can be done in parallel
 	MaxParallel int
 
+	// MaxSurge is how many allocations of the new job version can be placed
+	// before the allocations they replace are stopped. Old allocations are
+	// only stopped once their replacement is healthy.
+	MaxSurge int
+
 	// HealthCheck specifies the mechanism in which allocations are marked
 	// healthy or unhealthy as part of a deployment.
 	HealthCheck string
@@ -4944,6 +5156,18 @@ type UpdateStrategy struct {
 	// Canary is the number of canaries to deploy when a change to the task
 	// group is detected.
 	Canary int
+
+	// CanaryPercent is the percentage of the eligible nodes to deploy
+	// canaries to when a change to a system job task group is detected.
+	CanaryPercent int
+
+	// Stages rolls out the task group progressively once its canaries are
+	// promoted, one stage at a time.
+	Stages []*UpdateStage
+
+	// Analysis compares the healthy canaries with the allocations of the
+	// stable job version before they are promoted.
+	Analysis *CanaryAnalysis
 }
 
 func (u *UpdateStrategy) Copy() *UpdateStrategy {
@@ -4953,6 +5177,8 @@ func (u *UpdateStrategy) Copy() *UpdateStrategy {
 
 	c := new(UpdateStrategy)
 	*c = *u
+	c.Stages = CopySliceUpdateStages(u.Stages)
+	c.Analysis = u.Analysis.Copy()
 	return c
 }
 
@@ -4971,10 +5197,19 @@ func (u *UpdateStrategy) Validate() error {
 	if u.MaxParallel < 0 {
 		_ = multierror.Append(&mErr, fmt.Errorf("Max parallel can not be less than zero: %d < 0", u.MaxParallel))
 	}
+	if u.MaxSurge < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Max surge can not be less than zero: %d < 0", u.MaxSurge))
+	}
 	if u.Canary < 0 {
 		_ = multierror.Append(&mErr, fmt.Errorf("Canary count can not be less than zero: %d < 0", u.Canary))
 	}
-	if u.Canary == 0 && u.AutoPromote {
+	if u.CanaryPercent < 0 || u.CanaryPercent > 100 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Canary percent must be between 0 and 100: %d", u.CanaryPercent))
+	}
+	if u.Canary > 0 && u.CanaryPercent > 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Canary count and canary percent can not both be set"))
+	}
+	if u.Canary == 0 && u.CanaryPercent == 0 && u.AutoPromote && len(u.Stages) == 0 {
 		_ = multierror.Append(&mErr, fmt.Errorf("Auto Promote requires a Canary count greater than zero"))
 	}
 	if u.MinHealthyTime < 0 {
@@ -4995,6 +5230,27 @@ func (u *UpdateStrategy) Validate() error {
 	if u.Stagger <= 0 {
 		_ = multierror.Append(&mErr, fmt.Errorf("Stagger must be greater than zero: %v", u.Stagger))
 	}
+	for i, stage := range u.Stages {
+		if stage.Percent <= 0 || stage.Percent > 100 {
+			_ = multierror.Append(&mErr, fmt.Errorf("Stage %d percent must be between 1 and 100: %d", i+1, stage.Percent))
+		} else if i > 0 && stage.Percent <= u.Stages[i-1].Percent {
+			_ = multierror.Append(&mErr, fmt.Errorf("Stage %d percent must be greater than the previous stage: %d <= %d", i+1, stage.Percent, u.Stages[i-1].Percent))
+		}
+		if stage.Pause < 0 {
+			_ = multierror.Append(&mErr, fmt.Errorf("Stage %d pause may not be less than zero: %v", i+1, stage.Pause))
+		}
+	}
+	if n := len(u.Stages); n > 0 && u.Stages[n-1].Percent != 100 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Last stage percent must be 100: %d", u.Stages[n-1].Percent))
+	}
+	if u.Analysis != nil {
+		if u.Canary == 0 && u.CanaryPercent == 0 {
+			_ = multierror.Append(&mErr, fmt.Errorf("Canary analysis requires a Canary count greater than zero"))
+		}
+		if err := u.Analysis.Validate(); err != nil {
+			_ = multierror.Append(&mErr, err)
+		}
+	}
 
 	return mErr.ErrorOrNil()
 }
@@ -5007,6 +5263,92 @@ func (u *UpdateStrategy) IsEmpty() bool {
 	return u.MaxParallel == 0
 }
 
+// UpdateStage is a step of a staged rollout. Each stage replaces allocations
+// until the given percentage of the task group runs the new job version.
+type UpdateStage struct {
+	// Percent is the percentage of the task group allocations running the
+	// new job version once the stage is complete.
+	Percent int
+
+	// Pause is how long the allocations of a healthy stage are observed
+	// before the rollout advances to the next stage when auto promote is
+	// set.
+	Pause time.Duration
+}
+
+func (s *UpdateStage) Copy() *UpdateStage {
+	if s == nil {
+		return nil
+	}
+	c := new(UpdateStage)
+	*c = *s
+	return c
+}
+
+func CopySliceUpdateStages(s []*UpdateStage) []*UpdateStage {
+	if s == nil {
+		return nil
+	}
+	c := make([]*UpdateStage, len(s))
+	for i, stage := range s {
+		c[i] = stage.Copy()
+	}
+	return c
+}
+
+// CanaryAnalysis compares the resource usage, restarts and optionally an
+// external metrics query of the canaries of a deployment with the allocations
+// of the stable job version. The deployment is failed if any of the
+// thresholds is exceeded.
+type CanaryAnalysis struct {
+	// MaxMemoryRatio is the highest allowed ratio of the average memory usage
+	// of the canaries to the one of the stable allocations. Zero disables
+	// the check.
+	MaxMemoryRatio float64
+
+	// MaxCPURatio is the highest allowed ratio of the average CPU usage of
+	// the canaries to the one of the stable allocations. Zero disables the
+	// check.
+	MaxCPURatio float64
+
+	// MaxRestarts is how many more task restarts the canaries may have on
+	// average than the stable allocations, both counted since the first
+	// canary was placed.
+	MaxRestarts int
+
+	// MetricsURL is queried with the deployment of the canaries once they
+	// are healthy, any response other than a 2xx fails the analysis.
+	MetricsURL string
+}
+
+func (a *CanaryAnalysis) Copy() *CanaryAnalysis {
+	if a == nil {
+		return nil
+	}
+	c := new(CanaryAnalysis)
+	*c = *a
+	return c
+}
+
+func (a *CanaryAnalysis) Validate() error {
+	var mErr multierror.Error
+	if a.MaxMemoryRatio < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Analysis max memory ratio may not be less than zero: %v", a.MaxMemoryRatio))
+	}
+	if a.MaxCPURatio < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Analysis max CPU ratio may not be less than zero: %v", a.MaxCPURatio))
+	}
+	if a.MaxRestarts < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Analysis max restarts may not be less than zero: %d", a.MaxRestarts))
+	}
+	if a.MetricsURL != "" {
+		if u, err := url.Parse(a.MetricsURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			_ = multierror.Append(&mErr, fmt.Errorf("Analysis metrics URL must be an absolute http or https URL: %q", a.MetricsURL))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
 // Rolling returns if a rolling strategy should be used.
 // TODO(alexdadgar): Remove once no longer used by the scheduler.
 func (u *UpdateStrategy) Rolling() bool {
@@ -5086,6 +5428,19 @@ type Namespace struct {
 	// Capabilities is the set of capabilities allowed for this namespace
 	Capabilities *NamespaceCapabilities
 
+	// NodePoolConfiguration is the namespace configuration for handling node
+	// pools.
+	NodePoolConfiguration *NamespaceNodePoolConfiguration
+
+	// Scheduling is the default scheduling configuration for jobs in the
+	// namespace.
+	Scheduling *Scheduling
+
+	// EvalWeight is the share of evaluation processing the namespace gets
+	// relative to other namespaces when evaluations are waiting in the eval
+	// broker. Zero uses DefaultNamespaceEvalWeight.
+	EvalWeight int
+
 	// Meta is the set of metadata key/value pairs that attached to the namespace
 	Meta map[string]string
 
@@ -5117,10 +5472,29 @@ func (n *Namespace) Validate() error {
 		err := fmt.Errorf("description longer than %d", maxNamespaceDescriptionLength)
 		mErr.Errors = append(mErr.Errors, err)
 	}
+	if err := n.NodePoolConfiguration.Validate(); err != nil {
+		mErr.Errors = append(mErr.Errors, err)
+	}
+	if err := n.Scheduling.Validate(); err != nil {
+		mErr.Errors = append(mErr.Errors, err)
+	}
+	if n.EvalWeight < 0 || n.EvalWeight > maxNamespaceEvalWeight {
+		err := fmt.Errorf("eval weight must be between 0 and %d", maxNamespaceEvalWeight)
+		mErr.Errors = append(mErr.Errors, err)
+	}
 
 	return mErr.ErrorOrNil()
 }
 
+// GetEvalWeight returns the eval weight of the namespace, or the default
+// weight if it isn't set.
+func (n *Namespace) GetEvalWeight() int {
+	if n == nil || n.EvalWeight == 0 {
+		return DefaultNamespaceEvalWeight
+	}
+	return n.EvalWeight
+}
+
 // SetHash is used to compute and set the hash of the namespace
 func (n *Namespace) SetHash() []byte {
 	// Initialize a 256bit Blake2 hash (32 bytes)
@@ -5141,6 +5515,18 @@ func (n *Namespace) SetHash() []byte {
 			_, _ = hash.Write([]byte(driver))
 		}
 	}
+	if n.NodePoolConfiguration != nil {
+		_, _ = hash.Write([]byte(n.NodePoolConfiguration.Default))
+		for _, pool := range n.NodePoolConfiguration.Allowed {
+			_, _ = hash.Write([]byte(pool))
+		}
+	}
+	if n.Scheduling != nil {
+		_, _ = hash.Write([]byte(n.Scheduling.Algorithm))
+	}
+	if n.EvalWeight != 0 {
+		_, _ = hash.Write([]byte(strconv.Itoa(n.EvalWeight)))
+	}
 
 	// sort keys to ensure hash stability when meta is stored later
 	var keys []string
@@ -5173,6 +5559,8 @@ func (n *Namespace) Copy() *Namespace {
 		c.DisabledTaskDrivers = slices.Clone(n.Capabilities.DisabledTaskDrivers)
 		nc.Capabilities = c
 	}
+	nc.NodePoolConfiguration = n.NodePoolConfiguration.Copy()
+	nc.Scheduling = n.Scheduling.Copy()
 	if n.Meta != nil {
 		nc.Meta = make(map[string]string, len(n.Meta))
 		for k, v := range n.Meta {
@@ -5239,6 +5627,20 @@ const (
 	PeriodicSpecTest = "_internal_test"
 )
 
+const (
+	// PeriodicConcurrencyAllow launches the periodic job even if previous
+	// instances are still running.
+	PeriodicConcurrencyAllow = "allow"
+
+	// PeriodicConcurrencyForbid skips launches of the periodic job while
+	// previous instances are still running.
+	PeriodicConcurrencyForbid = "forbid"
+
+	// PeriodicConcurrencyReplace stops the running instances of the periodic
+	// job before launching a new one.
+	PeriodicConcurrencyReplace = "replace"
+)
+
 // Periodic defines the interval a job should be run at.
 type PeriodicConfig struct {
 	// Enabled determines if the job should be run periodically.
@@ -5248,12 +5650,30 @@ type PeriodicConfig struct {
 	// on the SpecType.
 	Spec string
 
+	// Specs specifies multiple cron specs the job should be run as. The job
+	// is launched at the earliest upcoming time matching any of them. It can
+	// not be used alongside Spec.
+	Specs []string
+
 	// SpecType defines the format of the spec.
 	SpecType string
 
 	// ProhibitOverlap enforces that spawned jobs do not run in parallel.
 	ProhibitOverlap bool
 
+	// ConcurrencyPolicy defines how a launch is handled while previous
+	// instances of the job are still running. When unset, it is derived from
+	// ProhibitOverlap.
+	ConcurrencyPolicy string
+
+	// SuccessfulHistoryLimit and FailedHistoryLimit are the number of
+	// successful and failed instances of the job kept once they are
+	// complete. Older instances are garbage collected regardless of the job
+	// GC threshold. When unset, instances are garbage collected according to
+	// the job GC threshold.
+	SuccessfulHistoryLimit int
+	FailedHistoryLimit     int
+
 	// TimeZone is the user specified string that determines the time zone to
 	// launch against. The time zones must be specified from IANA Time Zone
 	// database, such as "America/New_York".
@@ -5261,16 +5681,32 @@ type PeriodicConfig struct {
 	// Reference: https://www.iana.org/time-zones
 	TimeZone string
 
+	// CatchupWindow is how far in the past launches missed while there was
+	// no leader are still dispatched once a leader is elected. When unset, a
+	// single launch is dispatched for all the missed ones.
+	CatchupWindow time.Duration
+
+	// Jitter is the maximum delay randomly added to each launch to spread
+	// the launches of jobs sharing the same schedule.
+	Jitter time.Duration
+
 	// location is the time zone to evaluate the launch time against
 	location *time.Location
 }
 
+const (
+	// PeriodicCatchupLimit is the maximum number of missed launches of a
+	// periodic job dispatched when a leader is elected.
+	PeriodicCatchupLimit = 10
+)
+
 func (p *PeriodicConfig) Copy() *PeriodicConfig {
 	if p == nil {
 		return nil
 	}
 	np := new(PeriodicConfig)
 	*np = *p
+	np.Specs = slices.Clone(p.Specs)
 	return np
 }
 
@@ -5280,8 +5716,34 @@ func (p *PeriodicConfig) Validate() error {
 	}
 
 	var mErr multierror.Error
-	if p.Spec == "" {
+	if p.Spec == "" && len(p.Specs) == 0 {
 		_ = multierror.Append(&mErr, fmt.Errorf("Must specify a spec"))
+	} else if p.Spec != "" && len(p.Specs) != 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Only one of cron or crons may be specified"))
+	}
+
+	if p.CatchupWindow < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Catchup window can not be less than zero: %v < 0", p.CatchupWindow))
+	}
+	if p.Jitter < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Jitter can not be less than zero: %v < 0", p.Jitter))
+	}
+
+	switch p.ConcurrencyPolicy {
+	case "", PeriodicConcurrencyForbid:
+	case PeriodicConcurrencyAllow, PeriodicConcurrencyReplace:
+		if p.ProhibitOverlap {
+			_ = multierror.Append(&mErr, fmt.Errorf("Prohibit overlap can not be used with concurrency policy %q", p.ConcurrencyPolicy))
+		}
+	default:
+		_ = multierror.Append(&mErr, fmt.Errorf("Unknown concurrency policy %q", p.ConcurrencyPolicy))
+	}
+
+	if p.SuccessfulHistoryLimit < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Successful history limit can not be less than zero: %d < 0", p.SuccessfulHistoryLimit))
+	}
+	if p.FailedHistoryLimit < 0 {
+		_ = multierror.Append(&mErr, fmt.Errorf("Failed history limit can not be less than zero: %d < 0", p.FailedHistoryLimit))
 	}
 
 	// Check if we got a valid time zone
@@ -5293,9 +5755,11 @@ func (p *PeriodicConfig) Validate() error {
 
 	switch p.SpecType {
 	case PeriodicSpecCron:
-		// Validate the cron spec
-		if _, err := cronexpr.Parse(p.Spec); err != nil {
-			_ = multierror.Append(&mErr, fmt.Errorf("Invalid cron spec %q: %v", p.Spec, err))
+		// Validate the cron specs
+		for _, spec := range p.cronSpecs() {
+			if _, err := cronexpr.Parse(spec); err != nil {
+				_ = multierror.Append(&mErr, fmt.Errorf("Invalid cron spec %q: %v", spec, err))
+			}
 		}
 	case PeriodicSpecTest:
 		// No-op
@@ -5336,11 +5800,21 @@ func CronParseNext(e *cronexpr.Expression, fromTime time.Time, spec string) (t t
 func (p *PeriodicConfig) Next(fromTime time.Time) (time.Time, error) {
 	switch p.SpecType {
 	case PeriodicSpecCron:
-		e, err := cronexpr.Parse(p.Spec)
-		if err != nil {
-			return time.Time{}, fmt.Errorf("failed parsing cron expression: %q: %v", p.Spec, err)
+		var next time.Time
+		for _, spec := range p.cronSpecs() {
+			e, err := cronexpr.Parse(spec)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("failed parsing cron expression: %q: %v", spec, err)
+			}
+			t, err := CronParseNext(e, fromTime, spec)
+			if err != nil {
+				return time.Time{}, err
+			}
+			if !t.IsZero() && (next.IsZero() || t.Before(next)) {
+				next = t
+			}
 		}
-		return CronParseNext(e, fromTime, p.Spec)
+		return next, nil
 	case PeriodicSpecTest:
 		split := strings.Split(p.Spec, ",")
 		if len(split) == 1 && split[0] == "" {
@@ -5369,6 +5843,36 @@ func (p *PeriodicConfig) Next(fromTime time.Time) (time.Time, error) {
 	return time.Time{}, nil
 }
 
+// Concurrency returns the concurrency policy of the periodic job, falling back
+// to ProhibitOverlap when no policy is set.
+func (p *PeriodicConfig) Concurrency() string {
+	if p.ConcurrencyPolicy != "" {
+		return p.ConcurrencyPolicy
+	}
+	if p.ProhibitOverlap {
+		return PeriodicConcurrencyForbid
+	}
+	return PeriodicConcurrencyAllow
+}
+
+// HistoryLimit returns the number of successful or failed instances of the
+// periodic job to keep, or zero if they are garbage collected according to
+// the job GC threshold.
+func (p *PeriodicConfig) HistoryLimit(failed bool) int {
+	if failed {
+		return p.FailedHistoryLimit
+	}
+	return p.SuccessfulHistoryLimit
+}
+
+// cronSpecs returns the cron specs of the periodic config.
+func (p *PeriodicConfig) cronSpecs() []string {
+	if len(p.Specs) != 0 {
+		return p.Specs
+	}
+	return []string{p.Spec}
+}
+
 // GetLocation returns the location to use for determining the time zone to run
 // the periodic job against.
 func (p *PeriodicConfig) GetLocation() *time.Location {
@@ -5501,6 +6005,14 @@ const (
 type TaskLifecycleConfig struct {
 	Hook    string
 	Sidecar bool
+
+	// ReadyChecks blocks the main tasks of a prestart sidecar until the
+	// checks of the sidecar's services pass.
+	ReadyChecks bool
+
+	// ReadyTimeout is how long to wait for the checks to pass before the
+	// sidecar is killed and the allocation fails.
+	ReadyTimeout time.Duration
 }
 
 func (d *TaskLifecycleConfig) Copy() *TaskLifecycleConfig {
@@ -5527,9 +6039,55 @@ func (d *TaskLifecycleConfig) Validate() error {
 		return fmt.Errorf("invalid hook: %v", d.Hook)
 	}
 
+	if d.ReadyChecks && (d.Hook != TaskLifecycleHookPrestart || !d.Sidecar) {
+		return fmt.Errorf("ready_checks is only supported by prestart sidecar tasks")
+	}
+	if d.ReadyTimeout < 0 {
+		return fmt.Errorf("ready_timeout must be greater than or equal to 0")
+	}
+
 	return nil
 }
 
+// TaskPrestop is a command executed inside a running task before the task is
+// sent its kill signal, allowing the task to drain gracefully.
+type TaskPrestop struct {
+	// Command is the command to execute inside the task.
+	Command string
+
+	// Args are the arguments passed to the command.
+	Args []string
+
+	// Timeout is the maximum amount of time the command may run before the
+	// task is killed anyway.
+	Timeout time.Duration
+}
+
+func (p *TaskPrestop) Copy() *TaskPrestop {
+	if p == nil {
+		return nil
+	}
+	np := new(TaskPrestop)
+	*np = *p
+	np.Args = slices.Clone(p.Args)
+	return np
+}
+
+func (p *TaskPrestop) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	var mErr multierror.Error
+	if p.Command == "" {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("missing prestop command"))
+	}
+	if p.Timeout <= 0 {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("prestop timeout must be greater than zero"))
+	}
+	return mErr.ErrorOrNil()
+}
+
 var (
 	// These default restart policies needs to be in sync with
 	// Canonicalize in api/tasks.go
@@ -5874,9 +6432,22 @@ type RestartPolicy struct {
 	// Delay is the time between a failure and a restart.
 	Delay time.Duration
 
+	// DelayFunction determines how the delay progressively changes on
+	// subsequent restarts within an interval. Valid values are "constant",
+	// "exponential", and "fibonacci". An empty value is treated as
+	// "constant".
+	DelayFunction string
+
+	// MaxDelay is an upper bound on the delay.
+	MaxDelay time.Duration
+
 	// Mode controls what happens when the task restarts more than attempt times
 	// in an interval.
 	Mode string
+
+	// OnExit classifies the exits of the task to override whether they are
+	// restarted.
+	OnExit ExitRules
 }
 
 func (r *RestartPolicy) Copy() *RestartPolicy {
@@ -5885,9 +6456,25 @@ func (r *RestartPolicy) Copy() *RestartPolicy {
 	}
 	nrp := new(RestartPolicy)
 	*nrp = *r
+	nrp.OnExit = r.OnExit.Copy()
 	return nrp
 }
 
+// Canonicalize defaults the max delay of a policy that increases its delay to
+// the restart interval, or to the delay if that is longer.
+func (r *RestartPolicy) Canonicalize() {
+	if r == nil || r.MaxDelay != 0 {
+		return
+	}
+	switch r.DelayFunction {
+	case "exponential", "fibonacci":
+		r.MaxDelay = r.Interval
+		if r.Delay > r.MaxDelay {
+			r.MaxDelay = r.Delay
+		}
+	}
+}
+
 func (r *RestartPolicy) Validate() error {
 	var mErr multierror.Error
 	switch r.Mode {
@@ -5908,9 +6495,46 @@ func (r *RestartPolicy) Validate() error {
 		_ = multierror.Append(&mErr,
 			fmt.Errorf("Nomad can't restart the TaskGroup %v times in an interval of %v with a delay of %v", r.Attempts, r.Interval, r.Delay))
 	}
+
+	switch r.DelayFunction {
+	case "", "constant":
+	case "exponential", "fibonacci":
+		if r.MaxDelay < r.Delay {
+			_ = multierror.Append(&mErr, fmt.Errorf("Max Delay cannot be less than Delay %v (got %v)", r.Delay, r.MaxDelay))
+		}
+	default:
+		_ = multierror.Append(&mErr, fmt.Errorf("Invalid delay function %q, must be one of %q", r.DelayFunction, RescheduleDelayFunctions))
+	}
+
+	if err := r.OnExit.Validate(); err != nil {
+		_ = multierror.Append(&mErr, err)
+	}
 	return mErr.ErrorOrNil()
 }
 
+// BackoffDelay returns the delay before the given restart attempt within an
+// interval, starting at one, according to the delay function of the policy.
+func (r *RestartPolicy) BackoffDelay(attempt int) time.Duration {
+	delay := r.Delay
+	switch r.DelayFunction {
+	case "exponential":
+		for i := 1; i < attempt && delay < r.MaxDelay; i++ {
+			delay *= 2
+		}
+	case "fibonacci":
+		var prev time.Duration
+		for i := 1; i < attempt && delay < r.MaxDelay; i++ {
+			prev, delay = delay, prev+delay
+		}
+	default:
+		return delay
+	}
+	if delay > r.MaxDelay {
+		delay = r.MaxDelay
+	}
+	return delay
+}
+
 func NewRestartPolicy(jobType string) *RestartPolicy {
 	switch jobType {
 	case JobTypeService, JobTypeSystem:
@@ -5950,6 +6574,10 @@ type ReschedulePolicy struct {
 	// Unlimited allows infinite rescheduling attempts. Only allowed when delay is set
 	// between reschedule attempts.
 	Unlimited bool
+
+	// OnExit classifies the exits of failed tasks to override whether their
+	// allocation is rescheduled.
+	OnExit ExitRules
 }
 
 func (r *ReschedulePolicy) Copy() *ReschedulePolicy {
@@ -5958,6 +6586,7 @@ func (r *ReschedulePolicy) Copy() *ReschedulePolicy {
 	}
 	nrp := new(ReschedulePolicy)
 	*nrp = *r
+	nrp.OnExit = r.OnExit.Copy()
 	return nrp
 }
 
@@ -5975,6 +6604,10 @@ func (r *ReschedulePolicy) Validate() error {
 		return nil
 	}
 	var mErr multierror.Error
+	if err := r.OnExit.Validate(); err != nil {
+		_ = multierror.Append(&mErr, err)
+	}
+
 	// Check for ambiguous/confusing settings
 	if r.Attempts > 0 {
 		if r.Interval <= 0 {
@@ -6237,10 +6870,27 @@ type TaskGroup struct {
 	// scheduling preferences.
 	Affinities []*Affinity
 
+	// AllocAffinities can be specified at the task group level to place the
+	// group relative to the allocations of other jobs.
+	AllocAffinities []*AllocAffinity
+
 	// Spread can be specified at the task group level to express spreading
 	// allocations across a desired attribute, such as datacenter
 	Spreads []*Spread
 
+	// Scheduling overrides the job, namespace and cluster scheduler
+	// algorithm for this task group.
+	Scheduling *Scheduling
+
+	// Gang marks the task group to be placed all at once. Allocations of a
+	// gang are only placed when every allocation of the group can be placed,
+	// and losing one of them replaces the whole group.
+	Gang bool
+
+	// DependsOn defers the placement of the task group until the task
+	// groups it depends on reach the required condition.
+	DependsOn []*GroupDependency
+
 	// Networks are the network configuration for the task group. This can be
 	// overridden in the task.
 	Networks Networks
@@ -6278,7 +6928,10 @@ func (tg *TaskGroup) Copy() *TaskGroup {
 	ntg.RestartPolicy = ntg.RestartPolicy.Copy()
 	ntg.ReschedulePolicy = ntg.ReschedulePolicy.Copy()
 	ntg.Affinities = CopySliceAffinities(ntg.Affinities)
+	ntg.AllocAffinities = CopySliceAllocAffinities(ntg.AllocAffinities)
 	ntg.Spreads = CopySliceSpreads(ntg.Spreads)
+	ntg.Scheduling = ntg.Scheduling.Copy()
+	ntg.DependsOn = CopySliceGroupDependencies(ntg.DependsOn)
 	ntg.Volumes = CopyMapVolumeRequest(ntg.Volumes)
 	ntg.Scaling = ntg.Scaling.Copy()
 	ntg.Consul = ntg.Consul.Copy()
@@ -6340,6 +6993,7 @@ func (tg *TaskGroup) Canonicalize(job *Job) {
 	if tg.RestartPolicy == nil {
 		tg.RestartPolicy = NewRestartPolicy(job.Type)
 	}
+	tg.RestartPolicy.Canonicalize()
 
 	if tg.ReschedulePolicy == nil {
 		tg.ReschedulePolicy = NewReschedulePolicy(job.Type)
@@ -6434,6 +7088,19 @@ func (tg *TaskGroup) Validate(j *Job) error {
 		}
 	}
 
+	if j.Type == JobTypeSystem || j.Type == JobTypeSysBatch {
+		if tg.AllocAffinities != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("%s jobs may not have an alloc_affinity stanza", j.Type))
+		}
+	} else {
+		for idx, affinity := range tg.AllocAffinities {
+			if err := affinity.Validate(); err != nil {
+				outer := fmt.Errorf("Allocation affinity %d validation failed: %s", idx+1, err)
+				mErr.Errors = append(mErr.Errors, outer)
+			}
+		}
+	}
+
 	if tg.RestartPolicy != nil {
 		if err := tg.RestartPolicy.Validate(); err != nil {
 			mErr.Errors = append(mErr.Errors, err)
@@ -6455,6 +7122,27 @@ func (tg *TaskGroup) Validate(j *Job) error {
 		}
 	}
 
+	if err := tg.Scheduling.Validate(); err != nil {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("Scheduling validation failed: %v", err))
+	}
+
+	if tg.Gang && (j.Type == JobTypeSystem || j.Type == JobTypeSysBatch) {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("Task Group %v: gang scheduling is not supported for %s jobs", tg.Name, j.Type))
+	}
+
+	if j.Type == JobTypeSystem || j.Type == JobTypeSysBatch {
+		if tg.DependsOn != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("%s jobs may not have a depends_on stanza", j.Type))
+		}
+	} else {
+		for idx, dep := range tg.DependsOn {
+			if err := dep.Validate(j.Type); err != nil {
+				outer := fmt.Errorf("Dependency %d validation failed: %s", idx+1, err)
+				mErr.Errors = append(mErr.Errors, outer)
+			}
+		}
+	}
+
 	if j.Type == JobTypeSystem {
 		if tg.ReschedulePolicy != nil {
 			mErr.Errors = append(mErr.Errors, fmt.Errorf("System jobs should not have a reschedule policy"))
@@ -6484,6 +7172,12 @@ func (tg *TaskGroup) Validate(j *Job) error {
 		default:
 			mErr.Errors = append(mErr.Errors, fmt.Errorf("Job type %q does not allow update block", j.Type))
 		}
+		if u.CanaryPercent > 0 && j.Type != JobTypeSystem {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("Job type %q does not allow canary_percent", j.Type))
+		}
+		if u.MaxSurge > 0 && j.Type != JobTypeService {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("Job type %q does not allow max_surge", j.Type))
+		}
 		if err := u.Validate(); err != nil {
 			mErr.Errors = append(mErr.Errors, err)
 		}
@@ -6534,6 +7228,10 @@ func (tg *TaskGroup) Validate(j *Job) error {
 			mErr.Errors = append(mErr.Errors, fmt.Errorf(
 				"Task group volume validation for %s failed: %v", name, err))
 		}
+		if volReq.PerAlloc && tg.Update != nil && tg.Update.MaxSurge > 0 {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf(
+				"Task group volume validation for %s failed: volume cannot be per_alloc when max_surge is in use", name))
+		}
 	}
 
 	// Validate task group and task network resources
@@ -7118,6 +7816,10 @@ type Task struct {
 	// task from Consul and sending it a signal to shutdown. See #2441
 	ShutdownDelay time.Duration
 
+	// Prestop is a command executed inside the task before it is sent its
+	// kill signal.
+	Prestop *TaskPrestop
+
 	// VolumeMounts is a list of Volume name <-> mount configurations that will be
 	// attached to this task.
 	VolumeMounts []*VolumeMount
@@ -7168,6 +7870,22 @@ func (t *Task) IsPoststop() bool {
 		t.Lifecycle.Hook == TaskLifecycleHookPoststop
 }
 
+// IsReadinessGated returns whether the main tasks wait for the checks of the
+// task to pass before starting.
+func (t *Task) IsReadinessGated() bool {
+	return t.IsPrestart() && t.Lifecycle.Sidecar && t.Lifecycle.ReadyChecks
+}
+
+// HasChecks returns whether any service of the task defines checks.
+func (t *Task) HasChecks() bool {
+	for _, s := range t.Services {
+		if len(s.Checks) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *Task) Copy() *Task {
 	if t == nil {
 		return nil
@@ -7195,6 +7913,7 @@ func (t *Task) Copy() *Task {
 	nt.Meta = maps.Clone(nt.Meta)
 	nt.DispatchPayload = nt.DispatchPayload.Copy()
 	nt.Lifecycle = nt.Lifecycle.Copy()
+	nt.Prestop = nt.Prestop.Copy()
 
 	if t.Artifacts != nil {
 		artifacts := make([]*TaskArtifact, 0, len(t.Artifacts))
@@ -7248,6 +7967,8 @@ func (t *Task) Canonicalize(job *Job, tg *TaskGroup) {
 
 	if t.RestartPolicy == nil {
 		t.RestartPolicy = tg.RestartPolicy
+	} else {
+		t.RestartPolicy.Canonicalize()
 	}
 
 	// Set the default timeout if it is not specified.
@@ -7385,7 +8106,15 @@ func (t *Task) Validate(ephemeralDisk *EphemeralDisk, jobType string, tgServices
 		if err := t.Lifecycle.Validate(); err != nil {
 			mErr.Errors = append(mErr.Errors, fmt.Errorf("Lifecycle validation failed: %v", err))
 		}
+		if t.Lifecycle.ReadyChecks && !t.HasChecks() {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("Lifecycle ready_checks requires the task to have service checks"))
+		}
+
+	}
 
+	// Validate the Prestop block if there
+	if err := t.Prestop.Validate(); err != nil {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("Prestop validation failed: %v", err))
 	}
 
 	// Validation for TaskKind field which is used for Consul Connect integration
@@ -8250,6 +8979,10 @@ const (
 	// TaskWaitingShuttingDownDelay indicates that the task is waiting for
 	// shutdown delay before being TaskKilled
 	TaskWaitingShuttingDownDelay = "Waiting for shutdown delay"
+
+	// TaskPrestopExecuted indicates that the prestop command of the task was
+	// executed before killing the task.
+	TaskPrestopExecuted = "Prestop Executed"
 )
 
 // TaskEvent is an event that effects the state of a task and contains meta-data
@@ -8419,6 +9152,9 @@ func (e *TaskEvent) PopulateEventDisplayMessage() {
 		desc = strings.Join(parts, ", ")
 	case TaskRestarting:
 		in := fmt.Sprintf("Task restarting in %v", time.Duration(e.StartDelay))
+		if fn := e.Details["delay_function"]; fn != "" {
+			in = fmt.Sprintf("%s (%s backoff, attempt %s)", in, fn, e.Details["restart_attempt"])
+		}
 		if e.RestartReason != "" && e.RestartReason != ReasonWithinPolicy {
 			desc = fmt.Sprintf("%s - %s", e.RestartReason, in)
 		} else {
@@ -8463,6 +9199,14 @@ func (e *TaskEvent) PopulateEventDisplayMessage() {
 		desc = "Main tasks in the group died"
 	case TaskClientReconnected:
 		desc = "Client reconnected"
+	case TaskPrestopExecuted:
+		if e.DriverError != "" {
+			desc = fmt.Sprintf("Prestop command failed: %s", e.DriverError)
+		} else if e.Message != "" {
+			desc = fmt.Sprintf("Prestop command exited with code %d: %s", e.ExitCode, e.Message)
+		} else {
+			desc = fmt.Sprintf("Prestop command exited with code %d", e.ExitCode)
+		}
 	default:
 		desc = e.Message
 	}
@@ -8576,6 +9320,14 @@ func (e *TaskEvent) SetRestartDelay(delay time.Duration) *TaskEvent {
 	return e
 }
 
+// SetRestartBackoff records the restart attempt within the restart interval
+// and the delay function used to back off the restart.
+func (e *TaskEvent) SetRestartBackoff(attempt int, delayFunction string) *TaskEvent {
+	e.Details["restart_attempt"] = fmt.Sprintf("%d", attempt)
+	e.Details["delay_function"] = delayFunction
+	return e
+}
+
 func (e *TaskEvent) SetRestartReason(reason string) *TaskEvent {
 	e.RestartReason = reason
 	e.Details["restart_reason"] = reason
@@ -9028,6 +9780,17 @@ type Spread struct {
 	// SpreadTarget is used to describe desired percentages for each attribute value
 	SpreadTarget []*SpreadTarget
 
+	// MaxSkew switches the spread to topology spreading. Allocations of the
+	// task group are spread across the distinct values of the attribute so
+	// that the difference between the most and least used values does not
+	// exceed MaxSkew. It can't be combined with SpreadTarget.
+	MaxSkew int
+
+	// Required makes a topology spread a hard constraint. Nodes where a
+	// placement would exceed MaxSkew are not considered, instead of being
+	// penalized.
+	Required bool
+
 	// Memoized string representation
 	str string
 }
@@ -9072,7 +9835,11 @@ func (s *Spread) String() string {
 	if s.str != "" {
 		return s.str
 	}
-	s.str = fmt.Sprintf("%s %s %v", s.Attribute, s.SpreadTarget, s.Weight)
+	if s.MaxSkew > 0 {
+		s.str = fmt.Sprintf("%s max_skew=%d %v", s.Attribute, s.MaxSkew, s.Weight)
+	} else {
+		s.str = fmt.Sprintf("%s %s %v", s.Attribute, s.SpreadTarget, s.Weight)
+	}
 	return s.str
 }
 
@@ -9084,6 +9851,15 @@ func (s *Spread) Validate() error {
 	if s.Weight <= 0 || s.Weight > 100 {
 		mErr.Errors = append(mErr.Errors, errors.New("Spread stanza must have a positive weight from 0 to 100"))
 	}
+	if s.MaxSkew < 0 {
+		mErr.Errors = append(mErr.Errors, errors.New("Spread max skew must not be negative"))
+	}
+	if s.MaxSkew > 0 && len(s.SpreadTarget) > 0 {
+		mErr.Errors = append(mErr.Errors, errors.New("Spread max skew can't be combined with spread targets"))
+	}
+	if s.Required && s.MaxSkew == 0 {
+		mErr.Errors = append(mErr.Errors, errors.New("Required spread must set a max skew"))
+	}
 	seen := make(map[string]struct{})
 	sumPercent := uint32(0)
 
@@ -9313,6 +10089,12 @@ func DeploymentStatusDescriptionRollbackNoop(baseDescription string, jobVersion
 	return fmt.Sprintf("%s - not rolling back to stable job version %d as current job has same specification", baseDescription, jobVersion)
 }
 
+// DeploymentStatusDescriptionFailedAnalysis is used to get the status
+// description of a deployment whose canaries failed the canary analysis.
+func DeploymentStatusDescriptionFailedAnalysis(reason string) string {
+	return fmt.Sprintf("Failed due to canary analysis: %s", reason)
+}
+
 // DeploymentStatusDescriptionNoRollbackTarget is used to get the status description of
 // a deployment when there is no target to rollback to but autorevert is desired.
 func DeploymentStatusDescriptionNoRollbackTarget(baseDescription string) string {
@@ -9525,6 +10307,41 @@ type DeploymentState struct {
 
 	// UnhealthyAllocs are allocations that have been marked as unhealthy.
 	UnhealthyAllocs int
+
+	// Stages are the stages of a staged rollout, copied from the TaskGroup
+	// UpdateStrategy in scheduler.reconcile
+	Stages []*UpdateStage
+
+	// Stage is the index of the current stage of a staged rollout. It is
+	// only meaningful once the staged rollout has started.
+	Stage int
+}
+
+// StagedRollout returns whether the group is being rolled out in stages and
+// there are no canaries left to promote.
+func (d *DeploymentState) StagedRollout() bool {
+	return len(d.Stages) > 0 && (d.DesiredCanaries == 0 || d.Promoted)
+}
+
+// HasNextStage returns whether the staged rollout has stages after the
+// current one.
+func (d *DeploymentState) HasNextStage() bool {
+	return d.Stage < len(d.Stages)-1
+}
+
+// StageAllowance returns the number of allocations of the deployment allowed
+// by the current stage of a staged rollout.
+func (d *DeploymentState) StageAllowance() int {
+	if d.Stage >= len(d.Stages) {
+		return d.DesiredTotal
+	}
+	return (d.DesiredTotal*d.Stages[d.Stage].Percent + 99) / 100
+}
+
+// StageHealthy returns whether every allocation allowed by the current stage
+// of a staged rollout is healthy.
+func (d *DeploymentState) StageHealthy() bool {
+	return d.HealthyAllocs >= d.StageAllowance()
 }
 
 func (d *DeploymentState) GoString() string {
@@ -9537,6 +10354,9 @@ func (d *DeploymentState) GoString() string {
 	base += fmt.Sprintf("\n\tUnhealthy: %d", d.UnhealthyAllocs)
 	base += fmt.Sprintf("\n\tAutoRevert: %v", d.AutoRevert)
 	base += fmt.Sprintf("\n\tAutoPromote: %v", d.AutoPromote)
+	if len(d.Stages) > 0 {
+		base += fmt.Sprintf("\n\tStage: %d/%d", d.Stage+1, len(d.Stages))
+	}
 	return base
 }
 
@@ -9544,6 +10364,7 @@ func (d *DeploymentState) Copy() *DeploymentState {
 	c := &DeploymentState{}
 	*c = *d
 	c.PlacedCanaries = slices.Clone(d.PlacedCanaries)
+	c.Stages = CopySliceUpdateStages(d.Stages)
 	return c
 }
 
@@ -9633,6 +10454,12 @@ type DesiredTransition struct {
 	// task shutdown_delay configuration and ignore the delay for any
 	// allocations stopped as a result of this Deregister call.
 	NoShutdownDelay *bool
+
+	// MigrateTargetNodeID is the node the replacement of an allocation
+	// marked for migration should be placed on. It is set by the rebalancer
+	// and the scheduler falls back to any feasible node if the target can
+	// no longer run the replacement.
+	MigrateTargetNodeID string
 }
 
 // Merge merges the two desired transitions, preferring the values from the
@@ -9653,6 +10480,10 @@ func (d *DesiredTransition) Merge(o *DesiredTransition) {
 	if o.NoShutdownDelay != nil {
 		d.NoShutdownDelay = o.NoShutdownDelay
 	}
+
+	if o.MigrateTargetNodeID != "" {
+		d.MigrateTargetNodeID = o.MigrateTargetNodeID
+	}
 }
 
 // ShouldMigrate returns whether the transition object dictates a migration.
@@ -9885,6 +10716,15 @@ func (a *Allocation) Index() uint {
 	return uint(num)
 }
 
+// ArrayIndex returns the index of the array instance the allocation belongs
+// to, or -1 if the allocation's job is not an array dispatched job.
+func (a *Allocation) ArrayIndex() int {
+	if a.Job == nil || a.Job.ArrayCount <= 0 {
+		return -1
+	}
+	return int(a.Index()) % a.Job.ArrayCount
+}
+
 // Copy provides a copy of the allocation and deep copies the job
 func (a *Allocation) Copy() *Allocation {
 	return a.copyImpl(true)
@@ -10020,6 +10860,9 @@ func (a *Allocation) RescheduleEligible(reschedulePolicy *ReschedulePolicy, fail
 	if !enabled {
 		return false
 	}
+	if !a.exitReschedulable(reschedulePolicy) {
+		return false
+	}
 	if reschedulePolicy.Unlimited {
 		return true
 	}
@@ -10113,9 +10956,54 @@ func (a *Allocation) nextRescheduleTime(failTime time.Time, reschedulePolicy *Re
 		attempted, attempts := a.rescheduleInfo(reschedulePolicy, failTime)
 		rescheduleEligible = attempted < attempts && nextDelay < reschedulePolicy.Interval
 	}
+	if !a.exitReschedulable(reschedulePolicy) {
+		rescheduleEligible = false
+	}
 	return nextRescheduleTime, rescheduleEligible
 }
 
+// exitReschedulable returns false if the exit of a failed task of the
+// allocation is classified by the reschedule policy as one that should not
+// be retried.
+func (a *Allocation) exitReschedulable(reschedulePolicy *ReschedulePolicy) bool {
+	if len(reschedulePolicy.OnExit) == 0 {
+		return true
+	}
+	exitCode, signal, ok := a.FailedTaskExit()
+	if !ok {
+		return true
+	}
+	switch reschedulePolicy.OnExit.Action(exitCode, signal) {
+	case ExitActionFail, ExitActionSuccess:
+		return false
+	default:
+		return true
+	}
+}
+
+// FailedTaskExit returns the exit code and signal of the last exit of the
+// first failed task of the allocation, ordered by task name. If no failed
+// task has exited, ok is false.
+func (a *Allocation) FailedTaskExit() (exitCode, signal int, ok bool) {
+	names := make([]string, 0, len(a.TaskStates))
+	for name, state := range a.TaskStates {
+		if state.Failed {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		events := a.TaskStates[name].Events
+		for i := len(events) - 1; i >= 0; i-- {
+			if events[i].Type == TaskTerminated {
+				return events[i].ExitCode, events[i].Signal, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
 // NextRescheduleTimeByFailTime works like NextRescheduleTime but allows callers
 // specify a failure time. Useful for things like determining whether to reschedule
 // an alloc on a disconnected node.
@@ -10678,6 +11566,43 @@ type AllocMetric struct {
 	// This is to prevent creating many failed allocations for a
 	// single task group.
 	CoalescedFailures int
+
+	// NodeExplanations explains for every node considered why it was
+	// filtered or how it was scored. It is keyed by node ID and only set
+	// when explaining the placement was requested.
+	NodeExplanations map[string]*NodeExplanation
+
+	// explain toggles recording NodeExplanations
+	explain bool
+}
+
+// NodeExplanation explains why a node was filtered or how it was scored
+// during a placement.
+type NodeExplanation struct {
+	NodeID   string
+	NodeName string
+
+	// Filtered is the constraint or reason the node was filtered for
+	Filtered string
+
+	// Exhausted is the resource dimension the node was exhausted on
+	Exhausted string
+
+	// Scores is the score of the node from each scorer of the rank stack
+	Scores map[string]float64
+
+	// NormScore is the final normalized score of the node
+	NormScore float64
+}
+
+func (e *NodeExplanation) Copy() *NodeExplanation {
+	if e == nil {
+		return nil
+	}
+	ne := new(NodeExplanation)
+	*ne = *e
+	ne.Scores = maps.Clone(e.Scores)
+	return ne
 }
 
 func (a *AllocMetric) Copy() *AllocMetric {
@@ -10694,9 +11619,56 @@ func (a *AllocMetric) Copy() *AllocMetric {
 	na.QuotaExhausted = slices.Clone(na.QuotaExhausted)
 	na.Scores = maps.Clone(na.Scores)
 	na.ScoreMetaData = CopySliceNodeScoreMeta(na.ScoreMetaData)
+	if a.NodeExplanations != nil {
+		na.NodeExplanations = make(map[string]*NodeExplanation, len(a.NodeExplanations))
+		for id, e := range a.NodeExplanations {
+			na.NodeExplanations[id] = e.Copy()
+		}
+	}
 	return na
 }
 
+// Explain enables recording an explanation for every node considered.
+func (a *AllocMetric) Explain() {
+	a.explain = true
+	if a.NodeExplanations == nil {
+		a.NodeExplanations = make(map[string]*NodeExplanation)
+	}
+}
+
+// MergeNodeExplanations copies the node explanations of other into the
+// metrics. It is used when the nodes of a placement are evaluated one at a
+// time, such as for system jobs.
+func (a *AllocMetric) MergeNodeExplanations(other *AllocMetric) {
+	if other == nil || len(other.NodeExplanations) == 0 {
+		return
+	}
+	if a.NodeExplanations == nil {
+		a.NodeExplanations = make(map[string]*NodeExplanation, len(other.NodeExplanations))
+	}
+	for id, e := range other.NodeExplanations {
+		a.NodeExplanations[id] = e.Copy()
+	}
+}
+
+// nodeExplanation returns the explanation of the node, or nil if explaining
+// is not enabled.
+func (a *AllocMetric) nodeExplanation(node *Node) *NodeExplanation {
+	if !a.explain || node == nil {
+		return nil
+	}
+	e, ok := a.NodeExplanations[node.ID]
+	if !ok {
+		e = &NodeExplanation{
+			NodeID:   node.ID,
+			NodeName: node.Name,
+			Scores:   make(map[string]float64),
+		}
+		a.NodeExplanations[node.ID] = e
+	}
+	return e
+}
+
 func (a *AllocMetric) EvaluateNode() {
 	a.NodesEvaluated += 1
 }
@@ -10715,6 +11687,9 @@ func (a *AllocMetric) FilterNode(node *Node, constraint string) {
 		}
 		a.ConstraintFiltered[constraint] += 1
 	}
+	if e := a.nodeExplanation(node); e != nil {
+		e.Filtered = constraint
+	}
 }
 
 func (a *AllocMetric) ExhaustedNode(node *Node, dimension string) {
@@ -10731,6 +11706,9 @@ func (a *AllocMetric) ExhaustedNode(node *Node, dimension string) {
 		}
 		a.DimensionExhausted[dimension] += 1
 	}
+	if e := a.nodeExplanation(node); e != nil {
+		e.Exhausted = dimension
+	}
 }
 
 func (a *AllocMetric) ExhaustQuota(dimensions []string) {
@@ -10772,6 +11750,14 @@ func (a *AllocMetric) ExhaustResources(tg *TaskGroup) {
 
 // ScoreNode is used to gather top K scoring nodes in a heap
 func (a *AllocMetric) ScoreNode(node *Node, name string, score float64) {
+	if e := a.nodeExplanation(node); e != nil {
+		if name == NormScorerName {
+			e.NormScore = score
+		} else {
+			e.Scores[name] = score
+		}
+	}
+
 	// Create nodeScoreMeta lazily if its the first time or if its a new node
 	if a.nodeScoreMeta == nil || a.nodeScoreMeta.NodeID != node.ID {
 		a.nodeScoreMeta = &NodeScoreMeta{
@@ -10974,6 +11960,8 @@ const (
 	EvalTriggerScaling              = "job-scaling"
 	EvalTriggerMaxDisconnectTimeout = "max-disconnect-timeout"
 	EvalTriggerReconnect            = "reconnect"
+	EvalTriggerRebalance            = "rebalance"
+	EvalTriggerGroupDependency      = "group-dependency"
 )
 
 const (
@@ -11032,6 +12020,10 @@ const (
 	// active key
 	CoreJobVariablesRekey = "variables-rekey"
 
+	// CoreJobRebalance is used to periodically migrate allocations to reduce
+	// cluster fragmentation.
+	CoreJobRebalance = "rebalance"
+
 	// CoreJobForceGC is used to force garbage collection of all GCable objects.
 	CoreJobForceGC = "force-gc"
 )
@@ -11122,6 +12114,11 @@ type Evaluation struct {
 	// to determine the cause.
 	FailedTGAllocs map[string]*AllocMetric
 
+	// DeferredTGAllocs are task groups whose placements were deferred until
+	// the task groups they depend on reach the required condition, mapped
+	// to the reason they are waiting.
+	DeferredTGAllocs map[string]string
+
 	// ClassEligibility tracks computed node classes that have been explicitly
 	// marked as eligible or ineligible.
 	ClassEligibility map[string]bool
@@ -11138,6 +12135,11 @@ type Evaluation struct {
 	// during the evaluation. This should not be set during normal operations.
 	AnnotatePlan bool
 
+	// ExplainPlan triggers the scheduler to record why each node was
+	// filtered or how it was scored for every placement. This should not be
+	// set during normal operations.
+	ExplainPlan bool
+
 	// QueuedAllocations is the number of unplaced allocations at the time the
 	// evaluation was processed. The map is keyed by Task Group names.
 	QueuedAllocations map[string]int
@@ -11293,6 +12295,8 @@ func (e *Evaluation) Copy() *Evaluation {
 		ne.FailedTGAllocs = failedTGs
 	}
 
+	ne.DeferredTGAllocs = maps.Clone(e.DeferredTGAllocs)
+
 	// Copy queued allocations
 	if e.QueuedAllocations != nil {
 		queuedAllocations := make(map[string]int, len(e.QueuedAllocations))
@@ -11640,6 +12644,39 @@ func (p *Plan) PopUpdate(alloc *Allocation) {
 	}
 }
 
+// RemoveAlloc removes the alloc from the plan allocations.
+func (p *Plan) RemoveAlloc(alloc *Allocation) {
+	removePlanAlloc(p.NodeAllocation, alloc)
+}
+
+// RemoveUpdate removes the alloc from the plan stopped allocations.
+func (p *Plan) RemoveUpdate(alloc *Allocation) {
+	removePlanAlloc(p.NodeUpdate, alloc)
+}
+
+// RemovePreemptedAlloc removes the alloc from the plan preempted allocations.
+func (p *Plan) RemovePreemptedAlloc(alloc *Allocation) {
+	removePlanAlloc(p.NodePreemptions, alloc)
+}
+
+// removePlanAlloc removes the allocation with the same ID as alloc from the
+// per-node allocations of a plan.
+func removePlanAlloc(allocsByNode map[string][]*Allocation, alloc *Allocation) {
+	existing := allocsByNode[alloc.NodeID]
+	for i, a := range existing {
+		if a.ID != alloc.ID {
+			continue
+		}
+		existing = append(existing[:i], existing[i+1:]...)
+		if len(existing) > 0 {
+			allocsByNode[alloc.NodeID] = existing
+		} else {
+			delete(allocsByNode, alloc.NodeID)
+		}
+		return
+	}
+}
+
 // AppendAlloc appends the alloc to the plan allocations.
 // Uses the passed job if explicitly passed, otherwise
 // it is assumed the alloc will use the plan Job version.
@@ -11753,6 +12790,10 @@ type PlanAnnotations struct {
 
 	// PreemptedAllocs is the set of allocations to be preempted to make the placement successful.
 	PreemptedAllocs []*AllocListStub
+
+	// SchedulerAlgorithms is the effective scheduler algorithm used to place
+	// each task group.
+	SchedulerAlgorithms map[string]SchedulerAlgorithm
 }
 
 // DesiredUpdates is the set of changes the scheduler would like to make given
@@ -1319,6 +1319,17 @@ func TestTaskGroup_Validate(t *testing.T) {
 	err = tg.Validate(&Job{})
 	expected = "Multiple service providers used: task group services must use the same provider"
 	require.Contains(t, err.Error(), expected)
+
+	tg = &TaskGroup{
+		Name:  "group-a",
+		Gang:  true,
+		Tasks: []*Task{{Name: "task-a"}},
+	}
+	err = tg.Validate(&Job{Type: JobTypeSysBatch})
+	require.Contains(t, err.Error(), "gang scheduling is not supported for sysbatch jobs")
+
+	err = tg.Validate(&Job{Type: JobTypeBatch})
+	require.NotContains(t, err.Error(), "gang scheduling")
 }
 
 func TestTaskGroupNetwork_Validate(t *testing.T) {
@@ -1711,6 +1722,32 @@ func TestTask_Validate_Resources(t *testing.T) {
 			},
 			err: "MemoryMaxMB value (10) should be larger than MemoryMB value (200",
 		},
+		{
+			name: "numa with cores",
+			res: &Resources{
+				Cores:    2,
+				MemoryMB: 200,
+				NUMA:     &NUMA{Affinity: NUMAAffinityRequire},
+			},
+		},
+		{
+			name: "numa without cores",
+			res: &Resources{
+				CPU:      100,
+				MemoryMB: 200,
+				NUMA:     &NUMA{Affinity: NUMAAffinityPrefer},
+			},
+			err: "Task can only set 'numa' when asking for 'cores'.",
+		},
+		{
+			name: "invalid numa affinity",
+			res: &Resources{
+				Cores:    2,
+				MemoryMB: 200,
+				NUMA:     &NUMA{Affinity: "maybe"},
+			},
+			err: `invalid NUMA affinity "maybe"`,
+		},
 	}
 
 	for i := range cases {
@@ -3079,6 +3116,126 @@ func TestUpdateStrategy_Validate(t *testing.T) {
 	)
 }
 
+func TestUpdateStrategy_Validate_Stages(t *testing.T) {
+	ci.Parallel(t)
+
+	u := DefaultUpdateStrategy.Copy()
+	u.Stages = []*UpdateStage{
+		{Percent: 10, Pause: time.Minute},
+		{Percent: 50},
+		{Percent: 100},
+	}
+	require.NoError(t, u.Validate())
+
+	u.Stages = []*UpdateStage{
+		{Percent: 0},
+		{Percent: 50, Pause: -time.Second},
+		{Percent: 20},
+	}
+	err := u.Validate()
+	requireErrors(t, err,
+		"Stage 1 percent must be between 1 and 100",
+		"Stage 2 pause may not be less than zero",
+		"Stage 3 percent must be greater than the previous stage",
+		"Last stage percent must be 100",
+	)
+}
+
+func TestUpdateStrategy_Validate_Analysis(t *testing.T) {
+	ci.Parallel(t)
+
+	u := DefaultUpdateStrategy.Copy()
+	u.Canary = 1
+	u.Analysis = &CanaryAnalysis{
+		MaxMemoryRatio: 1.5,
+		MaxRestarts:    1,
+		MetricsURL:     "https://metrics.example.com/query",
+	}
+	require.NoError(t, u.Validate())
+
+	u.Canary = 0
+	u.Analysis = &CanaryAnalysis{
+		MaxMemoryRatio: -1,
+		MaxCPURatio:    -1,
+		MaxRestarts:    -1,
+		MetricsURL:     "metrics.example.com",
+	}
+	err := u.Validate()
+	requireErrors(t, err,
+		"Canary analysis requires a Canary count greater than zero",
+		"Analysis max memory ratio may not be less than zero",
+		"Analysis max CPU ratio may not be less than zero",
+		"Analysis max restarts may not be less than zero",
+		"Analysis metrics URL must be an absolute http or https URL",
+	)
+}
+
+func TestUpdateStrategy_Validate_CanaryPercent(t *testing.T) {
+	ci.Parallel(t)
+
+	u := DefaultUpdateStrategy.Copy()
+	u.CanaryPercent = 10
+	u.AutoPromote = true
+	require.NoError(t, u.Validate())
+
+	u.Canary = 1
+	u.CanaryPercent = 101
+	err := u.Validate()
+	requireErrors(t, err,
+		"Canary percent must be between 0 and 100",
+		"Canary count and canary percent can not both be set",
+	)
+
+	// Canary percent is only allowed for system jobs
+	j := testJob()
+	j.TaskGroups[0].Update = DefaultUpdateStrategy.Copy()
+	j.TaskGroups[0].Update.CanaryPercent = 10
+	err = j.Validate()
+	requireErrors(t, err, `Job type "service" does not allow canary_percent`)
+}
+
+func TestUpdateStrategy_Validate_MaxSurge(t *testing.T) {
+	ci.Parallel(t)
+
+	u := DefaultUpdateStrategy.Copy()
+	u.MaxSurge = -1
+	requireErrors(t, u.Validate(), "Max surge can not be less than zero")
+
+	// Max surge is only allowed for service jobs
+	j := testJob()
+	j.Type = JobTypeSystem
+	j.TaskGroups[0].Update = DefaultUpdateStrategy.Copy()
+	j.TaskGroups[0].Update.MaxSurge = 1
+	requireErrors(t, j.Validate(), `Job type "system" does not allow max_surge`)
+}
+
+func TestDeploymentState_StageAllowance(t *testing.T) {
+	ci.Parallel(t)
+
+	d := &DeploymentState{
+		DesiredTotal:    10,
+		DesiredCanaries: 1,
+		Stages: []*UpdateStage{
+			{Percent: 25},
+			{Percent: 100},
+		},
+	}
+	require.False(t, d.StagedRollout())
+
+	d.Promoted = true
+	require.True(t, d.StagedRollout())
+	require.True(t, d.HasNextStage())
+	require.Equal(t, 3, d.StageAllowance())
+
+	d.HealthyAllocs = 3
+	require.True(t, d.StageHealthy())
+
+	d.Stage++
+	require.False(t, d.HasNextStage())
+	require.Equal(t, 10, d.StageAllowance())
+	require.False(t, d.StageHealthy())
+}
+
 func TestResource_NetIndex(t *testing.T) {
 	ci.Parallel(t)
 
@@ -3816,6 +3973,96 @@ func TestPeriodicConfig_NextCron(t *testing.T) {
 	}
 }
 
+func TestPeriodicConfig_NextCrons(t *testing.T) {
+	ci.Parallel(t)
+
+	from := time.Date(2009, time.November, 10, 23, 22, 30, 0, time.UTC)
+
+	// The earliest upcoming time of any spec is returned
+	p := &PeriodicConfig{
+		Enabled:  true,
+		SpecType: PeriodicSpecCron,
+		Specs:    []string{"0 0 29 2 * 1980", "*/10 * * * *", "*/5 * * * *"},
+	}
+	p.Canonicalize()
+	n, err := p.Next(from)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2009, time.November, 10, 23, 25, 0, 0, time.UTC), n)
+
+	// No spec matching in the future returns the zero time
+	p.Specs = []string{"0 0 29 2 * 1980"}
+	n, err = p.Next(from)
+	require.NoError(t, err)
+	require.True(t, n.IsZero())
+
+	p.Specs = []string{"*/5 * * * *", "1 15-0 *"}
+	_, err = p.Next(from)
+	require.ErrorContains(t, err, "failed parsing cron expression")
+}
+
+func TestPeriodicConfig_Validate_Crons(t *testing.T) {
+	ci.Parallel(t)
+
+	p := &PeriodicConfig{
+		Enabled:       true,
+		SpecType:      PeriodicSpecCron,
+		Specs:         []string{"@hourly", "0 0-15 * * *"},
+		CatchupWindow: time.Hour,
+		Jitter:        time.Minute,
+	}
+	p.Canonicalize()
+	require.NoError(t, p.Validate())
+
+	p.Spec = "@daily"
+	p.Specs = []string{"@hourly", "1 15-0 *"}
+	p.CatchupWindow = -1
+	p.Jitter = -1
+	requireErrors(t, p.Validate(),
+		"Only one of cron or crons may be specified",
+		`Invalid cron spec "1 15-0 *"`,
+		"Catchup window can not be less than zero",
+		"Jitter can not be less than zero",
+	)
+}
+
+func TestPeriodicConfig_Concurrency(t *testing.T) {
+	ci.Parallel(t)
+
+	p := &PeriodicConfig{Enabled: true, SpecType: PeriodicSpecCron, Spec: "@hourly"}
+	p.Canonicalize()
+	require.NoError(t, p.Validate())
+	require.Equal(t, PeriodicConcurrencyAllow, p.Concurrency())
+
+	// The policy is derived from prohibit_overlap when unset
+	p.ProhibitOverlap = true
+	require.NoError(t, p.Validate())
+	require.Equal(t, PeriodicConcurrencyForbid, p.Concurrency())
+
+	p.ConcurrencyPolicy = PeriodicConcurrencyForbid
+	require.NoError(t, p.Validate())
+
+	p.ConcurrencyPolicy = PeriodicConcurrencyReplace
+	p.SuccessfulHistoryLimit = -1
+	p.FailedHistoryLimit = -1
+	requireErrors(t, p.Validate(),
+		`Prohibit overlap can not be used with concurrency policy "replace"`,
+		"Successful history limit can not be less than zero",
+		"Failed history limit can not be less than zero",
+	)
+
+	p.ProhibitOverlap = false
+	p.ConcurrencyPolicy = "queue"
+	p.SuccessfulHistoryLimit = 3
+	p.FailedHistoryLimit = 1
+	requireErrors(t, p.Validate(), `Unknown concurrency policy "queue"`)
+
+	p.ConcurrencyPolicy = PeriodicConcurrencyReplace
+	require.NoError(t, p.Validate())
+	require.Equal(t, PeriodicConcurrencyReplace, p.Concurrency())
+	require.Equal(t, 3, p.HistoryLimit(false))
+	require.Equal(t, 1, p.HistoryLimit(true))
+}
+
 func TestPeriodicConfig_ValidTimeZone(t *testing.T) {
 	ci.Parallel(t)
 
@@ -3891,6 +4138,34 @@ func TestTaskLifecycleConfig_Validate(t *testing.T) {
 			},
 			err: fmt.Errorf("no lifecycle hook provided"),
 		},
+		{
+			name: "prestart sidecar ready checks",
+			tlc: &TaskLifecycleConfig{
+				Hook:         "prestart",
+				Sidecar:      true,
+				ReadyChecks:  true,
+				ReadyTimeout: time.Minute,
+			},
+			err: nil,
+		},
+		{
+			name: "ready checks not sidecar",
+			tlc: &TaskLifecycleConfig{
+				Hook:        "prestart",
+				ReadyChecks: true,
+			},
+			err: fmt.Errorf("ready_checks is only supported by prestart sidecar tasks"),
+		},
+		{
+			name: "negative ready timeout",
+			tlc: &TaskLifecycleConfig{
+				Hook:         "prestart",
+				Sidecar:      true,
+				ReadyChecks:  true,
+				ReadyTimeout: -time.Second,
+			},
+			err: fmt.Errorf("ready_timeout must be greater than or equal to 0"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -3907,6 +4182,23 @@ func TestTaskLifecycleConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestTaskPrestop_Validate(t *testing.T) {
+	ci.Parallel(t)
+
+	p := &TaskPrestop{
+		Command: "nginx",
+		Args:    []string{"-s", "quit"},
+		Timeout: 30 * time.Second,
+	}
+	require.NoError(t, p.Validate())
+
+	p = &TaskPrestop{}
+	err := p.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing prestop command")
+	require.Contains(t, err.Error(), "prestop timeout must be greater than zero")
+}
+
 func TestRestartPolicy_Validate(t *testing.T) {
 	ci.Parallel(t)
 
@@ -3961,6 +4253,137 @@ func TestRestartPolicy_Validate(t *testing.T) {
 	if err := p.Validate(); err == nil || !strings.Contains(err.Error(), "Interval can not be less than") {
 		t.Fatalf("expect interval too small error, got: %v", err)
 	}
+
+	// Bad delay function fails
+	p = &RestartPolicy{
+		Mode:          RestartPolicyModeFail,
+		Attempts:      1,
+		Interval:      5 * time.Second,
+		DelayFunction: "nope",
+	}
+	if err := p.Validate(); err == nil || !strings.Contains(err.Error(), "Invalid delay function") {
+		t.Fatalf("expect delay function error, got: %v", err)
+	}
+
+	// Fails when max delay is less than delay with a progressive delay function
+	p = &RestartPolicy{
+		Mode:          RestartPolicyModeFail,
+		Attempts:      1,
+		Delay:         2 * time.Second,
+		Interval:      5 * time.Second,
+		DelayFunction: "exponential",
+		MaxDelay:      1 * time.Second,
+	}
+	if err := p.Validate(); err == nil || !strings.Contains(err.Error(), "Max Delay cannot be less than Delay") {
+		t.Fatalf("expect max delay error, got: %v", err)
+	}
+}
+
+func TestRestartPolicy_Canonicalize(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name     string
+		policy   *RestartPolicy
+		expected time.Duration
+	}{
+		{
+			name: "constant",
+			policy: &RestartPolicy{
+				Delay:         15 * time.Second,
+				DelayFunction: "constant",
+				Interval:      time.Minute,
+			},
+			expected: 0,
+		},
+		{
+			name: "exponential defaults to interval",
+			policy: &RestartPolicy{
+				Delay:         15 * time.Second,
+				DelayFunction: "exponential",
+				Interval:      time.Minute,
+			},
+			expected: time.Minute,
+		},
+		{
+			name: "fibonacci defaults to longer delay",
+			policy: &RestartPolicy{
+				Delay:         2 * time.Minute,
+				DelayFunction: "fibonacci",
+				Interval:      time.Minute,
+				Mode:          RestartPolicyModeFail,
+			},
+			expected: 2 * time.Minute,
+		},
+		{
+			name: "max delay set",
+			policy: &RestartPolicy{
+				Delay:         15 * time.Second,
+				DelayFunction: "exponential",
+				Interval:      time.Minute,
+				MaxDelay:      30 * time.Second,
+			},
+			expected: 30 * time.Second,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.policy.Canonicalize()
+			require.Equal(t, tc.expected, tc.policy.MaxDelay)
+		})
+	}
+
+	// A canonicalized job with an increasing delay passes validation
+	job := testJob()
+	job.TaskGroups[0].RestartPolicy = &RestartPolicy{
+		Attempts:      2,
+		Delay:         15 * time.Second,
+		DelayFunction: "exponential",
+		Interval:      time.Minute,
+		Mode:          RestartPolicyModeFail,
+	}
+	job.Canonicalize()
+	require.NoError(t, job.TaskGroups[0].RestartPolicy.Validate())
+}
+
+func TestRestartPolicy_BackoffDelay(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		delayFunction string
+		expected      []time.Duration
+	}{
+		{
+			delayFunction: "",
+			expected:      []time.Duration{1, 1, 1, 1, 1, 1},
+		},
+		{
+			delayFunction: "constant",
+			expected:      []time.Duration{1, 1, 1, 1, 1, 1},
+		},
+		{
+			delayFunction: "exponential",
+			expected:      []time.Duration{1, 2, 4, 8, 10, 10},
+		},
+		{
+			delayFunction: "fibonacci",
+			expected:      []time.Duration{1, 1, 2, 3, 5, 8, 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.delayFunction, func(t *testing.T) {
+			p := &RestartPolicy{
+				Delay:         time.Second,
+				DelayFunction: tc.delayFunction,
+				MaxDelay:      10 * time.Second,
+			}
+			for i, expected := range tc.expected {
+				require.Equal(t, expected*time.Second, p.BackoffDelay(i+1), "attempt %d", i+1)
+			}
+		})
+	}
 }
 
 func TestReschedulePolicy_Validate(t *testing.T) {
@@ -4477,6 +4900,36 @@ func TestPlan_NormalizeAllocations(t *testing.T) {
 	assert.Equal(t, expectedPreemptedAlloc, actualPreemptedAlloc)
 }
 
+func TestPlan_RemoveAlloc(t *testing.T) {
+	ci.Parallel(t)
+
+	plan := &Plan{
+		NodeAllocation:  make(map[string][]*Allocation),
+		NodeUpdate:      make(map[string][]*Allocation),
+		NodePreemptions: make(map[string][]*Allocation),
+	}
+
+	alloc1 := MockAlloc()
+	alloc2 := MockAlloc()
+	alloc2.NodeID = alloc1.NodeID
+	plan.AppendAlloc(alloc1, nil)
+	plan.AppendAlloc(alloc2, nil)
+	plan.AppendStoppedAlloc(alloc1, "", "", "")
+	plan.AppendPreemptedAlloc(alloc2, alloc1.ID)
+
+	plan.RemoveAlloc(alloc1)
+	require.Equal(t, []*Allocation{alloc2}, plan.NodeAllocation[alloc1.NodeID])
+
+	plan.RemoveAlloc(alloc2)
+	require.NotContains(t, plan.NodeAllocation, alloc1.NodeID)
+
+	plan.RemoveUpdate(alloc1)
+	require.Empty(t, plan.NodeUpdate)
+
+	plan.RemovePreemptedAlloc(alloc2)
+	require.Empty(t, plan.NodePreemptions)
+}
+
 func TestPlan_AppendStoppedAllocAppendsAllocWithUpdatedAttrs(t *testing.T) {
 	ci.Parallel(t)
 	plan := &Plan{
@@ -6141,6 +6594,7 @@ func TestTaskEventPopulate(t *testing.T) {
 		{NewTaskEvent(TaskArtifactDownloadFailed).SetDownloadError(fmt.Errorf("connection reset by peer")), "connection reset by peer"},
 		{NewTaskEvent(TaskRestarting).SetRestartDelay(2 * time.Second).SetRestartReason(ReasonWithinPolicy), "Task restarting in 2s"},
 		{NewTaskEvent(TaskRestarting).SetRestartReason("Chaos Monkey did it"), "Chaos Monkey did it - Task restarting in 0s"},
+		{NewTaskEvent(TaskRestarting).SetRestartDelay(4 * time.Second).SetRestartReason(ReasonWithinPolicy).SetRestartBackoff(3, "exponential"), "Task restarting in 4s (exponential backoff, attempt 3)"},
 		{NewTaskEvent(TaskKilling), "Sent interrupt"},
 		{NewTaskEvent(TaskKilling).SetKillReason("Its time for you to die"), "Its time for you to die"},
 		{NewTaskEvent(TaskKilling).SetKillTimeout(1*time.Second, 5*time.Second), "Sent interrupt. Waiting 1s before force killing"},
@@ -6619,6 +7073,49 @@ func TestSpread_Validate(t *testing.T) {
 			err:  nil,
 			name: "Valid spread",
 		},
+		{
+			spread: &Spread{
+				Attribute: "${meta.rack}",
+				Weight:    50,
+				MaxSkew:   1,
+				SpreadTarget: []*SpreadTarget{
+					{
+						Value:   "r1",
+						Percent: 50,
+					},
+				},
+			},
+			err:  fmt.Errorf("Spread max skew can't be combined with spread targets"),
+			name: "Max skew with targets",
+		},
+		{
+			spread: &Spread{
+				Attribute: "${meta.rack}",
+				Weight:    50,
+				MaxSkew:   -1,
+			},
+			err:  fmt.Errorf("Spread max skew must not be negative"),
+			name: "Negative max skew",
+		},
+		{
+			spread: &Spread{
+				Attribute: "${meta.rack}",
+				Weight:    50,
+				Required:  true,
+			},
+			err:  fmt.Errorf("Required spread must set a max skew"),
+			name: "Required without max skew",
+		},
+		{
+			spread: &Spread{
+				Attribute: "${meta.rack}",
+				Weight:    50,
+				MaxSkew:   1,
+				Required:  true,
+			},
+			err:  nil,
+			name: "Valid topology spread",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -6634,6 +7131,54 @@ func TestSpread_Validate(t *testing.T) {
 	}
 }
 
+func TestAllocMetric_Explain(t *testing.T) {
+	ci.Parallel(t)
+
+	node1 := &Node{ID: "node1", Name: "one"}
+	node2 := &Node{ID: "node2", Name: "two"}
+	node3 := &Node{ID: "node3", Name: "three"}
+
+	// Nothing is recorded unless explaining is enabled
+	metric := new(AllocMetric)
+	metric.FilterNode(node1, "constraint")
+	metric.ScoreNode(node2, "binpack", 0.5)
+	require.Nil(t, metric.NodeExplanations)
+
+	metric = new(AllocMetric)
+	metric.Explain()
+	metric.FilterNode(node1, "${attr.kernel.name} = linux")
+	metric.ExhaustedNode(node2, "memory")
+	metric.ScoreNode(node3, "binpack", 0.5)
+	metric.ScoreNode(node3, "job-anti-affinity", -0.25)
+	metric.ScoreNode(node3, NormScorerName, 0.125)
+
+	require.Equal(t, map[string]*NodeExplanation{
+		"node1": {
+			NodeID:   "node1",
+			NodeName: "one",
+			Filtered: "${attr.kernel.name} = linux",
+			Scores:   map[string]float64{},
+		},
+		"node2": {
+			NodeID:    "node2",
+			NodeName:  "two",
+			Exhausted: "memory",
+			Scores:    map[string]float64{},
+		},
+		"node3": {
+			NodeID:    "node3",
+			NodeName:  "three",
+			Scores:    map[string]float64{"binpack": 0.5, "job-anti-affinity": -0.25},
+			NormScore: 0.125,
+		},
+	}, metric.NodeExplanations)
+
+	// Copies don't share explanations
+	copied := metric.Copy()
+	copied.NodeExplanations["node3"].Scores["binpack"] = 1
+	require.Equal(t, 0.5, metric.NodeExplanations["node3"].Scores["binpack"])
+}
+
 func TestNodeReservedNetworkResources_ParseReserved(t *testing.T) {
 	ci.Parallel(t)
 
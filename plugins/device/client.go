@@ -78,9 +78,14 @@ func (d *devicePluginClient) handleFingerprint(
 }
 
 func (d *devicePluginClient) Reserve(deviceIDs []string) (*ContainerReservation, error) {
+	return d.ReserveShare(deviceIDs, 0)
+}
+
+func (d *devicePluginClient) ReserveShare(deviceIDs []string, share uint64) (*ContainerReservation, error) {
 	// Build the request
 	req := &proto.ReserveRequest{
 		DeviceIds: deviceIDs,
+		Share:     share,
 	}
 
 	// Make the request
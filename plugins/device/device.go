@@ -37,6 +37,17 @@ type DevicePlugin interface {
 	Stats(ctx context.Context, interval time.Duration) (<-chan *StatsResponse, error)
 }
 
+// SharedDevicePlugin is implemented by device plugins that fingerprint
+// shareable devices, so that a share of a device can be reserved for a task.
+type SharedDevicePlugin interface {
+	DevicePlugin
+
+	// ReserveShare is used to reserve a share of the capacity of each of a
+	// set of devices and retrieve mount instructions. The plugin may use the
+	// share to configure the isolation of the task.
+	ReserveShare(deviceIDs []string, share uint64) (*ContainerReservation, error)
+}
+
 // FingerprintResponse includes a set of detected devices or an error in the
 // process of fingerprinting.
 type FingerprintResponse struct {
@@ -79,6 +90,11 @@ type DeviceGroup struct {
 
 	// Attributes are a set of attributes shared for all the devices.
 	Attributes map[string]*structs.Attribute
+
+	// Capacity marks the devices as shareable when set. It is the amount of
+	// each device, in a unit chosen by the plugin such as slots or MiB of
+	// memory, that can be split between tasks.
+	Capacity uint64
 }
 
 // Validate validates that the device group is valid
@@ -9,6 +9,7 @@ import (
 
 type FingerprintFn func(context.Context) (<-chan *FingerprintResponse, error)
 type ReserveFn func([]string) (*ContainerReservation, error)
+type ReserveShareFn func([]string, uint64) (*ContainerReservation, error)
 type StatsFn func(context.Context, time.Duration) (<-chan *StatsResponse, error)
 
 // MockDevicePlugin is used for testing.
@@ -16,9 +17,10 @@ type StatsFn func(context.Context, time.Duration) (<-chan *StatsResponse, error)
 // is passed through the base plugin layer.
 type MockDevicePlugin struct {
 	*base.MockPlugin
-	FingerprintF FingerprintFn
-	ReserveF     ReserveFn
-	StatsF       StatsFn
+	FingerprintF  FingerprintFn
+	ReserveF      ReserveFn
+	ReserveShareF ReserveShareFn
+	StatsF        StatsFn
 }
 
 func (p *MockDevicePlugin) Fingerprint(ctx context.Context) (<-chan *FingerprintResponse, error) {
@@ -29,6 +31,10 @@ func (p *MockDevicePlugin) Reserve(devices []string) (*ContainerReservation, err
 	return p.ReserveF(devices)
 }
 
+func (p *MockDevicePlugin) ReserveShare(devices []string, share uint64) (*ContainerReservation, error) {
+	return p.ReserveShareF(devices, share)
+}
+
 func (p *MockDevicePlugin) Stats(ctx context.Context, interval time.Duration) (<-chan *StatsResponse, error) {
 	return p.StatsF(ctx, interval)
 }
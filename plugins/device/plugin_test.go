@@ -208,9 +208,10 @@ func TestDevicePlugin_Fingerprint(t *testing.T) {
 			Name:   "foo",
 		},
 		{
-			Vendor: "nvidia",
-			Type:   DeviceTypeGPU,
-			Name:   "bar",
+			Vendor:   "nvidia",
+			Type:     DeviceTypeGPU,
+			Name:     "bar",
+			Capacity: 4,
 		},
 	}
 
@@ -464,6 +465,51 @@ func TestDevicePlugin_Reserve(t *testing.T) {
 	require.EqualValues(reservation, containerRes)
 }
 
+func TestDevicePlugin_ReserveShare(t *testing.T) {
+	ci.Parallel(t)
+	require := require.New(t)
+
+	reservation := &ContainerReservation{
+		Envs: map[string]string{
+			"foo": "bar",
+		},
+	}
+
+	var received []string
+	var receivedShare uint64
+	mock := &MockDevicePlugin{
+		ReserveShareF: func(devices []string, share uint64) (*ContainerReservation, error) {
+			received = devices
+			receivedShare = share
+			return reservation, nil
+		},
+	}
+
+	client, server := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		base.PluginTypeBase:   &base.PluginBase{Impl: mock},
+		base.PluginTypeDevice: &PluginDevice{Impl: mock},
+	})
+	defer server.Stop()
+	defer client.Close()
+
+	raw, err := client.Dispense(base.PluginTypeDevice)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	impl, ok := raw.(SharedDevicePlugin)
+	if !ok {
+		t.Fatalf("bad: %#v", raw)
+	}
+
+	req := []string{"a"}
+	containerRes, err := impl.ReserveShare(req, 4)
+	require.NoError(err)
+	require.EqualValues(req, received)
+	require.EqualValues(4, receivedShare)
+	require.EqualValues(reservation, containerRes)
+}
+
 func TestDevicePlugin_Stats(t *testing.T) {
 	ci.Parallel(t)
 	require := require.New(t)
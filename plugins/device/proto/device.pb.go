@@ -114,10 +114,13 @@ type DeviceGroup struct {
 	Devices []*DetectedDevice `protobuf:"bytes,4,rep,name=devices,proto3" json:"devices,omitempty"`
 	// attributes allows adding attributes to be used for constraints or
 	// affinities.
-	Attributes           map[string]*proto1.Attribute `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+	Attributes map[string]*proto1.Attribute `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// capacity marks the devices as shareable when set, and is the amount of
+	// each device, such as slots or memory, that can be split between tasks.
+	Capacity             uint64   `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DeviceGroup) Reset()         { *m = DeviceGroup{} }
@@ -180,6 +183,13 @@ func (m *DeviceGroup) GetAttributes() map[string]*proto1.Attribute {
 	return nil
 }
 
+func (m *DeviceGroup) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
 // DetectedDevice is a single detected device.
 type DetectedDevice struct {
 	// ID is the ID of the device. This ID is used during allocation and must be
@@ -298,7 +308,10 @@ func (m *DeviceLocality) GetPciBusId() string {
 // how to allocate the requested devices.
 type ReserveRequest struct {
 	// device_ids are the requested devices.
-	DeviceIds            []string `protobuf:"bytes,1,rep,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	DeviceIds []string `protobuf:"bytes,1,rep,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	// share is the amount of the capacity of each shareable device that is
+	// requested. It is zero when the devices are reserved whole.
+	Share                uint64   `protobuf:"varint,2,opt,name=share,proto3" json:"share,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -336,6 +349,13 @@ func (m *ReserveRequest) GetDeviceIds() []string {
 	return nil
 }
 
+func (m *ReserveRequest) GetShare() uint64 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
 // ReserveResponse informs Nomad how to expose the requested devices
 // to the the task.
 type ReserveResponse struct {
@@ -795,68 +815,69 @@ func init() {
 }
 
 var fileDescriptor_5edb0c35c07fa415 = []byte{
-	// 965 bytes of a gzipped FileDescriptorProto
+	// 988 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xef, 0x8e, 0xdb, 0x44,
-	0x10, 0x27, 0xc9, 0xe5, 0x92, 0x4c, 0xee, 0xae, 0x65, 0x7b, 0x42, 0xc6, 0x40, 0x7b, 0x58, 0x42,
-	0x3a, 0x41, 0xeb, 0x94, 0x14, 0x89, 0x0a, 0x04, 0x52, 0xdb, 0x94, 0x5e, 0xf8, 0xd3, 0xab, 0xb6,
-	0x15, 0x52, 0x8b, 0x84, 0xb5, 0x67, 0x2f, 0xf1, 0xb6, 0xf6, 0xda, 0xec, 0xae, 0x53, 0x99, 0x4f,
-	0x3c, 0x0e, 0x5f, 0x78, 0x01, 0x1e, 0x86, 0x0f, 0x3c, 0x09, 0xf2, 0xee, 0x3a, 0xf1, 0xfd, 0xe9,
-	0x25, 0x81, 0x4f, 0xde, 0x9d, 0x99, 0xdf, 0xcc, 0xec, 0xcc, 0x6f, 0x67, 0x0d, 0x1f, 0xe6, 0x49,
-	0x31, 0x63, 0x5c, 0x8e, 0x22, 0x3a, 0x67, 0x21, 0x1d, 0xe5, 0x22, 0x53, 0x99, 0xdd, 0xf8, 0x7a,
-	0x83, 0xae, 0xc7, 0x44, 0xc6, 0x2c, 0xcc, 0x44, 0xee, 0xf3, 0x2c, 0x25, 0x91, 0x6f, 0x21, 0xbe,
-	0xb1, 0x72, 0x6f, 0xcc, 0xb2, 0x6c, 0x96, 0x58, 0xe8, 0x49, 0xf1, 0xcb, 0x48, 0xb1, 0x94, 0x4a,
-	0x45, 0xd2, 0xdc, 0x38, 0x70, 0xaf, 0x9f, 0x35, 0x88, 0x0a, 0x41, 0x14, 0xcb, 0xb8, 0xd5, 0xdf,
-	0xac, 0x73, 0x90, 0x31, 0x11, 0x34, 0x1a, 0x49, 0x25, 0x8a, 0x50, 0x49, 0x9b, 0x0b, 0x51, 0x4a,
-	0xb0, 0x93, 0x42, 0xd9, 0x74, 0xdc, 0xc3, 0x4b, 0xad, 0xa5, 0x22, 0x4a, 0x1a, 0x4b, 0x6f, 0x1f,
-	0xd0, 0x37, 0x8c, 0xcf, 0xa8, 0xc8, 0x05, 0xe3, 0x0a, 0xd3, 0x5f, 0x0b, 0x2a, 0x95, 0x47, 0xe1,
-	0xda, 0x29, 0xa9, 0xcc, 0x33, 0x2e, 0x29, 0x7a, 0x0c, 0x3b, 0xe6, 0x3c, 0xc1, 0x4c, 0x64, 0x45,
-	0xee, 0xb4, 0x0e, 0x3a, 0x87, 0xc3, 0xf1, 0x27, 0xfe, 0xe5, 0x87, 0xf7, 0x27, 0xfa, 0xf3, 0xa8,
-	0x82, 0xe0, 0x61, 0xb4, 0xdc, 0x78, 0xbf, 0x77, 0x60, 0xd8, 0x50, 0xa2, 0x77, 0x60, 0x7b, 0x4e,
-	0x79, 0x94, 0x09, 0xa7, 0x75, 0xd0, 0x3a, 0x1c, 0x60, 0xbb, 0x43, 0x37, 0xc0, 0xc2, 0x02, 0x55,
-	0xe6, 0xd4, 0x69, 0x6b, 0x25, 0x18, 0xd1, 0xb3, 0x32, 0xa7, 0x0d, 0x03, 0x4e, 0x52, 0xea, 0x74,
-	0x9a, 0x06, 0x8f, 0x49, 0x4a, 0xd1, 0x11, 0xf4, 0xcc, 0x4e, 0x3a, 0x5b, 0x3a, 0x69, 0x7f, 0x75,
-	0xd2, 0x8a, 0x86, 0x8a, 0x46, 0x26, 0x3f, 0x5c, 0xc3, 0xd1, 0x4f, 0x00, 0x8b, 0x6a, 0x4b, 0xa7,
-	0xab, 0x9d, 0x7d, 0xb9, 0x41, 0x05, 0xfc, 0x7b, 0x0b, 0xf4, 0x43, 0xae, 0x44, 0x89, 0x1b, 0xee,
-	0xdc, 0x1c, 0xae, 0x9c, 0x51, 0xa3, 0xab, 0xd0, 0x79, 0x45, 0x4b, 0x5b, 0x90, 0x6a, 0x89, 0x1e,
-	0x41, 0x77, 0x4e, 0x92, 0xc2, 0xd4, 0x61, 0x38, 0xfe, 0xf4, 0x8d, 0xc1, 0x4d, 0xf3, 0x7d, 0xdb,
-	0xfc, 0x65, 0x60, 0x6c, 0xf0, 0x5f, 0xb4, 0xef, 0xb6, 0xbc, 0xbf, 0x5a, 0xb0, 0x77, 0xfa, 0xa8,
-	0x68, 0x0f, 0xda, 0xd3, 0x89, 0x0d, 0xd8, 0x9e, 0x4e, 0x90, 0x03, 0xbd, 0x98, 0x92, 0x44, 0xc5,
-	0xa5, 0x8e, 0xd8, 0xc7, 0xf5, 0x16, 0xdd, 0x02, 0x64, 0x96, 0x41, 0x44, 0x65, 0x28, 0x58, 0x5e,
-	0x11, 0xd6, 0x56, 0xff, 0x6d, 0xa3, 0x99, 0x2c, 0x15, 0xe8, 0x18, 0x86, 0xf1, 0xeb, 0x20, 0xc9,
-	0x42, 0x92, 0x30, 0x55, 0x3a, 0x5b, 0x3a, 0x7d, 0x7f, 0xbd, 0xda, 0x7d, 0x6f, 0x51, 0x18, 0xe2,
-	0xd7, 0xf5, 0xda, 0xf3, 0xab, 0xdc, 0x9b, 0x5a, 0xf4, 0x3e, 0x40, 0x1e, 0xb2, 0xe0, 0xa4, 0x90,
-	0x01, 0x8b, 0xec, 0x19, 0xfa, 0x79, 0xc8, 0xee, 0x17, 0x72, 0x1a, 0x79, 0x23, 0xd8, 0xc3, 0x54,
-	0x52, 0x31, 0xa7, 0x96, 0xe8, 0xe8, 0x03, 0xb0, 0x2c, 0x09, 0x58, 0x24, 0x35, 0x9f, 0x07, 0x78,
-	0x60, 0x24, 0xd3, 0x48, 0x7a, 0x09, 0x5c, 0x59, 0x00, 0xec, 0x1d, 0x78, 0x0e, 0xbb, 0x61, 0xc6,
-	0x15, 0x61, 0x9c, 0x8a, 0x40, 0x50, 0xa9, 0x83, 0x0c, 0xc7, 0x9f, 0xad, 0x3a, 0xc6, 0x83, 0x1a,
-	0x64, 0x1c, 0xea, 0xbb, 0x8d, 0x77, 0xc2, 0x86, 0xd4, 0xfb, 0xa3, 0x0d, 0xfb, 0x17, 0x99, 0x21,
-	0x0c, 0x5b, 0x94, 0xcf, 0xa5, 0xbd, 0x6f, 0x5f, 0xff, 0x97, 0x50, 0xfe, 0x43, 0x3e, 0xb7, 0x84,
-	0xd3, 0xbe, 0xd0, 0x57, 0xb0, 0x9d, 0x66, 0x05, 0x57, 0xd2, 0x69, 0x6b, 0xaf, 0x1f, 0xad, 0xf2,
-	0xfa, 0x43, 0x65, 0x8d, 0x2d, 0x08, 0x4d, 0x96, 0x17, 0xaa, 0xa3, 0xf1, 0x1f, 0xaf, 0xd7, 0xc7,
-	0xa7, 0x39, 0x0d, 0x17, 0x97, 0xc9, 0xfd, 0x1c, 0x06, 0x8b, 0xbc, 0x2e, 0x60, 0xfa, 0x7e, 0x93,
-	0xe9, 0x83, 0x26, 0x6d, 0x7f, 0x86, 0xae, 0xce, 0x07, 0xbd, 0x07, 0x03, 0x45, 0xe4, 0xab, 0x20,
-	0x27, 0x2a, 0xae, 0xfb, 0x5d, 0x09, 0x9e, 0x10, 0x15, 0x57, 0xca, 0x38, 0x93, 0xca, 0x28, 0x8d,
-	0x8f, 0x7e, 0x25, 0xa8, 0x95, 0x82, 0x92, 0x28, 0xc8, 0x78, 0x52, 0x6a, 0xce, 0xf6, 0x71, 0xbf,
-	0x12, 0x1c, 0xf3, 0xa4, 0xf4, 0x62, 0x80, 0x65, 0xbe, 0xff, 0x23, 0xc8, 0x01, 0x0c, 0x73, 0x2a,
-	0x52, 0x26, 0x25, 0xcb, 0xb8, 0xb4, 0x57, 0xa3, 0x29, 0xf2, 0x5e, 0xc0, 0xce, 0xd3, 0x6a, 0x1e,
-	0xd7, 0x8c, 0xfc, 0x16, 0xae, 0x85, 0x59, 0x92, 0xd0, 0xb0, 0xea, 0x5a, 0xc0, 0xb8, 0xaa, 0x3a,
-	0x98, 0x58, 0x96, 0xbd, 0xeb, 0x9b, 0x67, 0xc2, 0xaf, 0x9f, 0x09, 0x7f, 0x62, 0x9f, 0x09, 0x8c,
-	0x96, 0xa8, 0xa9, 0x05, 0x79, 0xcf, 0x61, 0xd7, 0xfa, 0xb6, 0xe4, 0x3d, 0x82, 0x6d, 0x3d, 0xb9,
-	0x6b, 0x2a, 0xdd, 0xde, 0x60, 0x70, 0x19, 0x4f, 0x16, 0xef, 0xfd, 0xd9, 0x86, 0xab, 0x67, 0x95,
-	0x6f, 0x9c, 0xdf, 0x08, 0xb6, 0x1a, 0x83, 0x5b, 0xaf, 0x2b, 0x59, 0x63, 0x56, 0xeb, 0x35, 0x7a,
-	0x09, 0x7b, 0x8c, 0x4b, 0x45, 0x78, 0x48, 0x03, 0xfd, 0x48, 0xd9, 0x61, 0xfd, 0x60, 0xd3, 0x34,
-	0xfd, 0xa9, 0x75, 0xa3, 0x77, 0x86, 0xf6, 0xbb, 0xac, 0x29, 0x73, 0x53, 0x40, 0xe7, 0x8d, 0x2e,
-	0xe0, 0xe0, 0xbd, 0xd3, 0xd3, 0x76, 0xcd, 0xc7, 0xce, 0x14, 0xab, 0x41, 0xd8, 0xbf, 0x5b, 0xf5,
-	0x53, 0x67, 0x4a, 0xf5, 0x1d, 0xf4, 0x64, 0x91, 0xa6, 0x44, 0x94, 0xb6, 0xb5, 0x6b, 0x8f, 0xf1,
-	0x0a, 0xff, 0x63, 0xe5, 0x17, 0xd7, 0x1e, 0xd0, 0x11, 0x74, 0x4d, 0xb9, 0x4c, 0x8e, 0xe3, 0x4d,
-	0x5c, 0x1d, 0x9f, 0xbc, 0xa4, 0xa1, 0xc2, 0xc6, 0x01, 0xba, 0x0b, 0x83, 0xc5, 0x9f, 0x89, 0x6e,
-	0xcd, 0x70, 0xec, 0x9e, 0xe3, 0xdc, 0xb3, 0xda, 0x02, 0x2f, 0x8d, 0xc7, 0xff, 0xb4, 0x61, 0xc7,
-	0x1c, 0xf0, 0x89, 0x0e, 0x86, 0x7e, 0x83, 0x61, 0xe3, 0x1f, 0x02, 0x8d, 0x57, 0x15, 0xee, 0xfc,
-	0x6f, 0x88, 0x7b, 0x67, 0x23, 0x8c, 0xe1, 0xb8, 0xf7, 0xd6, 0xed, 0x16, 0x4a, 0xa0, 0x67, 0xe7,
-	0x36, 0x5a, 0xf9, 0xbe, 0x9c, 0x7e, 0x11, 0xdc, 0xd1, 0xda, 0xf6, 0x75, 0x3c, 0x14, 0x43, 0xd7,
-	0x34, 0xf5, 0xe6, 0x2a, 0x6c, 0xf3, 0xa6, 0xbb, 0xb7, 0xd6, 0xb4, 0x5e, 0x9e, 0xeb, 0x7e, 0xef,
-	0x45, 0xd7, 0x74, 0x61, 0x5b, 0x7f, 0xee, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x11, 0xd4, 0x56,
-	0x04, 0x9b, 0x0a, 0x00, 0x00,
+	0x10, 0xc7, 0xb9, 0xe4, 0x2e, 0x19, 0xdf, 0xa5, 0x65, 0x7b, 0x42, 0xc6, 0x40, 0x1b, 0x2c, 0x21,
+	0x9d, 0xa0, 0x75, 0x4a, 0x8a, 0x44, 0x05, 0x02, 0xa9, 0x6d, 0x8e, 0x5e, 0xf8, 0xd3, 0xab, 0xb6,
+	0x15, 0x52, 0x8b, 0x84, 0xb5, 0x67, 0x2f, 0xf1, 0xb6, 0xf6, 0xda, 0x78, 0xd7, 0xa9, 0xc2, 0xb3,
+	0xf0, 0x00, 0x7c, 0xe1, 0x05, 0x78, 0x18, 0x3e, 0xf0, 0x24, 0xc8, 0xbb, 0xeb, 0xc4, 0xb9, 0xbb,
+	0x36, 0x09, 0x7c, 0xf2, 0xce, 0x9f, 0xdf, 0xec, 0xec, 0xcc, 0x6f, 0x67, 0x0d, 0x1f, 0xe6, 0x49,
+	0x39, 0x65, 0x5c, 0x0c, 0x23, 0x3a, 0x63, 0x21, 0x1d, 0xe6, 0x45, 0x26, 0x33, 0x23, 0xf8, 0x4a,
+	0x40, 0xd7, 0x63, 0x22, 0x62, 0x16, 0x66, 0x45, 0xee, 0xf3, 0x2c, 0x25, 0x91, 0x6f, 0x20, 0xbe,
+	0xf6, 0x72, 0x6f, 0x4c, 0xb3, 0x6c, 0x9a, 0x18, 0xe8, 0x59, 0xf9, 0xcb, 0x50, 0xb2, 0x94, 0x0a,
+	0x49, 0xd2, 0x5c, 0x07, 0x70, 0xaf, 0x9f, 0x77, 0x88, 0xca, 0x82, 0x48, 0x96, 0x71, 0x63, 0xbf,
+	0x59, 0xe7, 0x20, 0x62, 0x52, 0xd0, 0x68, 0x28, 0x64, 0x51, 0x86, 0x52, 0x98, 0x5c, 0x88, 0x94,
+	0x05, 0x3b, 0x2b, 0xa5, 0x49, 0xc7, 0x3d, 0x7a, 0xa3, 0xb7, 0x90, 0x44, 0x0a, 0xed, 0xe9, 0x1d,
+	0x02, 0xfa, 0x86, 0xf1, 0x29, 0x2d, 0xf2, 0x82, 0x71, 0x89, 0xe9, 0xaf, 0x25, 0x15, 0xd2, 0xa3,
+	0x70, 0x6d, 0x45, 0x2b, 0xf2, 0x8c, 0x0b, 0x8a, 0x1e, 0xc1, 0xbe, 0x3e, 0x4f, 0x30, 0x2d, 0xb2,
+	0x32, 0x77, 0xac, 0xc1, 0xce, 0x91, 0x3d, 0xfa, 0xc4, 0x7f, 0xf3, 0xe1, 0xfd, 0xb1, 0xfa, 0x3c,
+	0xac, 0x20, 0xd8, 0x8e, 0x96, 0x82, 0xf7, 0xfb, 0x0e, 0xd8, 0x0d, 0x23, 0x7a, 0x07, 0x76, 0x67,
+	0x94, 0x47, 0x59, 0xe1, 0x58, 0x03, 0xeb, 0xa8, 0x87, 0x8d, 0x84, 0x6e, 0x80, 0x81, 0x05, 0x72,
+	0x9e, 0x53, 0xa7, 0xa5, 0x8c, 0xa0, 0x55, 0x4f, 0xe7, 0x39, 0x6d, 0x38, 0x70, 0x92, 0x52, 0x67,
+	0xa7, 0xe9, 0xf0, 0x88, 0xa4, 0x14, 0x9d, 0xc0, 0x9e, 0x96, 0x84, 0xd3, 0x56, 0x49, 0xfb, 0xeb,
+	0x93, 0x96, 0x34, 0x94, 0x34, 0xd2, 0xf9, 0xe1, 0x1a, 0x8e, 0x7e, 0x02, 0x58, 0x54, 0x5b, 0x38,
+	0x1d, 0x15, 0xec, 0xcb, 0x2d, 0x2a, 0xe0, 0xdf, 0x5b, 0xa0, 0x8f, 0xb9, 0x2c, 0xe6, 0xb8, 0x11,
+	0x0e, 0xb9, 0xd0, 0x0d, 0x49, 0x4e, 0x42, 0x26, 0xe7, 0xce, 0xee, 0xc0, 0x3a, 0x6a, 0xe3, 0x85,
+	0xec, 0xe6, 0x70, 0xe5, 0x1c, 0x14, 0x5d, 0x85, 0x9d, 0x97, 0x74, 0x6e, 0x8a, 0x55, 0x2d, 0xd1,
+	0x43, 0xe8, 0xcc, 0x48, 0x52, 0xea, 0x1a, 0xd9, 0xa3, 0x4f, 0x5f, 0x9b, 0x98, 0x26, 0x86, 0x6f,
+	0x88, 0xb1, 0x4c, 0x0a, 0x6b, 0xfc, 0x17, 0xad, 0xbb, 0x96, 0xf7, 0x97, 0x05, 0xfd, 0xd5, 0x32,
+	0xa0, 0x3e, 0xb4, 0x26, 0x63, 0xb3, 0x61, 0x6b, 0x32, 0x46, 0x0e, 0xec, 0xc5, 0x94, 0x24, 0x32,
+	0x9e, 0xab, 0x1d, 0xbb, 0xb8, 0x16, 0xd1, 0x2d, 0x40, 0x7a, 0x19, 0x44, 0x54, 0x84, 0x05, 0xcb,
+	0x2b, 0x32, 0x9b, 0xce, 0xbc, 0xad, 0x2d, 0xe3, 0xa5, 0x01, 0x9d, 0x82, 0x1d, 0xbf, 0x0a, 0x92,
+	0x2c, 0x24, 0x49, 0x75, 0xf8, 0xf6, 0xc0, 0xda, 0xac, 0x49, 0xd5, 0xe7, 0x7b, 0x83, 0xc2, 0x10,
+	0xbf, 0xaa, 0xd7, 0x9e, 0x0f, 0xfd, 0x55, 0x2b, 0x7a, 0x1f, 0x20, 0x0f, 0x59, 0x70, 0x56, 0x8a,
+	0x80, 0x45, 0xe6, 0x0c, 0xdd, 0x3c, 0x64, 0xf7, 0x4b, 0x31, 0x89, 0xbc, 0x63, 0xe8, 0x63, 0x2a,
+	0x68, 0x31, 0xa3, 0xe6, 0x12, 0xa0, 0x0f, 0xc0, 0x30, 0x28, 0x60, 0x91, 0x50, 0x5c, 0xef, 0xe1,
+	0x9e, 0xd6, 0x4c, 0x22, 0x81, 0x0e, 0xa1, 0xa3, 0x8a, 0xa8, 0x0e, 0xde, 0xc6, 0x5a, 0xf0, 0x12,
+	0xb8, 0xb2, 0x08, 0x63, 0x6e, 0xcd, 0x33, 0x38, 0x08, 0x33, 0x2e, 0x09, 0xe3, 0xb4, 0x08, 0x0a,
+	0x2a, 0xd4, 0xd6, 0xf6, 0xe8, 0xb3, 0x75, 0x87, 0x7b, 0x50, 0x83, 0x74, 0x40, 0x35, 0x0d, 0xf0,
+	0x7e, 0xd8, 0xd0, 0x7a, 0x7f, 0xb4, 0xe0, 0xf0, 0x32, 0x37, 0x84, 0xa1, 0x4d, 0xf9, 0x4c, 0x98,
+	0x1b, 0xfa, 0xf5, 0x7f, 0xd9, 0xca, 0x3f, 0xe6, 0x33, 0x43, 0x51, 0x15, 0x0b, 0x7d, 0x05, 0xbb,
+	0x69, 0x56, 0x72, 0x29, 0x9c, 0x96, 0x8a, 0xfa, 0xd1, 0xba, 0xa8, 0x3f, 0x54, 0xde, 0xd8, 0x80,
+	0xd0, 0x78, 0x79, 0x05, 0x77, 0x14, 0xfe, 0xe3, 0xcd, 0xba, 0xfb, 0x24, 0xa7, 0xe1, 0xe2, 0xfa,
+	0xb9, 0x9f, 0x43, 0x6f, 0x91, 0xd7, 0x25, 0xfc, 0x3f, 0x6c, 0xf2, 0xbf, 0xd7, 0x24, 0xf3, 0xcf,
+	0xd0, 0x51, 0xf9, 0xa0, 0xf7, 0xa0, 0x27, 0x89, 0x78, 0x19, 0xe4, 0x44, 0xc6, 0x35, 0x0b, 0x2a,
+	0xc5, 0x63, 0x22, 0xe3, 0xca, 0x18, 0x67, 0x42, 0x6a, 0xa3, 0x8e, 0xd1, 0xad, 0x14, 0xb5, 0xb1,
+	0xa0, 0x24, 0x0a, 0x32, 0x9e, 0xcc, 0x15, 0x93, 0xbb, 0xb8, 0x5b, 0x29, 0x4e, 0x79, 0x32, 0xf7,
+	0x62, 0x80, 0x65, 0xbe, 0xff, 0x63, 0x93, 0x01, 0xd8, 0x39, 0x2d, 0x52, 0x26, 0x04, 0xcb, 0xb8,
+	0x30, 0x17, 0xa6, 0xa9, 0xf2, 0x9e, 0xc3, 0xfe, 0x13, 0x49, 0xa4, 0xa8, 0x79, 0xfa, 0x2d, 0x5c,
+	0x0b, 0xb3, 0x24, 0xa1, 0x61, 0xd5, 0xb5, 0x80, 0x71, 0x59, 0x75, 0x30, 0x31, 0x2c, 0x7b, 0xd7,
+	0xd7, 0x0f, 0x8b, 0x5f, 0x3f, 0x2c, 0xfe, 0xd8, 0x3c, 0x2c, 0x18, 0x2d, 0x51, 0x13, 0x03, 0xf2,
+	0x9e, 0xc1, 0x81, 0x89, 0x6d, 0xc8, 0x7b, 0x02, 0xbb, 0x6a, 0xd6, 0xd7, 0x54, 0xba, 0xbd, 0xc5,
+	0xa8, 0xd3, 0x91, 0x0c, 0xde, 0xfb, 0xb3, 0x05, 0x57, 0xcf, 0x1b, 0x5f, 0x3b, 0xf1, 0x11, 0xb4,
+	0x1b, 0xa3, 0x5e, 0xad, 0x2b, 0x5d, 0x63, 0xba, 0xab, 0x35, 0x7a, 0x01, 0x7d, 0xc6, 0x85, 0x24,
+	0x3c, 0xa4, 0x81, 0x7a, 0xd6, 0xcc, 0x78, 0x7f, 0xb0, 0x6d, 0x9a, 0xfe, 0xc4, 0x84, 0x51, 0x92,
+	0xa6, 0xfd, 0x01, 0x6b, 0xea, 0xdc, 0x14, 0xd0, 0x45, 0xa7, 0x4b, 0x38, 0x78, 0x6f, 0x75, 0x06,
+	0x6f, 0xf8, 0x3c, 0xea, 0x62, 0x35, 0x08, 0xfb, 0xb7, 0x05, 0x76, 0xc3, 0x84, 0xbe, 0x83, 0x3d,
+	0x51, 0xa6, 0x29, 0x29, 0xe6, 0x8e, 0xb5, 0xdd, 0x70, 0xaf, 0xf0, 0x3f, 0x56, 0x71, 0x71, 0x1d,
+	0x01, 0x9d, 0x40, 0x47, 0x97, 0x4b, 0xe7, 0x38, 0xda, 0x26, 0xd4, 0xe9, 0xd9, 0x0b, 0x1a, 0x4a,
+	0xac, 0x03, 0xa0, 0xbb, 0xd0, 0x5b, 0xfc, 0xcb, 0xa8, 0xd6, 0xd8, 0x23, 0xf7, 0x02, 0xe7, 0x9e,
+	0xd6, 0x1e, 0x78, 0xe9, 0x3c, 0xfa, 0xa7, 0x05, 0xfb, 0xfa, 0x80, 0x8f, 0xd5, 0x66, 0xe8, 0x37,
+	0xb0, 0x1b, 0x7f, 0x1d, 0x68, 0xb4, 0xae, 0x70, 0x17, 0x7f, 0x5c, 0xdc, 0x3b, 0x5b, 0x61, 0x34,
+	0xc7, 0xbd, 0xb7, 0x6e, 0x5b, 0x28, 0x81, 0x3d, 0x33, 0xb7, 0xd1, 0xda, 0x57, 0x67, 0xf5, 0x9d,
+	0x70, 0x87, 0x1b, 0xfb, 0xd7, 0xfb, 0xa1, 0x18, 0x3a, 0xba, 0xa9, 0x37, 0xd7, 0x61, 0x9b, 0x37,
+	0xdd, 0xbd, 0xb5, 0xa1, 0xf7, 0xf2, 0x5c, 0xf7, 0xf7, 0x9e, 0x77, 0x74, 0x17, 0x76, 0xd5, 0xe7,
+	0xce, 0xbf, 0x03, 0x00, 0x07, 0xb8, 0xae, 0xab, 0xcd, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
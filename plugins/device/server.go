@@ -56,7 +56,17 @@ func (d *devicePluginServer) Fingerprint(req *proto.FingerprintRequest, stream p
 }
 
 func (d *devicePluginServer) Reserve(ctx context.Context, req *proto.ReserveRequest) (*proto.ReserveResponse, error) {
-	resp, err := d.impl.Reserve(req.GetDeviceIds())
+	var resp *ContainerReservation
+	var err error
+	if share := req.GetShare(); share != 0 {
+		shared, ok := d.impl.(SharedDevicePlugin)
+		if !ok {
+			return nil, fmt.Errorf("device plugin doesn't support reserving shares of devices")
+		}
+		resp, err = shared.ReserveShare(req.GetDeviceIds(), share)
+	} else {
+		resp, err = d.impl.Reserve(req.GetDeviceIds())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +32,7 @@ func convertProtoDeviceGroup(in *proto.DeviceGroup) *DeviceGroup {
 		Name:       in.DeviceName,
 		Devices:    convertProtoDevices(in.Devices),
 		Attributes: structs.ConvertProtoAttributeMap(in.Attributes),
+		Capacity:   in.Capacity,
 	}
 }
 
@@ -168,6 +169,7 @@ func convertStructDeviceGroup(in *DeviceGroup) *proto.DeviceGroup {
 		DeviceName: in.Name,
 		Devices:    convertStructDevices(in.Devices),
 		Attributes: structs.ConvertStructAttributeMap(in.Attributes),
+		Capacity:   in.Capacity,
 	}
 }
 
@@ -379,6 +379,17 @@ func (d *driverPluginClient) SignalTask(taskID string, signal string) error {
 	return grpcutils.HandleGrpcErr(err, d.doneCtx)
 }
 
+// UpdateTaskResources will resize the specified task to the given resources
+// without restarting it
+func (d *driverPluginClient) UpdateTaskResources(taskID string, resources *Resources) error {
+	req := &proto.UpdateTaskResourcesRequest{
+		TaskId:    taskID,
+		Resources: ResourcesToProto(resources),
+	}
+	_, err := d.client.UpdateTaskResources(d.doneCtx, req)
+	return grpcutils.HandleGrpcErr(err, d.doneCtx)
+}
+
 // ExecTask will run the given command within the execution context of the task.
 // The driver will wait for the given timeout for the command to complete before
 // terminating it. The stdout and stderr of the command will be return to the caller,
@@ -471,6 +482,7 @@ func (d *driverPluginClient) ExecTaskStreamingRaw(ctx context.Context,
 }
 
 var _ DriverNetworkManager = (*driverPluginClient)(nil)
+var _ DriverResourceUpdater = (*driverPluginClient)(nil)
 
 func (d *driverPluginClient) CreateNetwork(allocID string, _ *NetworkCreateRequest) (*NetworkIsolationSpec, bool, error) {
 	req := &proto.CreateNetworkRequest{
@@ -94,6 +94,13 @@ type DriverNetworkManager interface {
 	DestroyNetwork(allocID string, spec *NetworkIsolationSpec) error
 }
 
+// DriverResourceUpdater is the interface which exposes a function for resizing
+// a running task to new resources without restarting it. This only needs to be
+// implemented if the driver can change the resource limits of running tasks.
+type DriverResourceUpdater interface {
+	UpdateTaskResources(taskID string, resources *Resources) error
+}
+
 // DriverSignalTaskNotSupported can be embedded by drivers which don't support
 // the SignalTask RPC. This satisfies the SignalTask func requirement for the
 // DriverPlugin interface.
@@ -137,7 +137,7 @@ func (x DriverCapabilities_FSIsolation) String() string {
 }
 
 func (DriverCapabilities_FSIsolation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{32, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{34, 0}
 }
 
 type DriverCapabilities_MountConfigs int32
@@ -165,7 +165,7 @@ func (x DriverCapabilities_MountConfigs) String() string {
 }
 
 func (DriverCapabilities_MountConfigs) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{32, 1}
+	return fileDescriptor_4a8f45747846a74d, []int{34, 1}
 }
 
 type NetworkIsolationSpec_NetworkIsolationMode int32
@@ -196,7 +196,7 @@ func (x NetworkIsolationSpec_NetworkIsolationMode) String() string {
 }
 
 func (NetworkIsolationSpec_NetworkIsolationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{33, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{35, 0}
 }
 
 type CPUUsage_Fields int32
@@ -233,7 +233,7 @@ func (x CPUUsage_Fields) String() string {
 }
 
 func (CPUUsage_Fields) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{54, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{56, 0}
 }
 
 type MemoryUsage_Fields int32
@@ -273,7 +273,7 @@ func (x MemoryUsage_Fields) String() string {
 }
 
 func (MemoryUsage_Fields) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{55, 0}
+	return fileDescriptor_4a8f45747846a74d, []int{57, 0}
 }
 
 type TaskConfigSchemaRequest struct {
@@ -1833,6 +1833,86 @@ func (m *DestroyNetworkResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DestroyNetworkResponse proto.InternalMessageInfo
 
+type UpdateTaskResourcesRequest struct {
+	// TaskId is the ID of the target task
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Resources are the resources the task should be resized to
+	Resources            *Resources `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *UpdateTaskResourcesRequest) Reset()         { *m = UpdateTaskResourcesRequest{} }
+func (m *UpdateTaskResourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTaskResourcesRequest) ProtoMessage()    {}
+func (*UpdateTaskResourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{32}
+}
+
+func (m *UpdateTaskResourcesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTaskResourcesRequest.Unmarshal(m, b)
+}
+func (m *UpdateTaskResourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTaskResourcesRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateTaskResourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTaskResourcesRequest.Merge(m, src)
+}
+func (m *UpdateTaskResourcesRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateTaskResourcesRequest.Size(m)
+}
+func (m *UpdateTaskResourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTaskResourcesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTaskResourcesRequest proto.InternalMessageInfo
+
+func (m *UpdateTaskResourcesRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *UpdateTaskResourcesRequest) GetResources() *Resources {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+type UpdateTaskResourcesResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateTaskResourcesResponse) Reset()         { *m = UpdateTaskResourcesResponse{} }
+func (m *UpdateTaskResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateTaskResourcesResponse) ProtoMessage()    {}
+func (*UpdateTaskResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a8f45747846a74d, []int{33}
+}
+
+func (m *UpdateTaskResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTaskResourcesResponse.Unmarshal(m, b)
+}
+func (m *UpdateTaskResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTaskResourcesResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateTaskResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTaskResourcesResponse.Merge(m, src)
+}
+func (m *UpdateTaskResourcesResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateTaskResourcesResponse.Size(m)
+}
+func (m *UpdateTaskResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTaskResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTaskResourcesResponse proto.InternalMessageInfo
+
 type DriverCapabilities struct {
 	// SendSignals indicates that the driver can send process signals (ex. SIGUSR1)
 	// to the task.
@@ -1858,7 +1938,7 @@ func (m *DriverCapabilities) Reset()         { *m = DriverCapabilities{} }
 func (m *DriverCapabilities) String() string { return proto.CompactTextString(m) }
 func (*DriverCapabilities) ProtoMessage()    {}
 func (*DriverCapabilities) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{32}
+	return fileDescriptor_4a8f45747846a74d, []int{34}
 }
 
 func (m *DriverCapabilities) XXX_Unmarshal(b []byte) error {
@@ -1942,7 +2022,7 @@ func (m *NetworkIsolationSpec) Reset()         { *m = NetworkIsolationSpec{} }
 func (m *NetworkIsolationSpec) String() string { return proto.CompactTextString(m) }
 func (*NetworkIsolationSpec) ProtoMessage()    {}
 func (*NetworkIsolationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{33}
+	return fileDescriptor_4a8f45747846a74d, []int{35}
 }
 
 func (m *NetworkIsolationSpec) XXX_Unmarshal(b []byte) error {
@@ -2003,7 +2083,7 @@ func (m *HostsConfig) Reset()         { *m = HostsConfig{} }
 func (m *HostsConfig) String() string { return proto.CompactTextString(m) }
 func (*HostsConfig) ProtoMessage()    {}
 func (*HostsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{34}
+	return fileDescriptor_4a8f45747846a74d, []int{36}
 }
 
 func (m *HostsConfig) XXX_Unmarshal(b []byte) error {
@@ -2051,7 +2131,7 @@ func (m *DNSConfig) Reset()         { *m = DNSConfig{} }
 func (m *DNSConfig) String() string { return proto.CompactTextString(m) }
 func (*DNSConfig) ProtoMessage()    {}
 func (*DNSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{35}
+	return fileDescriptor_4a8f45747846a74d, []int{37}
 }
 
 func (m *DNSConfig) XXX_Unmarshal(b []byte) error {
@@ -2143,7 +2223,7 @@ func (m *TaskConfig) Reset()         { *m = TaskConfig{} }
 func (m *TaskConfig) String() string { return proto.CompactTextString(m) }
 func (*TaskConfig) ProtoMessage()    {}
 func (*TaskConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{36}
+	return fileDescriptor_4a8f45747846a74d, []int{38}
 }
 
 func (m *TaskConfig) XXX_Unmarshal(b []byte) error {
@@ -2300,7 +2380,7 @@ func (m *Resources) Reset()         { *m = Resources{} }
 func (m *Resources) String() string { return proto.CompactTextString(m) }
 func (*Resources) ProtoMessage()    {}
 func (*Resources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{37}
+	return fileDescriptor_4a8f45747846a74d, []int{39}
 }
 
 func (m *Resources) XXX_Unmarshal(b []byte) error {
@@ -2355,7 +2435,7 @@ func (m *AllocatedTaskResources) Reset()         { *m = AllocatedTaskResources{}
 func (m *AllocatedTaskResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedTaskResources) ProtoMessage()    {}
 func (*AllocatedTaskResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{38}
+	return fileDescriptor_4a8f45747846a74d, []int{40}
 }
 
 func (m *AllocatedTaskResources) XXX_Unmarshal(b []byte) error {
@@ -2408,7 +2488,7 @@ func (m *AllocatedCpuResources) Reset()         { *m = AllocatedCpuResources{} }
 func (m *AllocatedCpuResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedCpuResources) ProtoMessage()    {}
 func (*AllocatedCpuResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{39}
+	return fileDescriptor_4a8f45747846a74d, []int{41}
 }
 
 func (m *AllocatedCpuResources) XXX_Unmarshal(b []byte) error {
@@ -2448,7 +2528,7 @@ func (m *AllocatedMemoryResources) Reset()         { *m = AllocatedMemoryResourc
 func (m *AllocatedMemoryResources) String() string { return proto.CompactTextString(m) }
 func (*AllocatedMemoryResources) ProtoMessage()    {}
 func (*AllocatedMemoryResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{40}
+	return fileDescriptor_4a8f45747846a74d, []int{42}
 }
 
 func (m *AllocatedMemoryResources) XXX_Unmarshal(b []byte) error {
@@ -2499,7 +2579,7 @@ func (m *NetworkResource) Reset()         { *m = NetworkResource{} }
 func (m *NetworkResource) String() string { return proto.CompactTextString(m) }
 func (*NetworkResource) ProtoMessage()    {}
 func (*NetworkResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{41}
+	return fileDescriptor_4a8f45747846a74d, []int{43}
 }
 
 func (m *NetworkResource) XXX_Unmarshal(b []byte) error {
@@ -2574,7 +2654,7 @@ func (m *NetworkPort) Reset()         { *m = NetworkPort{} }
 func (m *NetworkPort) String() string { return proto.CompactTextString(m) }
 func (*NetworkPort) ProtoMessage()    {}
 func (*NetworkPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{42}
+	return fileDescriptor_4a8f45747846a74d, []int{44}
 }
 
 func (m *NetworkPort) XXX_Unmarshal(b []byte) error {
@@ -2623,7 +2703,7 @@ func (m *PortMapping) Reset()         { *m = PortMapping{} }
 func (m *PortMapping) String() string { return proto.CompactTextString(m) }
 func (*PortMapping) ProtoMessage()    {}
 func (*PortMapping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{43}
+	return fileDescriptor_4a8f45747846a74d, []int{45}
 }
 
 func (m *PortMapping) XXX_Unmarshal(b []byte) error {
@@ -2700,7 +2780,7 @@ func (m *LinuxResources) Reset()         { *m = LinuxResources{} }
 func (m *LinuxResources) String() string { return proto.CompactTextString(m) }
 func (*LinuxResources) ProtoMessage()    {}
 func (*LinuxResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{44}
+	return fileDescriptor_4a8f45747846a74d, []int{46}
 }
 
 func (m *LinuxResources) XXX_Unmarshal(b []byte) error {
@@ -2796,7 +2876,7 @@ func (m *Mount) Reset()         { *m = Mount{} }
 func (m *Mount) String() string { return proto.CompactTextString(m) }
 func (*Mount) ProtoMessage()    {}
 func (*Mount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{45}
+	return fileDescriptor_4a8f45747846a74d, []int{47}
 }
 
 func (m *Mount) XXX_Unmarshal(b []byte) error {
@@ -2867,7 +2947,7 @@ func (m *Device) Reset()         { *m = Device{} }
 func (m *Device) String() string { return proto.CompactTextString(m) }
 func (*Device) ProtoMessage()    {}
 func (*Device) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{46}
+	return fileDescriptor_4a8f45747846a74d, []int{48}
 }
 
 func (m *Device) XXX_Unmarshal(b []byte) error {
@@ -2929,7 +3009,7 @@ func (m *TaskHandle) Reset()         { *m = TaskHandle{} }
 func (m *TaskHandle) String() string { return proto.CompactTextString(m) }
 func (*TaskHandle) ProtoMessage()    {}
 func (*TaskHandle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{47}
+	return fileDescriptor_4a8f45747846a74d, []int{49}
 }
 
 func (m *TaskHandle) XXX_Unmarshal(b []byte) error {
@@ -2997,7 +3077,7 @@ func (m *NetworkOverride) Reset()         { *m = NetworkOverride{} }
 func (m *NetworkOverride) String() string { return proto.CompactTextString(m) }
 func (*NetworkOverride) ProtoMessage()    {}
 func (*NetworkOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{48}
+	return fileDescriptor_4a8f45747846a74d, []int{50}
 }
 
 func (m *NetworkOverride) XXX_Unmarshal(b []byte) error {
@@ -3056,7 +3136,7 @@ func (m *ExitResult) Reset()         { *m = ExitResult{} }
 func (m *ExitResult) String() string { return proto.CompactTextString(m) }
 func (*ExitResult) ProtoMessage()    {}
 func (*ExitResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{49}
+	return fileDescriptor_4a8f45747846a74d, []int{51}
 }
 
 func (m *ExitResult) XXX_Unmarshal(b []byte) error {
@@ -3120,7 +3200,7 @@ func (m *TaskStatus) Reset()         { *m = TaskStatus{} }
 func (m *TaskStatus) String() string { return proto.CompactTextString(m) }
 func (*TaskStatus) ProtoMessage()    {}
 func (*TaskStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{50}
+	return fileDescriptor_4a8f45747846a74d, []int{52}
 }
 
 func (m *TaskStatus) XXX_Unmarshal(b []byte) error {
@@ -3196,7 +3276,7 @@ func (m *TaskDriverStatus) Reset()         { *m = TaskDriverStatus{} }
 func (m *TaskDriverStatus) String() string { return proto.CompactTextString(m) }
 func (*TaskDriverStatus) ProtoMessage()    {}
 func (*TaskDriverStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{51}
+	return fileDescriptor_4a8f45747846a74d, []int{53}
 }
 
 func (m *TaskDriverStatus) XXX_Unmarshal(b []byte) error {
@@ -3242,7 +3322,7 @@ func (m *TaskStats) Reset()         { *m = TaskStats{} }
 func (m *TaskStats) String() string { return proto.CompactTextString(m) }
 func (*TaskStats) ProtoMessage()    {}
 func (*TaskStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{52}
+	return fileDescriptor_4a8f45747846a74d, []int{54}
 }
 
 func (m *TaskStats) XXX_Unmarshal(b []byte) error {
@@ -3305,7 +3385,7 @@ func (m *TaskResourceUsage) Reset()         { *m = TaskResourceUsage{} }
 func (m *TaskResourceUsage) String() string { return proto.CompactTextString(m) }
 func (*TaskResourceUsage) ProtoMessage()    {}
 func (*TaskResourceUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{53}
+	return fileDescriptor_4a8f45747846a74d, []int{55}
 }
 
 func (m *TaskResourceUsage) XXX_Unmarshal(b []byte) error {
@@ -3358,7 +3438,7 @@ func (m *CPUUsage) Reset()         { *m = CPUUsage{} }
 func (m *CPUUsage) String() string { return proto.CompactTextString(m) }
 func (*CPUUsage) ProtoMessage()    {}
 func (*CPUUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{54}
+	return fileDescriptor_4a8f45747846a74d, []int{56}
 }
 
 func (m *CPUUsage) XXX_Unmarshal(b []byte) error {
@@ -3447,7 +3527,7 @@ func (m *MemoryUsage) Reset()         { *m = MemoryUsage{} }
 func (m *MemoryUsage) String() string { return proto.CompactTextString(m) }
 func (*MemoryUsage) ProtoMessage()    {}
 func (*MemoryUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{55}
+	return fileDescriptor_4a8f45747846a74d, []int{57}
 }
 
 func (m *MemoryUsage) XXX_Unmarshal(b []byte) error {
@@ -3546,7 +3626,7 @@ func (m *DriverTaskEvent) Reset()         { *m = DriverTaskEvent{} }
 func (m *DriverTaskEvent) String() string { return proto.CompactTextString(m) }
 func (*DriverTaskEvent) ProtoMessage()    {}
 func (*DriverTaskEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a8f45747846a74d, []int{56}
+	return fileDescriptor_4a8f45747846a74d, []int{58}
 }
 
 func (m *DriverTaskEvent) XXX_Unmarshal(b []byte) error {
@@ -3653,6 +3733,8 @@ func init() {
 	proto.RegisterType((*CreateNetworkResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.CreateNetworkResponse")
 	proto.RegisterType((*DestroyNetworkRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.DestroyNetworkRequest")
 	proto.RegisterType((*DestroyNetworkResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.DestroyNetworkResponse")
+	proto.RegisterType((*UpdateTaskResourcesRequest)(nil), "hashicorp.nomad.plugins.drivers.proto.UpdateTaskResourcesRequest")
+	proto.RegisterType((*UpdateTaskResourcesResponse)(nil), "hashicorp.nomad.plugins.drivers.proto.UpdateTaskResourcesResponse")
 	proto.RegisterType((*DriverCapabilities)(nil), "hashicorp.nomad.plugins.drivers.proto.DriverCapabilities")
 	proto.RegisterType((*NetworkIsolationSpec)(nil), "hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec")
 	proto.RegisterMapType((map[string]string)(nil), "hashicorp.nomad.plugins.drivers.proto.NetworkIsolationSpec.LabelsEntry")
@@ -3692,245 +3774,247 @@ func init() {
 }
 
 var fileDescriptor_4a8f45747846a74d = []byte{
-	// 3795 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x73, 0xdb, 0x48,
-	0x76, 0x17, 0x08, 0x7e, 0x3e, 0x4a, 0x14, 0xd4, 0x96, 0x6d, 0x9a, 0x9b, 0x64, 0xbc, 0x48, 0x4d,
-	0x4a, 0xd9, 0x9d, 0xa1, 0x67, 0xb5, 0x95, 0xf1, 0x78, 0xd6, 0xb3, 0x1e, 0x0e, 0x45, 0x5b, 0x1a,
-	0x4b, 0x94, 0xd2, 0xa4, 0xca, 0xeb, 0x38, 0x19, 0x04, 0x02, 0xda, 0x14, 0x2c, 0xe2, 0x63, 0xd0,
-	0xa0, 0x2c, 0x6d, 0x2a, 0x95, 0xd4, 0xa6, 0x2a, 0x35, 0xa9, 0x4a, 0x2a, 0xb9, 0x4c, 0xf6, 0x92,
-	0xd3, 0x56, 0xe5, 0x94, 0x7f, 0x20, 0xb5, 0xa9, 0x3d, 0xe5, 0x90, 0x7f, 0x22, 0x97, 0xdc, 0x72,
-	0x4c, 0x4e, 0xb9, 0xa6, 0xfa, 0x03, 0x20, 0x20, 0xd2, 0x63, 0x90, 0xf2, 0x89, 0x78, 0xaf, 0xbb,
-	0x7f, 0xfd, 0xf8, 0xde, 0xeb, 0xd7, 0xaf, 0xbb, 0x1f, 0xe8, 0xc1, 0x78, 0x32, 0x72, 0x3c, 0x7a,
-	0xcf, 0x0e, 0x9d, 0x73, 0x12, 0xd2, 0x7b, 0x41, 0xe8, 0x47, 0xbe, 0xa4, 0xda, 0x9c, 0x40, 0xef,
-	0x9f, 0x9a, 0xf4, 0xd4, 0xb1, 0xfc, 0x30, 0x68, 0x7b, 0xbe, 0x6b, 0xda, 0x6d, 0x39, 0xa6, 0x2d,
-	0xc7, 0x88, 0x6e, 0xad, 0xdf, 0x19, 0xf9, 0xfe, 0x68, 0x4c, 0x04, 0xc2, 0xc9, 0xe4, 0xe5, 0x3d,
-	0x7b, 0x12, 0x9a, 0x91, 0xe3, 0x7b, 0xb2, 0xfd, 0xbd, 0xab, 0xed, 0x91, 0xe3, 0x12, 0x1a, 0x99,
-	0x6e, 0x20, 0x3b, 0xbc, 0x1f, 0xcb, 0x42, 0x4f, 0xcd, 0x90, 0xd8, 0xf7, 0x4e, 0xad, 0x31, 0x0d,
-	0x88, 0xc5, 0x7e, 0x0d, 0xf6, 0x21, 0xbb, 0x7d, 0x70, 0xa5, 0x1b, 0x8d, 0xc2, 0x89, 0x15, 0xc5,
-	0x92, 0x9b, 0x51, 0x14, 0x3a, 0x27, 0x93, 0x88, 0x88, 0xde, 0xfa, 0x1d, 0xb8, 0x3d, 0x34, 0xe9,
-	0x59, 0xd7, 0xf7, 0x5e, 0x3a, 0xa3, 0x81, 0x75, 0x4a, 0x5c, 0x13, 0x93, 0xaf, 0x27, 0x84, 0x46,
-	0xfa, 0x1f, 0x43, 0x73, 0xb6, 0x89, 0x06, 0xbe, 0x47, 0x09, 0xfa, 0x1c, 0x8a, 0x6c, 0xca, 0xa6,
-	0x72, 0x57, 0xd9, 0xaa, 0x6f, 0x7f, 0xd0, 0x7e, 0x93, 0x0a, 0x84, 0x0c, 0x6d, 0x29, 0x6a, 0x7b,
-	0x10, 0x10, 0x0b, 0xf3, 0x91, 0xfa, 0x4d, 0xb8, 0xd1, 0x35, 0x03, 0xf3, 0xc4, 0x19, 0x3b, 0x91,
-	0x43, 0x68, 0x3c, 0xe9, 0x04, 0x36, 0xb3, 0x6c, 0x39, 0xe1, 0x9f, 0xc0, 0xaa, 0x95, 0xe2, 0xcb,
-	0x89, 0x1f, 0xb4, 0x73, 0xe9, 0xbe, 0xbd, 0xc3, 0xa9, 0x0c, 0x70, 0x06, 0x4e, 0xdf, 0x04, 0xf4,
-	0xd8, 0xf1, 0x46, 0x24, 0x0c, 0x42, 0xc7, 0x8b, 0x62, 0x61, 0x7e, 0xa3, 0xc2, 0x8d, 0x0c, 0x5b,
-	0x0a, 0xf3, 0x0a, 0x20, 0xd1, 0x23, 0x13, 0x45, 0xdd, 0xaa, 0x6f, 0x7f, 0x99, 0x53, 0x94, 0x39,
-	0x78, 0xed, 0x4e, 0x02, 0xd6, 0xf3, 0xa2, 0xf0, 0x12, 0xa7, 0xd0, 0xd1, 0x57, 0x50, 0x3e, 0x25,
-	0xe6, 0x38, 0x3a, 0x6d, 0x16, 0xee, 0x2a, 0x5b, 0x8d, 0xed, 0xc7, 0xd7, 0x98, 0x67, 0x97, 0x03,
-	0x0d, 0x22, 0x33, 0x22, 0x58, 0xa2, 0xa2, 0x0f, 0x01, 0x89, 0x2f, 0xc3, 0x26, 0xd4, 0x0a, 0x9d,
-	0x80, 0xb9, 0x64, 0x53, 0xbd, 0xab, 0x6c, 0xd5, 0xf0, 0x86, 0x68, 0xd9, 0x99, 0x36, 0xb4, 0x02,
-	0x58, 0xbf, 0x22, 0x2d, 0xd2, 0x40, 0x3d, 0x23, 0x97, 0xdc, 0x22, 0x35, 0xcc, 0x3e, 0xd1, 0x13,
-	0x28, 0x9d, 0x9b, 0xe3, 0x09, 0xe1, 0x22, 0xd7, 0xb7, 0x7f, 0xf4, 0x36, 0xf7, 0x90, 0x2e, 0x3a,
-	0xd5, 0x03, 0x16, 0xe3, 0x3f, 0x2d, 0x7c, 0xa2, 0xe8, 0x0f, 0xa0, 0x9e, 0x92, 0x1b, 0x35, 0x00,
-	0x8e, 0xfb, 0x3b, 0xbd, 0x61, 0xaf, 0x3b, 0xec, 0xed, 0x68, 0x2b, 0x68, 0x0d, 0x6a, 0xc7, 0xfd,
-	0xdd, 0x5e, 0x67, 0x7f, 0xb8, 0xfb, 0x5c, 0x53, 0x50, 0x1d, 0x2a, 0x31, 0x51, 0xd0, 0x2f, 0x00,
-	0x61, 0x62, 0xf9, 0xe7, 0x24, 0x64, 0x8e, 0x2c, 0xad, 0x8a, 0x6e, 0x43, 0x25, 0x32, 0xe9, 0x99,
-	0xe1, 0xd8, 0x52, 0xe6, 0x32, 0x23, 0xf7, 0x6c, 0xb4, 0x07, 0xe5, 0x53, 0xd3, 0xb3, 0xc7, 0x6f,
-	0x97, 0x3b, 0xab, 0x6a, 0x06, 0xbe, 0xcb, 0x07, 0x62, 0x09, 0xc0, 0xbc, 0x3b, 0x33, 0xb3, 0x30,
-	0x80, 0xfe, 0x1c, 0xb4, 0x41, 0x64, 0x86, 0x51, 0x5a, 0x9c, 0x1e, 0x14, 0xd9, 0xfc, 0xd2, 0xa3,
-	0x17, 0x99, 0x53, 0xac, 0x4c, 0xcc, 0x87, 0xeb, 0xff, 0x5b, 0x80, 0x8d, 0x14, 0xb6, 0xf4, 0xd4,
-	0x67, 0x50, 0x0e, 0x09, 0x9d, 0x8c, 0x23, 0x0e, 0xdf, 0xd8, 0x7e, 0x94, 0x13, 0x7e, 0x06, 0xa9,
-	0x8d, 0x39, 0x0c, 0x96, 0x70, 0x68, 0x0b, 0x34, 0x31, 0xc2, 0x20, 0x61, 0xe8, 0x87, 0x86, 0x4b,
-	0x47, 0x5c, 0x6b, 0x35, 0xdc, 0x10, 0xfc, 0x1e, 0x63, 0x1f, 0xd0, 0x51, 0x4a, 0xab, 0xea, 0x35,
-	0xb5, 0x8a, 0x4c, 0xd0, 0x3c, 0x12, 0xbd, 0xf6, 0xc3, 0x33, 0x83, 0xa9, 0x36, 0x74, 0x6c, 0xd2,
-	0x2c, 0x72, 0xd0, 0x8f, 0x73, 0x82, 0xf6, 0xc5, 0xf0, 0x43, 0x39, 0x1a, 0xaf, 0x7b, 0x59, 0x86,
-	0xfe, 0x43, 0x28, 0x8b, 0x7f, 0xca, 0x3c, 0x69, 0x70, 0xdc, 0xed, 0xf6, 0x06, 0x03, 0x6d, 0x05,
-	0xd5, 0xa0, 0x84, 0x7b, 0x43, 0xcc, 0x3c, 0xac, 0x06, 0xa5, 0xc7, 0x9d, 0x61, 0x67, 0x5f, 0x2b,
-	0xe8, 0x3f, 0x80, 0xf5, 0x67, 0xa6, 0x13, 0xe5, 0x71, 0x2e, 0xdd, 0x07, 0x6d, 0xda, 0x57, 0x5a,
-	0x67, 0x2f, 0x63, 0x9d, 0xfc, 0xaa, 0xe9, 0x5d, 0x38, 0xd1, 0x15, 0x7b, 0x68, 0xa0, 0x92, 0x30,
-	0x94, 0x26, 0x60, 0x9f, 0xfa, 0x6b, 0x58, 0x1f, 0x44, 0x7e, 0x90, 0xcb, 0xf3, 0x7f, 0x0c, 0x15,
-	0xb6, 0xdb, 0xf8, 0x93, 0x48, 0xba, 0xfe, 0x9d, 0xb6, 0xd8, 0x8d, 0xda, 0xf1, 0x6e, 0xd4, 0xde,
-	0x91, 0xbb, 0x15, 0x8e, 0x7b, 0xa2, 0x5b, 0x50, 0xa6, 0xce, 0xc8, 0x33, 0xc7, 0x32, 0x5a, 0x48,
-	0x4a, 0x47, 0xcc, 0xc9, 0xe3, 0x89, 0xa5, 0xe3, 0x77, 0x01, 0xed, 0x10, 0x1a, 0x85, 0xfe, 0x65,
-	0x2e, 0x79, 0x36, 0xa1, 0xf4, 0xd2, 0x0f, 0x2d, 0xb1, 0x10, 0xab, 0x58, 0x10, 0x6c, 0x51, 0x65,
-	0x40, 0x24, 0xf6, 0x87, 0x80, 0xf6, 0x3c, 0xb6, 0xa7, 0xe4, 0x33, 0xc4, 0x3f, 0x14, 0xe0, 0x46,
-	0xa6, 0xbf, 0x34, 0xc6, 0xf2, 0xeb, 0x90, 0x05, 0xa6, 0x09, 0x15, 0xeb, 0x10, 0x1d, 0x42, 0x59,
-	0xf4, 0x90, 0x9a, 0xbc, 0xbf, 0x00, 0x90, 0xd8, 0xa6, 0x24, 0x9c, 0x84, 0x99, 0xeb, 0xf4, 0xea,
-	0xbb, 0x75, 0xfa, 0xd7, 0xa0, 0xc5, 0xff, 0x83, 0xbe, 0xd5, 0x36, 0x5f, 0xc2, 0x0d, 0xcb, 0x1f,
-	0x8f, 0x89, 0xc5, 0xbc, 0xc1, 0x70, 0xbc, 0x88, 0x84, 0xe7, 0xe6, 0xf8, 0xed, 0x7e, 0x83, 0xa6,
-	0xa3, 0xf6, 0xe4, 0x20, 0xfd, 0x05, 0x6c, 0xa4, 0x26, 0x96, 0x86, 0x78, 0x0c, 0x25, 0xca, 0x18,
-	0xd2, 0x12, 0x1f, 0x2d, 0x68, 0x09, 0x8a, 0xc5, 0x70, 0xfd, 0x86, 0x00, 0xef, 0x9d, 0x13, 0x2f,
-	0xf9, 0x5b, 0xfa, 0x0e, 0x6c, 0x0c, 0xb8, 0x9b, 0xe6, 0xf2, 0xc3, 0xa9, 0x8b, 0x17, 0x32, 0x2e,
-	0xbe, 0x09, 0x28, 0x8d, 0x22, 0x1d, 0xf1, 0x12, 0xd6, 0x7b, 0x17, 0xc4, 0xca, 0x85, 0xdc, 0x84,
-	0x8a, 0xe5, 0xbb, 0xae, 0xe9, 0xd9, 0xcd, 0xc2, 0x5d, 0x75, 0xab, 0x86, 0x63, 0x32, 0xbd, 0x16,
-	0xd5, 0xbc, 0x6b, 0x51, 0xff, 0x3b, 0x05, 0xb4, 0xe9, 0xdc, 0x52, 0x91, 0x4c, 0xfa, 0xc8, 0x66,
-	0x40, 0x6c, 0xee, 0x55, 0x2c, 0x29, 0xc9, 0x8f, 0xc3, 0x85, 0xe0, 0x93, 0x30, 0x4c, 0x85, 0x23,
-	0xf5, 0x9a, 0xe1, 0x48, 0xdf, 0x85, 0xdf, 0x8a, 0xc5, 0x19, 0x44, 0x21, 0x31, 0x5d, 0xc7, 0x1b,
-	0xed, 0x1d, 0x1e, 0x06, 0x44, 0x08, 0x8e, 0x10, 0x14, 0x6d, 0x33, 0x32, 0xa5, 0x60, 0xfc, 0x9b,
-	0x2d, 0x7a, 0x6b, 0xec, 0xd3, 0x64, 0xd1, 0x73, 0x42, 0xff, 0x0f, 0x15, 0x9a, 0x33, 0x50, 0xb1,
-	0x7a, 0x5f, 0x40, 0x89, 0x92, 0x68, 0x12, 0x48, 0x57, 0xe9, 0xe5, 0x16, 0x78, 0x3e, 0x5e, 0x7b,
-	0xc0, 0xc0, 0xb0, 0xc0, 0x44, 0x23, 0xa8, 0x46, 0xd1, 0xa5, 0x41, 0x9d, 0x9f, 0xc7, 0x09, 0xc1,
-	0xfe, 0x75, 0xf1, 0x87, 0x24, 0x74, 0x1d, 0xcf, 0x1c, 0x0f, 0x9c, 0x9f, 0x13, 0x5c, 0x89, 0xa2,
-	0x4b, 0xf6, 0x81, 0x9e, 0x33, 0x87, 0xb7, 0x1d, 0x4f, 0xaa, 0xbd, 0xbb, 0xec, 0x2c, 0x29, 0x05,
-	0x63, 0x81, 0xd8, 0xda, 0x87, 0x12, 0xff, 0x4f, 0xcb, 0x38, 0xa2, 0x06, 0x6a, 0x14, 0x5d, 0x72,
-	0xa1, 0xaa, 0x98, 0x7d, 0xb6, 0x1e, 0xc2, 0x6a, 0xfa, 0x1f, 0x30, 0x47, 0x3a, 0x25, 0xce, 0xe8,
-	0x54, 0x38, 0x58, 0x09, 0x4b, 0x8a, 0x59, 0xf2, 0xb5, 0x63, 0xcb, 0x94, 0xb5, 0x84, 0x05, 0xa1,
-	0xff, 0x6b, 0x01, 0xee, 0xcc, 0xd1, 0x8c, 0x74, 0xd6, 0x17, 0x19, 0x67, 0x7d, 0x47, 0x5a, 0x88,
-	0x3d, 0xfe, 0x45, 0xc6, 0xe3, 0xdf, 0x21, 0x38, 0x5b, 0x36, 0xb7, 0xa0, 0x4c, 0x2e, 0x9c, 0x88,
-	0xd8, 0x52, 0x55, 0x92, 0x4a, 0x2d, 0xa7, 0xe2, 0x75, 0x97, 0xd3, 0x01, 0x6c, 0x76, 0x43, 0x62,
-	0x46, 0x44, 0x86, 0xf2, 0xd8, 0xff, 0xef, 0x40, 0xd5, 0x1c, 0x8f, 0x7d, 0x6b, 0x6a, 0xd6, 0x0a,
-	0xa7, 0xf7, 0x6c, 0xd4, 0x82, 0xea, 0xa9, 0x4f, 0x23, 0xcf, 0x74, 0x89, 0x0c, 0x5e, 0x09, 0xad,
-	0x7f, 0xab, 0xc0, 0xcd, 0x2b, 0x78, 0xd2, 0x0a, 0x27, 0xd0, 0x70, 0xa8, 0x3f, 0xe6, 0x7f, 0xd0,
-	0x48, 0x9d, 0xf0, 0x7e, 0xb2, 0xd8, 0x56, 0xb3, 0x17, 0x63, 0xf0, 0x03, 0xdf, 0x9a, 0x93, 0x26,
-	0xb9, 0xc7, 0xf1, 0xc9, 0x6d, 0xb9, 0xd2, 0x63, 0x52, 0xff, 0x47, 0x05, 0x6e, 0xca, 0x1d, 0x3e,
-	0xff, 0x1f, 0x9d, 0x15, 0xb9, 0xf0, 0xae, 0x45, 0xd6, 0x9b, 0x70, 0xeb, 0xaa, 0x5c, 0x32, 0xe6,
-	0xff, 0x5f, 0x11, 0xd0, 0xec, 0xe9, 0x12, 0x7d, 0x1f, 0x56, 0x29, 0xf1, 0x6c, 0x43, 0xec, 0x17,
-	0x62, 0x2b, 0xab, 0xe2, 0x3a, 0xe3, 0x89, 0x8d, 0x83, 0xb2, 0x10, 0x48, 0x2e, 0xa4, 0xb4, 0x55,
-	0xcc, 0xbf, 0xd1, 0x29, 0xac, 0xbe, 0xa4, 0x46, 0x32, 0x37, 0x77, 0xa8, 0x46, 0xee, 0xb0, 0x36,
-	0x2b, 0x47, 0xfb, 0xf1, 0x20, 0xf9, 0x5f, 0xb8, 0xfe, 0x92, 0x26, 0x04, 0xfa, 0x46, 0x81, 0xdb,
-	0x71, 0x5a, 0x31, 0x55, 0x9f, 0xeb, 0xdb, 0x84, 0x36, 0x8b, 0x77, 0xd5, 0xad, 0xc6, 0xf6, 0xd1,
-	0x35, 0xf4, 0x37, 0xc3, 0x3c, 0xf0, 0x6d, 0x82, 0x6f, 0x7a, 0x73, 0xb8, 0x14, 0xb5, 0xe1, 0x86,
-	0x3b, 0xa1, 0x91, 0x21, 0xbc, 0xc0, 0x90, 0x9d, 0x9a, 0x25, 0xae, 0x97, 0x0d, 0xd6, 0x94, 0xf1,
-	0x55, 0x74, 0x06, 0x6b, 0xae, 0x3f, 0xf1, 0x22, 0xc3, 0xe2, 0xe7, 0x1f, 0xda, 0x2c, 0x2f, 0x74,
-	0x30, 0x9e, 0xa3, 0xa5, 0x03, 0x06, 0x27, 0x4e, 0x53, 0x14, 0xaf, 0xba, 0x29, 0x8a, 0x19, 0x32,
-	0x24, 0xae, 0x1f, 0x11, 0x83, 0xc5, 0x4b, 0xda, 0xac, 0x08, 0x43, 0x0a, 0x1e, 0x0b, 0x0d, 0x54,
-	0x6f, 0x43, 0x3d, 0xa5, 0x66, 0x54, 0x85, 0x62, 0xff, 0xb0, 0xdf, 0xd3, 0x56, 0x10, 0x40, 0xb9,
-	0xbb, 0x8b, 0x0f, 0x0f, 0x87, 0xe2, 0xd4, 0xb0, 0x77, 0xd0, 0x79, 0xd2, 0xd3, 0x0a, 0x7a, 0x0f,
-	0x56, 0xd3, 0x13, 0x22, 0x04, 0x8d, 0xe3, 0xfe, 0xd3, 0xfe, 0xe1, 0xb3, 0xbe, 0x71, 0x70, 0x78,
-	0xdc, 0x1f, 0xb2, 0xf3, 0x46, 0x03, 0xa0, 0xd3, 0x7f, 0x3e, 0xa5, 0xd7, 0xa0, 0xd6, 0x3f, 0x8c,
-	0x49, 0xa5, 0x55, 0xd0, 0x14, 0xfd, 0xdf, 0x55, 0xd8, 0x9c, 0xa7, 0x7b, 0x64, 0x43, 0x91, 0xd9,
-	0x51, 0x9e, 0xf8, 0xde, 0xbd, 0x19, 0x39, 0x3a, 0x73, 0xdf, 0xc0, 0x94, 0x21, 0xbe, 0x86, 0xf9,
-	0x37, 0x32, 0xa0, 0x3c, 0x36, 0x4f, 0xc8, 0x98, 0x36, 0x55, 0x7e, 0x27, 0xf2, 0xe4, 0x3a, 0x73,
-	0xef, 0x73, 0x24, 0x71, 0x21, 0x22, 0x61, 0xd1, 0x10, 0xea, 0x2c, 0x88, 0x51, 0xa1, 0x3a, 0x19,
-	0x57, 0xb7, 0x73, 0xce, 0xb2, 0x3b, 0x1d, 0x89, 0xd3, 0x30, 0xad, 0x07, 0x50, 0x4f, 0x4d, 0x36,
-	0xe7, 0x3e, 0x63, 0x33, 0x7d, 0x9f, 0x51, 0x4b, 0x5f, 0x4e, 0x3c, 0x9a, 0xb5, 0x01, 0xd3, 0x11,
-	0x73, 0x82, 0xdd, 0xc3, 0xc1, 0x50, 0x9c, 0x1c, 0x9f, 0xe0, 0xc3, 0xe3, 0x23, 0x4d, 0x61, 0xcc,
-	0x61, 0x67, 0xf0, 0x54, 0x2b, 0x24, 0x3e, 0xa2, 0xea, 0x5d, 0xa8, 0xa7, 0xe4, 0xca, 0x44, 0x6d,
-	0x25, 0x1b, 0xb5, 0x59, 0xdc, 0x34, 0x6d, 0x3b, 0x24, 0x94, 0x4a, 0x39, 0x62, 0x52, 0x7f, 0x01,
-	0xb5, 0x9d, 0xfe, 0x40, 0x42, 0x34, 0xa1, 0x42, 0x49, 0xc8, 0xfe, 0x37, 0xbf, 0x99, 0xaa, 0xe1,
-	0x98, 0x64, 0xe0, 0x94, 0x98, 0xa1, 0x75, 0x4a, 0xa8, 0xdc, 0xeb, 0x13, 0x9a, 0x8d, 0xf2, 0xf9,
-	0x0d, 0x8f, 0xb0, 0x5d, 0x0d, 0xc7, 0xa4, 0xfe, 0x3f, 0x15, 0x80, 0xe9, 0x6d, 0x03, 0x6a, 0x40,
-	0x21, 0x89, 0xc1, 0x05, 0xc7, 0x66, 0x7e, 0x90, 0xda, 0x63, 0xf8, 0x37, 0xda, 0x86, 0x9b, 0x2e,
-	0x1d, 0x05, 0xa6, 0x75, 0x66, 0xc8, 0x4b, 0x02, 0xb1, 0x54, 0x79, 0x3c, 0x5b, 0xc5, 0x37, 0x64,
-	0xa3, 0x5c, 0x89, 0x02, 0x77, 0x1f, 0x54, 0xe2, 0x9d, 0xf3, 0xd8, 0x53, 0xdf, 0xfe, 0x74, 0xe1,
-	0x5b, 0x90, 0x76, 0xcf, 0x3b, 0x17, 0xbe, 0xc2, 0x60, 0x90, 0x01, 0x60, 0x93, 0x73, 0xc7, 0x22,
-	0x06, 0x03, 0x2d, 0x71, 0xd0, 0xcf, 0x17, 0x07, 0xdd, 0xe1, 0x18, 0x09, 0x74, 0xcd, 0x8e, 0x69,
-	0xd4, 0x87, 0x5a, 0x48, 0xa8, 0x3f, 0x09, 0x2d, 0x22, 0x02, 0x50, 0xfe, 0x83, 0x0a, 0x8e, 0xc7,
-	0xe1, 0x29, 0x04, 0xda, 0x81, 0x32, 0x8f, 0x3b, 0x2c, 0xc2, 0xa8, 0xdf, 0x79, 0xa5, 0x9a, 0x05,
-	0xe3, 0x91, 0x04, 0xcb, 0xb1, 0xe8, 0x09, 0x54, 0x84, 0x88, 0xb4, 0x59, 0xe5, 0x30, 0x1f, 0xe6,
-	0x0d, 0x8a, 0x7c, 0x14, 0x8e, 0x47, 0x33, 0xab, 0x4e, 0x28, 0x09, 0x9b, 0x35, 0x61, 0x55, 0xf6,
-	0x8d, 0xbe, 0x07, 0x35, 0xb1, 0x07, 0xdb, 0x4e, 0xd8, 0x04, 0xe1, 0x9c, 0x9c, 0xb1, 0xe3, 0x84,
-	0xe8, 0x3d, 0xa8, 0x8b, 0x5c, 0xcb, 0xe0, 0x51, 0xa1, 0xce, 0x9b, 0x41, 0xb0, 0x8e, 0x58, 0x6c,
-	0x10, 0x1d, 0x48, 0x18, 0x8a, 0x0e, 0xab, 0x49, 0x07, 0x12, 0x86, 0xbc, 0xc3, 0xef, 0xc1, 0x3a,
-	0xcf, 0x50, 0x47, 0xa1, 0x3f, 0x09, 0x0c, 0xee, 0x53, 0x6b, 0xbc, 0xd3, 0x1a, 0x63, 0x3f, 0x61,
-	0xdc, 0x3e, 0x73, 0xae, 0x3b, 0x50, 0x7d, 0xe5, 0x9f, 0x88, 0x0e, 0x0d, 0xb1, 0x0e, 0x5e, 0xf9,
-	0x27, 0x71, 0x53, 0x92, 0x25, 0xac, 0x67, 0xb3, 0x84, 0xaf, 0xe1, 0xd6, 0xec, 0x76, 0xc7, 0xb3,
-	0x05, 0xed, 0xfa, 0xd9, 0xc2, 0xa6, 0x37, 0x2f, 0x0e, 0x7f, 0x01, 0xaa, 0xed, 0xd1, 0xe6, 0xc6,
-	0x42, 0xce, 0x91, 0xac, 0x63, 0xcc, 0x06, 0xb7, 0x3e, 0x86, 0x6a, 0xec, 0x7d, 0x8b, 0xc4, 0xa5,
-	0xd6, 0x43, 0x68, 0x64, 0x7d, 0x77, 0xa1, 0xa8, 0xf6, 0xcf, 0x05, 0xa8, 0x25, 0x5e, 0x8a, 0x3c,
-	0xb8, 0xc1, 0xb5, 0xc8, 0x52, 0x34, 0x63, 0xea, 0xf4, 0x22, 0x31, 0xfc, 0x2c, 0xe7, 0xff, 0xea,
-	0xc4, 0x08, 0xf2, 0x84, 0x2a, 0x57, 0x00, 0x4a, 0x90, 0xa7, 0xf3, 0x7d, 0x05, 0xeb, 0x63, 0xc7,
-	0x9b, 0x5c, 0xa4, 0xe6, 0x12, 0x19, 0xdd, 0x1f, 0xe4, 0x9c, 0x6b, 0x9f, 0x8d, 0x9e, 0xce, 0xd1,
-	0x18, 0x67, 0x68, 0xb4, 0x0b, 0xa5, 0xc0, 0x0f, 0xa3, 0x78, 0x93, 0xca, 0xbb, 0x7d, 0x1c, 0xf9,
-	0x61, 0x74, 0x60, 0x06, 0x01, 0x3b, 0xb4, 0x08, 0x00, 0xfd, 0xdb, 0x02, 0xdc, 0x9a, 0xff, 0xc7,
-	0x50, 0x1f, 0x54, 0x2b, 0x98, 0x48, 0x25, 0x3d, 0x5c, 0x54, 0x49, 0xdd, 0x60, 0x32, 0x95, 0x9f,
-	0x01, 0xa1, 0x67, 0x50, 0x76, 0x89, 0xeb, 0x87, 0x97, 0x52, 0x17, 0x8f, 0x16, 0x85, 0x3c, 0xe0,
-	0xa3, 0xa7, 0xa8, 0x12, 0x0e, 0x61, 0xa8, 0x4a, 0xef, 0xa5, 0x32, 0x4e, 0x2e, 0x78, 0xad, 0x14,
-	0x43, 0xe2, 0x04, 0x47, 0xff, 0x18, 0x6e, 0xce, 0xfd, 0x2b, 0xe8, 0xb7, 0x01, 0xac, 0x60, 0x62,
-	0xf0, 0x6b, 0x7f, 0xe1, 0x41, 0x2a, 0xae, 0x59, 0xc1, 0x64, 0xc0, 0x19, 0xfa, 0x0b, 0x68, 0xbe,
-	0x49, 0x5e, 0x16, 0x7d, 0x84, 0xc4, 0x86, 0x7b, 0xc2, 0x75, 0xa0, 0xe2, 0xaa, 0x60, 0x1c, 0x9c,
-	0x20, 0x1d, 0xd6, 0xe2, 0x46, 0xf3, 0x82, 0x75, 0x50, 0x79, 0x87, 0xba, 0xec, 0x60, 0x5e, 0x1c,
-	0x9c, 0xe8, 0xbf, 0x2c, 0xc0, 0xfa, 0x15, 0x91, 0xd9, 0xd1, 0x4d, 0x44, 0xbc, 0xf8, 0x50, 0x2c,
-	0x28, 0x16, 0xfe, 0x2c, 0xc7, 0x8e, 0xaf, 0x53, 0xf9, 0x37, 0xdf, 0xf8, 0x02, 0x79, 0xd5, 0x59,
-	0x70, 0x02, 0xb6, 0x7c, 0xdc, 0x13, 0x27, 0xa2, 0x3c, 0x0b, 0x29, 0x61, 0x41, 0xa0, 0xe7, 0xd0,
-	0x08, 0x09, 0xdf, 0x70, 0x6d, 0x43, 0x78, 0x59, 0x69, 0x21, 0x2f, 0x93, 0x12, 0x32, 0x67, 0xc3,
-	0x6b, 0x31, 0x12, 0xa3, 0x28, 0x7a, 0x06, 0x6b, 0xf6, 0xa5, 0x67, 0xba, 0x8e, 0x25, 0x91, 0xcb,
-	0x4b, 0x23, 0xaf, 0x4a, 0x20, 0x0e, 0xac, 0x3f, 0x80, 0x7a, 0xaa, 0x91, 0xfd, 0x31, 0x9e, 0x6e,
-	0x49, 0x9d, 0x08, 0x22, 0x1b, 0x2d, 0x4a, 0x32, 0x5a, 0xe8, 0x27, 0x50, 0x4f, 0xad, 0x8b, 0x45,
-	0x86, 0x32, 0x7d, 0x46, 0x3e, 0xd7, 0x67, 0x09, 0x17, 0x22, 0x1f, 0xdd, 0x86, 0x0a, 0x4b, 0x75,
-	0x0c, 0x27, 0xe0, 0x1a, 0xad, 0xe1, 0x32, 0x23, 0xf7, 0x02, 0xfd, 0xd7, 0x05, 0x68, 0x64, 0x97,
-	0x74, 0xec, 0x47, 0x01, 0x09, 0x1d, 0xdf, 0x4e, 0xf9, 0xd1, 0x11, 0x67, 0x30, 0x5f, 0x61, 0xcd,
-	0x5f, 0x4f, 0xfc, 0xc8, 0x8c, 0x7d, 0xc5, 0x0a, 0x26, 0x7f, 0xc8, 0xe8, 0x2b, 0x3e, 0xa8, 0x5e,
-	0xf1, 0x41, 0xf4, 0x01, 0x20, 0xe9, 0x4a, 0x63, 0xc7, 0x75, 0x22, 0xe3, 0xe4, 0x32, 0x22, 0xc2,
-	0xc6, 0x2a, 0xd6, 0x44, 0xcb, 0x3e, 0x6b, 0xf8, 0x82, 0xf1, 0x99, 0xe3, 0xf9, 0xbe, 0x6b, 0x50,
-	0xcb, 0x0f, 0x89, 0x61, 0xda, 0xaf, 0xf8, 0xa9, 0x45, 0xc5, 0x75, 0xdf, 0x77, 0x07, 0x8c, 0xd7,
-	0xb1, 0x5f, 0xb1, 0x9d, 0xcf, 0x0a, 0x26, 0x94, 0x44, 0x06, 0xfb, 0xe1, 0xc9, 0x42, 0x0d, 0x83,
-	0x60, 0x75, 0x83, 0x09, 0x45, 0xbf, 0x0b, 0x6b, 0x71, 0x07, 0xbe, 0xf9, 0xc9, 0x5d, 0x77, 0x55,
-	0x76, 0xe1, 0x3c, 0xa4, 0xc3, 0xea, 0x11, 0x09, 0x2d, 0xe2, 0x45, 0x43, 0xc7, 0x3a, 0x63, 0xfb,
-	0xbb, 0xb2, 0xa5, 0xe0, 0x0c, 0xef, 0xcb, 0x62, 0xb5, 0xa2, 0x55, 0x71, 0x3c, 0x9b, 0x4b, 0x5c,
-	0xaa, 0x7f, 0xa3, 0x40, 0x89, 0xe7, 0x08, 0x4c, 0x29, 0x7c, 0x7f, 0xe5, 0xdb, 0xaf, 0xcc, 0x2d,
-	0x19, 0x83, 0x6f, 0xbe, 0xdf, 0x83, 0x1a, 0x57, 0x7e, 0x2a, 0xa5, 0xe7, 0x89, 0x27, 0x6f, 0x6c,
-	0x41, 0x35, 0x24, 0xa6, 0xed, 0x7b, 0xe3, 0xf8, 0x36, 0x28, 0xa1, 0xd1, 0xef, 0x83, 0x16, 0x84,
-	0x7e, 0x60, 0x8e, 0xa6, 0x07, 0x48, 0x69, 0xbe, 0xf5, 0x14, 0x9f, 0xe5, 0xc4, 0xfa, 0xd7, 0x50,
-	0x16, 0x7b, 0xd2, 0x35, 0x44, 0xf9, 0x10, 0x90, 0xd0, 0x11, 0xb3, 0xbd, 0xeb, 0x50, 0x2a, 0x33,
-	0x56, 0xfe, 0x5a, 0x29, 0x5a, 0x8e, 0xa6, 0x0d, 0xfa, 0x7f, 0x2a, 0x22, 0x77, 0x15, 0xef, 0x48,
-	0x2c, 0xc9, 0x65, 0x0b, 0x82, 0x9d, 0xac, 0xc5, 0x85, 0x55, 0x4c, 0xa2, 0x3d, 0x28, 0xcb, 0x14,
-	0xb5, 0xb0, 0xec, 0x33, 0x9c, 0x04, 0x88, 0xaf, 0xaf, 0x89, 0x3c, 0xbc, 0x2f, 0x7a, 0x7d, 0x4d,
-	0xc4, 0xf5, 0x35, 0x61, 0x27, 0x4f, 0x99, 0x3c, 0x0b, 0xb8, 0x22, 0xcf, 0x9d, 0xeb, 0x76, 0xf2,
-	0x46, 0x40, 0xf4, 0xff, 0x56, 0x92, 0x90, 0x16, 0xdf, 0xe5, 0xa3, 0xaf, 0xa0, 0xca, 0xa2, 0x83,
-	0xe1, 0x9a, 0x81, 0x7c, 0x99, 0xee, 0x2e, 0xf7, 0x4c, 0x10, 0x6f, 0x78, 0x22, 0xf5, 0xad, 0x04,
-	0x82, 0x62, 0xa1, 0x91, 0x1d, 0x3b, 0xe2, 0xd0, 0xc8, 0xbe, 0xd1, 0xfb, 0xd0, 0x30, 0x27, 0x91,
-	0x6f, 0x98, 0xf6, 0x39, 0x09, 0x23, 0x87, 0x12, 0xe9, 0x26, 0x6b, 0x8c, 0xdb, 0x89, 0x99, 0xad,
-	0x4f, 0x61, 0x35, 0x8d, 0xf9, 0xb6, 0x94, 0xa4, 0x94, 0x4e, 0x49, 0xfe, 0x14, 0x60, 0x7a, 0x2f,
-	0xc6, 0x7c, 0x84, 0x5c, 0x38, 0x91, 0x61, 0xc5, 0xe7, 0xdc, 0x12, 0xae, 0x32, 0x46, 0x97, 0x9d,
-	0xbd, 0xb2, 0x97, 0xf6, 0xa5, 0xf8, 0xd2, 0x9e, 0x2d, 0x7c, 0xb6, 0x56, 0xcf, 0x9c, 0xf1, 0x38,
-	0xb9, 0xab, 0xab, 0xf9, 0xbe, 0xfb, 0x94, 0x33, 0xf4, 0xdf, 0x14, 0x84, 0xaf, 0x88, 0xe7, 0x97,
-	0x5c, 0xe7, 0x9c, 0x77, 0x65, 0xea, 0x07, 0x00, 0x34, 0x32, 0x43, 0x96, 0x5f, 0x99, 0xf1, 0x6d,
-	0x61, 0x6b, 0xe6, 0xd6, 0x7f, 0x18, 0xd7, 0x83, 0xe0, 0x9a, 0xec, 0xdd, 0x89, 0xd0, 0x67, 0xb0,
-	0x6a, 0xf9, 0x6e, 0x30, 0x26, 0x72, 0x70, 0xe9, 0xad, 0x83, 0xeb, 0x49, 0xff, 0x4e, 0x94, 0xba,
-	0xa3, 0x2c, 0x5f, 0xf7, 0x8e, 0xf2, 0xd7, 0x8a, 0x78, 0x45, 0x4a, 0x3f, 0x62, 0xa1, 0xd1, 0x9c,
-	0x4a, 0x89, 0x27, 0x4b, 0xbe, 0x88, 0x7d, 0x57, 0x99, 0x44, 0xeb, 0xb3, 0x3c, 0x75, 0x09, 0x6f,
-	0xce, 0x78, 0xff, 0x4d, 0x85, 0x5a, 0xf2, 0x80, 0x34, 0x63, 0xfb, 0x4f, 0xa0, 0x96, 0x14, 0xe3,
-	0xc8, 0x00, 0xf1, 0x9d, 0xe6, 0x49, 0x3a, 0xa3, 0x97, 0x80, 0xcc, 0xd1, 0x28, 0xc9, 0x64, 0x8d,
-	0x09, 0x35, 0x47, 0xf1, 0xf3, 0xdd, 0x27, 0x0b, 0xe8, 0x21, 0xde, 0xfa, 0x8e, 0xd9, 0x78, 0xac,
-	0x99, 0xa3, 0x51, 0x86, 0x83, 0xfe, 0x0c, 0x6e, 0x66, 0xe7, 0x30, 0x4e, 0x2e, 0x8d, 0xc0, 0xb1,
-	0xe5, 0x79, 0x7a, 0x77, 0xd1, 0x37, 0xb4, 0x76, 0x06, 0xfe, 0x8b, 0xcb, 0x23, 0xc7, 0x16, 0x3a,
-	0x47, 0xe1, 0x4c, 0x43, 0xeb, 0x2f, 0xe0, 0xf6, 0x1b, 0xba, 0xcf, 0xb1, 0x41, 0x3f, 0x5b, 0x1b,
-	0xb2, 0xbc, 0x12, 0x52, 0xd6, 0xfb, 0x95, 0x22, 0x9e, 0xfa, 0xb2, 0x3a, 0xe9, 0xa4, 0x53, 0xf0,
-	0x7b, 0x39, 0xe7, 0xe9, 0x1e, 0x1d, 0x0b, 0x78, 0x9e, 0x75, 0x7f, 0x79, 0x25, 0xeb, 0xce, 0x9b,
-	0x6b, 0x89, 0xe4, 0x55, 0x00, 0x49, 0x04, 0xfd, 0x5f, 0x54, 0xa8, 0xc6, 0xe8, 0xfc, 0x34, 0x7c,
-	0x49, 0x23, 0xe2, 0x1a, 0xc9, 0x55, 0x9d, 0x82, 0x41, 0xb0, 0xf8, 0x05, 0xd2, 0xf7, 0xa0, 0xc6,
-	0x0e, 0xdd, 0xa2, 0xb9, 0xc0, 0x9b, 0xab, 0x8c, 0xc1, 0x1b, 0xdf, 0x83, 0x7a, 0xe4, 0x47, 0xe6,
-	0xd8, 0x88, 0x78, 0x2a, 0xa0, 0x8a, 0xd1, 0x9c, 0xc5, 0x13, 0x01, 0xf4, 0x43, 0xd8, 0x88, 0x4e,
-	0x43, 0x3f, 0x8a, 0xc6, 0x2c, 0x0d, 0xe5, 0x49, 0x91, 0xc8, 0x61, 0x8a, 0x58, 0x4b, 0x1a, 0x44,
-	0xb2, 0x44, 0x59, 0xf4, 0x9e, 0x76, 0x66, 0xae, 0xcb, 0x83, 0x48, 0x11, 0xaf, 0x25, 0x5c, 0xe6,
-	0xda, 0x6c, 0xf3, 0x0c, 0x44, 0xb2, 0xc1, 0x63, 0x85, 0x82, 0x63, 0x12, 0x19, 0xb0, 0xee, 0x12,
-	0x93, 0x4e, 0x42, 0x62, 0x1b, 0x2f, 0x1d, 0x32, 0xb6, 0xc5, 0x25, 0x46, 0x23, 0xf7, 0x49, 0x22,
-	0x56, 0x4b, 0xfb, 0x31, 0x1f, 0x8d, 0x1b, 0x31, 0x9c, 0xa0, 0x59, 0xe6, 0x20, 0xbe, 0xd0, 0x3a,
-	0xd4, 0x07, 0xcf, 0x07, 0xc3, 0xde, 0x81, 0x71, 0x70, 0xb8, 0xd3, 0x93, 0xe5, 0x3f, 0x83, 0x1e,
-	0x16, 0xa4, 0xc2, 0xda, 0x87, 0x87, 0xc3, 0xce, 0xbe, 0x31, 0xdc, 0xeb, 0x3e, 0x1d, 0x68, 0x05,
-	0x74, 0x13, 0x36, 0x86, 0xbb, 0xf8, 0x70, 0x38, 0xdc, 0xef, 0xed, 0x18, 0x47, 0x3d, 0xbc, 0x77,
-	0xb8, 0x33, 0xd0, 0x54, 0x84, 0xa0, 0x31, 0x65, 0x0f, 0xf7, 0x0e, 0x7a, 0x5a, 0x11, 0xd5, 0xa1,
-	0x72, 0xd4, 0xc3, 0xdd, 0x5e, 0x7f, 0xa8, 0x95, 0xf4, 0x5f, 0xaa, 0x50, 0x4f, 0x59, 0x91, 0x39,
-	0x72, 0x48, 0xc5, 0x91, 0xa5, 0x88, 0xd9, 0x27, 0x7f, 0xae, 0x34, 0xad, 0x53, 0x61, 0x9d, 0x22,
-	0x16, 0x04, 0x3f, 0xa6, 0x98, 0x17, 0xa9, 0x75, 0x5e, 0xc4, 0x55, 0xd7, 0xbc, 0x10, 0x20, 0xdf,
-	0x87, 0xd5, 0x33, 0x12, 0x7a, 0x64, 0x2c, 0xdb, 0x85, 0x45, 0xea, 0x82, 0x27, 0xba, 0x6c, 0x81,
-	0x26, 0xbb, 0x4c, 0x61, 0x84, 0x39, 0x1a, 0x82, 0x7f, 0x10, 0x83, 0x6d, 0x42, 0x49, 0x34, 0x57,
-	0xc4, 0xfc, 0x9c, 0x60, 0xdb, 0x14, 0x7d, 0x6d, 0x06, 0x3c, 0x3d, 0x2c, 0x62, 0xfe, 0x8d, 0x4e,
-	0x66, 0xed, 0x53, 0xe6, 0xf6, 0x79, 0xb0, 0xb8, 0x3b, 0xbf, 0xc9, 0x44, 0xa7, 0x89, 0x89, 0x2a,
-	0xa0, 0xe2, 0xb8, 0x66, 0xa6, 0xdb, 0xe9, 0xee, 0x32, 0xb3, 0xac, 0x41, 0xed, 0xa0, 0xf3, 0x33,
-	0xe3, 0x78, 0xc0, 0x6f, 0xc0, 0x91, 0x06, 0xab, 0x4f, 0x7b, 0xb8, 0xdf, 0xdb, 0x97, 0x1c, 0x15,
-	0x6d, 0x82, 0x26, 0x39, 0xd3, 0x7e, 0x45, 0x86, 0x20, 0x3e, 0x4b, 0xa8, 0x0a, 0xc5, 0xc1, 0xb3,
-	0xce, 0x91, 0x56, 0xd6, 0xff, 0xab, 0x00, 0xeb, 0x62, 0x5b, 0x48, 0x5e, 0xf7, 0xdf, 0xfc, 0xba,
-	0x99, 0xbe, 0x11, 0x2a, 0x64, 0x6f, 0x84, 0xe2, 0x24, 0x94, 0xef, 0xea, 0xea, 0x34, 0x09, 0xe5,
-	0x37, 0x49, 0x99, 0x88, 0x5f, 0x5c, 0x24, 0xe2, 0x37, 0xa1, 0xe2, 0x12, 0x9a, 0xd8, 0xad, 0x86,
-	0x63, 0x12, 0x39, 0x50, 0x37, 0x3d, 0xcf, 0x8f, 0x4c, 0x71, 0xcd, 0x5a, 0x5e, 0x68, 0x33, 0xbc,
-	0xf2, 0x8f, 0xdb, 0x9d, 0x29, 0x92, 0x08, 0xcc, 0x69, 0xec, 0xd6, 0x4f, 0x41, 0xbb, 0xda, 0x61,
-	0x91, 0xed, 0xf0, 0x07, 0x3f, 0x9a, 0xee, 0x86, 0x84, 0xad, 0x0b, 0xf9, 0x3e, 0xa1, 0xad, 0x30,
-	0x02, 0x1f, 0xf7, 0xfb, 0x7b, 0xfd, 0x27, 0x9a, 0x82, 0x00, 0xca, 0xbd, 0x9f, 0xed, 0x0d, 0x7b,
-	0x3b, 0x5a, 0x61, 0xfb, 0x57, 0x1b, 0x50, 0x16, 0x42, 0xa2, 0x6f, 0x65, 0x26, 0x90, 0xae, 0x1c,
-	0x45, 0x3f, 0x5d, 0x38, 0xa3, 0xce, 0x54, 0xa3, 0xb6, 0x1e, 0x2d, 0x3d, 0x5e, 0xbe, 0xd4, 0xad,
-	0xa0, 0xbf, 0x51, 0x60, 0x35, 0xf3, 0x4a, 0x97, 0xf7, 0x9a, 0x79, 0x4e, 0xa1, 0x6a, 0xeb, 0x27,
-	0x4b, 0x8d, 0x4d, 0x64, 0xf9, 0x46, 0x81, 0x7a, 0xaa, 0x44, 0x13, 0x3d, 0x58, 0xa6, 0xac, 0x53,
-	0x48, 0xf2, 0xe9, 0xf2, 0x15, 0xa1, 0xfa, 0xca, 0x47, 0x0a, 0xfa, 0x6b, 0x05, 0xea, 0xa9, 0x62,
-	0xc5, 0xdc, 0xa2, 0xcc, 0x96, 0x56, 0xe6, 0x16, 0x65, 0x5e, 0x6d, 0xe4, 0x0a, 0xfa, 0x4b, 0x05,
-	0x6a, 0x49, 0xe1, 0x21, 0xba, 0xbf, 0x78, 0xa9, 0xa2, 0x10, 0xe2, 0x93, 0x65, 0x6b, 0x1c, 0xf5,
-	0x15, 0xf4, 0xe7, 0x50, 0x8d, 0xab, 0xf4, 0x50, 0xde, 0xdd, 0xeb, 0x4a, 0x09, 0x60, 0xeb, 0xfe,
-	0xc2, 0xe3, 0xd2, 0xd3, 0xc7, 0xa5, 0x73, 0xb9, 0xa7, 0xbf, 0x52, 0xe4, 0xd7, 0xba, 0xbf, 0xf0,
-	0xb8, 0x64, 0x7a, 0xe6, 0x09, 0xa9, 0x0a, 0xbb, 0xdc, 0x9e, 0x30, 0x5b, 0xda, 0x97, 0xdb, 0x13,
-	0xe6, 0x15, 0xf4, 0x09, 0x41, 0x52, 0x35, 0x7a, 0xb9, 0x05, 0x99, 0xad, 0x03, 0xcc, 0x2d, 0xc8,
-	0x9c, 0x92, 0x40, 0x7d, 0x05, 0xfd, 0x42, 0x49, 0x9f, 0x0b, 0xee, 0x2f, 0x5c, 0x8a, 0xb6, 0xa0,
-	0x4b, 0xce, 0x14, 0xc3, 0xf1, 0x05, 0xfa, 0x0b, 0x79, 0x8b, 0x21, 0x2a, 0xd9, 0xd0, 0x22, 0x60,
-	0x99, 0xe2, 0xb7, 0xd6, 0xc7, 0xcb, 0x6d, 0x36, 0x5c, 0x88, 0xbf, 0x52, 0x00, 0xa6, 0x35, 0x6f,
-	0xb9, 0x85, 0x98, 0x29, 0xb6, 0x6b, 0x3d, 0x58, 0x62, 0x64, 0x7a, 0x81, 0xc4, 0x35, 0x39, 0xb9,
-	0x17, 0xc8, 0x95, 0x9a, 0xbc, 0xdc, 0x0b, 0xe4, 0x6a, 0x3d, 0x9d, 0xbe, 0x82, 0xfe, 0x49, 0x81,
-	0x8d, 0x99, 0x9a, 0x20, 0xf4, 0xe8, 0x9a, 0x65, 0x61, 0xad, 0xcf, 0x97, 0x07, 0x88, 0x45, 0xdb,
-	0x52, 0x3e, 0x52, 0xd0, 0xdf, 0x2a, 0xb0, 0x96, 0xad, 0x95, 0xc8, 0xbd, 0x4b, 0xcd, 0xa9, 0x2e,
-	0x6a, 0x3d, 0x5c, 0x6e, 0x70, 0xa2, 0xad, 0xbf, 0x57, 0xa0, 0x91, 0x2d, 0x9b, 0x41, 0x0f, 0x17,
-	0x0b, 0x0b, 0x57, 0x04, 0xfa, 0x6c, 0xc9, 0xd1, 0xb1, 0x44, 0x5f, 0x54, 0xfe, 0xa8, 0x24, 0xb2,
-	0xb7, 0x32, 0xff, 0xf9, 0xf1, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x4e, 0xae, 0x7e, 0xe0,
-	0x33, 0x00, 0x00,
+	// 3825 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x6f, 0x1b, 0x49,
+	0x76, 0x77, 0xf3, 0x4b, 0xe4, 0xa3, 0x44, 0xb5, 0xca, 0xb2, 0x87, 0xe6, 0x64, 0x33, 0xde, 0x0e,
+	0x26, 0x50, 0x76, 0x67, 0xe8, 0x59, 0x2d, 0x32, 0x1e, 0x7b, 0x3d, 0xeb, 0xa1, 0x29, 0xda, 0xd2,
+	0x58, 0xa2, 0x94, 0x22, 0x05, 0xaf, 0xe3, 0x64, 0x3a, 0x2d, 0x76, 0x99, 0x6a, 0x8b, 0xfd, 0x31,
+	0x5d, 0x45, 0x59, 0xda, 0x20, 0x48, 0xb0, 0x41, 0x82, 0x09, 0x90, 0x20, 0xb9, 0x4c, 0xf6, 0x12,
+	0xe4, 0x10, 0x20, 0xa7, 0xfc, 0x03, 0xc1, 0x06, 0x7b, 0xda, 0x43, 0xfe, 0x89, 0x5c, 0x72, 0xcb,
+	0x31, 0x39, 0xe5, 0xba, 0xa8, 0x8f, 0x6e, 0x76, 0x93, 0xf4, 0xba, 0x49, 0xf9, 0xc4, 0xae, 0x57,
+	0x55, 0xbf, 0x7a, 0x7c, 0xef, 0xd5, 0x7b, 0xaf, 0xaa, 0x1e, 0x18, 0xc1, 0x68, 0x3c, 0x74, 0x3c,
+	0x7a, 0xc7, 0x0e, 0x9d, 0x73, 0x12, 0xd2, 0x3b, 0x41, 0xe8, 0x33, 0x5f, 0xb5, 0x9a, 0xa2, 0x81,
+	0x3e, 0x3c, 0xb5, 0xe8, 0xa9, 0x33, 0xf0, 0xc3, 0xa0, 0xe9, 0xf9, 0xae, 0x65, 0x37, 0xd5, 0x9c,
+	0xa6, 0x9a, 0x23, 0x87, 0x35, 0x7e, 0x7b, 0xe8, 0xfb, 0xc3, 0x11, 0x91, 0x08, 0x27, 0xe3, 0x97,
+	0x77, 0xec, 0x71, 0x68, 0x31, 0xc7, 0xf7, 0x54, 0xff, 0x07, 0xd3, 0xfd, 0xcc, 0x71, 0x09, 0x65,
+	0x96, 0x1b, 0xa8, 0x01, 0x1f, 0x46, 0xbc, 0xd0, 0x53, 0x2b, 0x24, 0xf6, 0x9d, 0xd3, 0xc1, 0x88,
+	0x06, 0x64, 0xc0, 0x7f, 0x4d, 0xfe, 0xa1, 0x86, 0x7d, 0x34, 0x35, 0x8c, 0xb2, 0x70, 0x3c, 0x60,
+	0x11, 0xe7, 0x16, 0x63, 0xa1, 0x73, 0x32, 0x66, 0x44, 0x8e, 0x36, 0x6e, 0xc1, 0x7b, 0x7d, 0x8b,
+	0x9e, 0xb5, 0x7d, 0xef, 0xa5, 0x33, 0xec, 0x0d, 0x4e, 0x89, 0x6b, 0x61, 0xf2, 0xf5, 0x98, 0x50,
+	0x66, 0xfc, 0x11, 0xd4, 0x67, 0xbb, 0x68, 0xe0, 0x7b, 0x94, 0xa0, 0x2f, 0xa0, 0xc0, 0x97, 0xac,
+	0x6b, 0xb7, 0xb5, 0xad, 0xea, 0xf6, 0x47, 0xcd, 0x37, 0x89, 0x40, 0xf2, 0xd0, 0x54, 0xac, 0x36,
+	0x7b, 0x01, 0x19, 0x60, 0x31, 0xd3, 0xb8, 0x01, 0xd7, 0xdb, 0x56, 0x60, 0x9d, 0x38, 0x23, 0x87,
+	0x39, 0x84, 0x46, 0x8b, 0x8e, 0x61, 0x33, 0x4d, 0x56, 0x0b, 0xfe, 0x31, 0xac, 0x0e, 0x12, 0x74,
+	0xb5, 0xf0, 0xbd, 0x66, 0x26, 0xd9, 0x37, 0x77, 0x44, 0x2b, 0x05, 0x9c, 0x82, 0x33, 0x36, 0x01,
+	0x3d, 0x76, 0xbc, 0x21, 0x09, 0x83, 0xd0, 0xf1, 0x58, 0xc4, 0xcc, 0x2f, 0xf3, 0x70, 0x3d, 0x45,
+	0x56, 0xcc, 0xbc, 0x02, 0x88, 0xe5, 0xc8, 0x59, 0xc9, 0x6f, 0x55, 0xb7, 0xbf, 0xcc, 0xc8, 0xca,
+	0x1c, 0xbc, 0x66, 0x2b, 0x06, 0xeb, 0x78, 0x2c, 0xbc, 0xc4, 0x09, 0x74, 0xf4, 0x15, 0x94, 0x4e,
+	0x89, 0x35, 0x62, 0xa7, 0xf5, 0xdc, 0x6d, 0x6d, 0xab, 0xb6, 0xfd, 0xf8, 0x0a, 0xeb, 0xec, 0x0a,
+	0xa0, 0x1e, 0xb3, 0x18, 0xc1, 0x0a, 0x15, 0x7d, 0x0c, 0x48, 0x7e, 0x99, 0x36, 0xa1, 0x83, 0xd0,
+	0x09, 0xb8, 0x49, 0xd6, 0xf3, 0xb7, 0xb5, 0xad, 0x0a, 0xde, 0x90, 0x3d, 0x3b, 0x93, 0x8e, 0x46,
+	0x00, 0xeb, 0x53, 0xdc, 0x22, 0x1d, 0xf2, 0x67, 0xe4, 0x52, 0x68, 0xa4, 0x82, 0xf9, 0x27, 0x7a,
+	0x02, 0xc5, 0x73, 0x6b, 0x34, 0x26, 0x82, 0xe5, 0xea, 0xf6, 0x0f, 0xde, 0x66, 0x1e, 0xca, 0x44,
+	0x27, 0x72, 0xc0, 0x72, 0xfe, 0xfd, 0xdc, 0x67, 0x9a, 0x71, 0x0f, 0xaa, 0x09, 0xbe, 0x51, 0x0d,
+	0xe0, 0xb8, 0xbb, 0xd3, 0xe9, 0x77, 0xda, 0xfd, 0xce, 0x8e, 0x7e, 0x0d, 0xad, 0x41, 0xe5, 0xb8,
+	0xbb, 0xdb, 0x69, 0xed, 0xf7, 0x77, 0x9f, 0xeb, 0x1a, 0xaa, 0xc2, 0x4a, 0xd4, 0xc8, 0x19, 0x17,
+	0x80, 0x30, 0x19, 0xf8, 0xe7, 0x24, 0xe4, 0x86, 0xac, 0xb4, 0x8a, 0xde, 0x83, 0x15, 0x66, 0xd1,
+	0x33, 0xd3, 0xb1, 0x15, 0xcf, 0x25, 0xde, 0xdc, 0xb3, 0xd1, 0x1e, 0x94, 0x4e, 0x2d, 0xcf, 0x1e,
+	0xbd, 0x9d, 0xef, 0xb4, 0xa8, 0x39, 0xf8, 0xae, 0x98, 0x88, 0x15, 0x00, 0xb7, 0xee, 0xd4, 0xca,
+	0x52, 0x01, 0xc6, 0x73, 0xd0, 0x7b, 0xcc, 0x0a, 0x59, 0x92, 0x9d, 0x0e, 0x14, 0xf8, 0xfa, 0x75,
+	0x6d, 0xe1, 0x35, 0xe5, 0xce, 0xc4, 0x62, 0xba, 0xf1, 0x7f, 0x39, 0xd8, 0x48, 0x60, 0x2b, 0x4b,
+	0x7d, 0x06, 0xa5, 0x90, 0xd0, 0xf1, 0x88, 0x09, 0xf8, 0xda, 0xf6, 0xc3, 0x8c, 0xf0, 0x33, 0x48,
+	0x4d, 0x2c, 0x60, 0xb0, 0x82, 0x43, 0x5b, 0xa0, 0xcb, 0x19, 0x26, 0x09, 0x43, 0x3f, 0x34, 0x5d,
+	0x3a, 0x14, 0x52, 0xab, 0xe0, 0x9a, 0xa4, 0x77, 0x38, 0xf9, 0x80, 0x0e, 0x13, 0x52, 0xcd, 0x5f,
+	0x51, 0xaa, 0xc8, 0x02, 0xdd, 0x23, 0xec, 0xb5, 0x1f, 0x9e, 0x99, 0x5c, 0xb4, 0xa1, 0x63, 0x93,
+	0x7a, 0x41, 0x80, 0x7e, 0x9a, 0x11, 0xb4, 0x2b, 0xa7, 0x1f, 0xaa, 0xd9, 0x78, 0xdd, 0x4b, 0x13,
+	0x8c, 0xef, 0x43, 0x49, 0xfe, 0x53, 0x6e, 0x49, 0xbd, 0xe3, 0x76, 0xbb, 0xd3, 0xeb, 0xe9, 0xd7,
+	0x50, 0x05, 0x8a, 0xb8, 0xd3, 0xc7, 0xdc, 0xc2, 0x2a, 0x50, 0x7c, 0xdc, 0xea, 0xb7, 0xf6, 0xf5,
+	0x9c, 0xf1, 0x3d, 0x58, 0x7f, 0x66, 0x39, 0x2c, 0x8b, 0x71, 0x19, 0x3e, 0xe8, 0x93, 0xb1, 0x4a,
+	0x3b, 0x7b, 0x29, 0xed, 0x64, 0x17, 0x4d, 0xe7, 0xc2, 0x61, 0x53, 0xfa, 0xd0, 0x21, 0x4f, 0xc2,
+	0x50, 0xa9, 0x80, 0x7f, 0x1a, 0xaf, 0x61, 0xbd, 0xc7, 0xfc, 0x20, 0x93, 0xe5, 0xff, 0x10, 0x56,
+	0x78, 0xb4, 0xf1, 0xc7, 0x4c, 0x99, 0xfe, 0xad, 0xa6, 0x8c, 0x46, 0xcd, 0x28, 0x1a, 0x35, 0x77,
+	0x54, 0xb4, 0xc2, 0xd1, 0x48, 0x74, 0x13, 0x4a, 0xd4, 0x19, 0x7a, 0xd6, 0x48, 0x79, 0x0b, 0xd5,
+	0x32, 0x10, 0xe8, 0x93, 0x85, 0x95, 0xe1, 0xb7, 0x01, 0xed, 0x10, 0xca, 0x42, 0xff, 0x32, 0x13,
+	0x3f, 0x9b, 0x50, 0x7c, 0xe9, 0x87, 0x03, 0xb9, 0x11, 0xcb, 0x58, 0x36, 0xf8, 0xa6, 0x4a, 0x81,
+	0x28, 0xec, 0x8f, 0x01, 0xed, 0x79, 0x3c, 0xa6, 0x64, 0x53, 0xc4, 0x3f, 0xe4, 0xe0, 0x7a, 0x6a,
+	0xbc, 0x52, 0xc6, 0xf2, 0xfb, 0x90, 0x3b, 0xa6, 0x31, 0x95, 0xfb, 0x10, 0x1d, 0x42, 0x49, 0x8e,
+	0x50, 0x92, 0xbc, 0xbb, 0x00, 0x90, 0x0c, 0x53, 0x0a, 0x4e, 0xc1, 0xcc, 0x35, 0xfa, 0xfc, 0xbb,
+	0x35, 0xfa, 0xd7, 0xa0, 0x47, 0xff, 0x83, 0xbe, 0x55, 0x37, 0x5f, 0xc2, 0xf5, 0x81, 0x3f, 0x1a,
+	0x91, 0x01, 0xb7, 0x06, 0xd3, 0xf1, 0x18, 0x09, 0xcf, 0xad, 0xd1, 0xdb, 0xed, 0x06, 0x4d, 0x66,
+	0xed, 0xa9, 0x49, 0xc6, 0x0b, 0xd8, 0x48, 0x2c, 0xac, 0x14, 0xf1, 0x18, 0x8a, 0x94, 0x13, 0x94,
+	0x26, 0x3e, 0x59, 0x50, 0x13, 0x14, 0xcb, 0xe9, 0xc6, 0x75, 0x09, 0xde, 0x39, 0x27, 0x5e, 0xfc,
+	0xb7, 0x8c, 0x1d, 0xd8, 0xe8, 0x09, 0x33, 0xcd, 0x64, 0x87, 0x13, 0x13, 0xcf, 0xa5, 0x4c, 0x7c,
+	0x13, 0x50, 0x12, 0x45, 0x19, 0xe2, 0x25, 0xac, 0x77, 0x2e, 0xc8, 0x20, 0x13, 0x72, 0x1d, 0x56,
+	0x06, 0xbe, 0xeb, 0x5a, 0x9e, 0x5d, 0xcf, 0xdd, 0xce, 0x6f, 0x55, 0x70, 0xd4, 0x4c, 0xee, 0xc5,
+	0x7c, 0xd6, 0xbd, 0x68, 0xfc, 0x9d, 0x06, 0xfa, 0x64, 0x6d, 0x25, 0x48, 0xce, 0x3d, 0xb3, 0x39,
+	0x10, 0x5f, 0x7b, 0x15, 0xab, 0x96, 0xa2, 0x47, 0xee, 0x42, 0xd2, 0x49, 0x18, 0x26, 0xdc, 0x51,
+	0xfe, 0x8a, 0xee, 0xc8, 0xd8, 0x85, 0xdf, 0x8a, 0xd8, 0xe9, 0xb1, 0x90, 0x58, 0xae, 0xe3, 0x0d,
+	0xf7, 0x0e, 0x0f, 0x03, 0x22, 0x19, 0x47, 0x08, 0x0a, 0xb6, 0xc5, 0x2c, 0xc5, 0x98, 0xf8, 0xe6,
+	0x9b, 0x7e, 0x30, 0xf2, 0x69, 0xbc, 0xe9, 0x45, 0xc3, 0xf8, 0xcf, 0x3c, 0xd4, 0x67, 0xa0, 0x22,
+	0xf1, 0xbe, 0x80, 0x22, 0x25, 0x6c, 0x1c, 0x28, 0x53, 0xe9, 0x64, 0x66, 0x78, 0x3e, 0x5e, 0xb3,
+	0xc7, 0xc1, 0xb0, 0xc4, 0x44, 0x43, 0x28, 0x33, 0x76, 0x69, 0x52, 0xe7, 0xa7, 0x51, 0x42, 0xb0,
+	0x7f, 0x55, 0xfc, 0x3e, 0x09, 0x5d, 0xc7, 0xb3, 0x46, 0x3d, 0xe7, 0xa7, 0x04, 0xaf, 0x30, 0x76,
+	0xc9, 0x3f, 0xd0, 0x73, 0x6e, 0xf0, 0xb6, 0xe3, 0x29, 0xb1, 0xb7, 0x97, 0x5d, 0x25, 0x21, 0x60,
+	0x2c, 0x11, 0x1b, 0xfb, 0x50, 0x14, 0xff, 0x69, 0x19, 0x43, 0xd4, 0x21, 0xcf, 0xd8, 0xa5, 0x60,
+	0xaa, 0x8c, 0xf9, 0x67, 0xe3, 0x01, 0xac, 0x26, 0xff, 0x01, 0x37, 0xa4, 0x53, 0xe2, 0x0c, 0x4f,
+	0xa5, 0x81, 0x15, 0xb1, 0x6a, 0x71, 0x4d, 0xbe, 0x76, 0x6c, 0x95, 0xb2, 0x16, 0xb1, 0x6c, 0x18,
+	0xff, 0x9e, 0x83, 0x5b, 0x73, 0x24, 0xa3, 0x8c, 0xf5, 0x45, 0xca, 0x58, 0xdf, 0x91, 0x14, 0x22,
+	0x8b, 0x7f, 0x91, 0xb2, 0xf8, 0x77, 0x08, 0xce, 0xb7, 0xcd, 0x4d, 0x28, 0x91, 0x0b, 0x87, 0x11,
+	0x5b, 0x89, 0x4a, 0xb5, 0x12, 0xdb, 0xa9, 0x70, 0xd5, 0xed, 0x74, 0x00, 0x9b, 0xed, 0x90, 0x58,
+	0x8c, 0x28, 0x57, 0x1e, 0xd9, 0xff, 0x2d, 0x28, 0x5b, 0xa3, 0x91, 0x3f, 0x98, 0xa8, 0x75, 0x45,
+	0xb4, 0xf7, 0x6c, 0xd4, 0x80, 0xf2, 0xa9, 0x4f, 0x99, 0x67, 0xb9, 0x44, 0x39, 0xaf, 0xb8, 0x6d,
+	0x7c, 0xab, 0xc1, 0x8d, 0x29, 0x3c, 0xa5, 0x85, 0x13, 0xa8, 0x39, 0xd4, 0x1f, 0x89, 0x3f, 0x68,
+	0x26, 0x4e, 0x78, 0x3f, 0x5a, 0x2c, 0xd4, 0xec, 0x45, 0x18, 0xe2, 0xc0, 0xb7, 0xe6, 0x24, 0x9b,
+	0xc2, 0xe2, 0xc4, 0xe2, 0xb6, 0xda, 0xe9, 0x51, 0xd3, 0xf8, 0x47, 0x0d, 0x6e, 0xa8, 0x08, 0x9f,
+	0xfd, 0x8f, 0xce, 0xb2, 0x9c, 0x7b, 0xd7, 0x2c, 0x1b, 0x75, 0xb8, 0x39, 0xcd, 0x97, 0xf2, 0xf9,
+	0x7f, 0xa5, 0x41, 0xe3, 0x38, 0xb0, 0x2d, 0x46, 0x94, 0xeb, 0xf5, 0xc7, 0xe1, 0x80, 0xbc, 0x3d,
+	0x8a, 0x76, 0xa1, 0x12, 0x46, 0x83, 0xeb, 0xb9, 0x85, 0x02, 0xdd, 0x64, 0x91, 0x09, 0x84, 0xf1,
+	0x1d, 0x78, 0x7f, 0x2e, 0x1b, 0x8a, 0xcd, 0xff, 0x2f, 0x00, 0x9a, 0x3d, 0x04, 0xa3, 0xef, 0xc2,
+	0x2a, 0x25, 0x9e, 0x6d, 0xca, 0xb0, 0x26, 0x23, 0x6e, 0x19, 0x57, 0x39, 0x4d, 0xc6, 0x37, 0xca,
+	0x3d, 0x35, 0xb9, 0x50, 0x42, 0x2d, 0x63, 0xf1, 0x8d, 0x4e, 0x61, 0xf5, 0x25, 0x35, 0x63, 0x11,
+	0x09, 0xbb, 0xaf, 0x65, 0xf6, 0xbe, 0xb3, 0x7c, 0x34, 0x1f, 0xf7, 0x62, 0xf1, 0xe3, 0xea, 0x4b,
+	0x1a, 0x37, 0xd0, 0x37, 0x1a, 0xbc, 0x17, 0x65, 0x3f, 0x13, 0x2d, 0xbb, 0xbe, 0x4d, 0x68, 0xbd,
+	0x70, 0x3b, 0xbf, 0x55, 0xdb, 0x3e, 0xba, 0x82, 0x9a, 0x67, 0x88, 0x07, 0xbe, 0x4d, 0xf0, 0x0d,
+	0x6f, 0x0e, 0x95, 0xa2, 0x26, 0x5c, 0x77, 0xc7, 0x94, 0x99, 0xd2, 0x58, 0x4d, 0x35, 0xa8, 0x5e,
+	0x14, 0x72, 0xd9, 0xe0, 0x5d, 0xa9, 0x2d, 0x85, 0xce, 0x60, 0xcd, 0xf5, 0xc7, 0x1e, 0x33, 0x07,
+	0xe2, 0x98, 0x46, 0xeb, 0xa5, 0x85, 0xce, 0xef, 0x73, 0xa4, 0x74, 0xc0, 0xe1, 0xe4, 0xa1, 0x8f,
+	0xe2, 0x55, 0x37, 0xd1, 0xe2, 0x8a, 0x0c, 0x89, 0xeb, 0x33, 0x62, 0x72, 0xfb, 0xa2, 0xf5, 0x15,
+	0xa9, 0x48, 0x49, 0xe3, 0x26, 0x41, 0x8d, 0x26, 0x54, 0x13, 0x62, 0x46, 0x65, 0x28, 0x74, 0x0f,
+	0xbb, 0x1d, 0xfd, 0x1a, 0x02, 0x28, 0xb5, 0x77, 0xf1, 0xe1, 0x61, 0x5f, 0x1e, 0x6e, 0xf6, 0x0e,
+	0x5a, 0x4f, 0x3a, 0x7a, 0xce, 0xe8, 0xc0, 0x6a, 0x72, 0x41, 0x84, 0xa0, 0x76, 0xdc, 0x7d, 0xda,
+	0x3d, 0x7c, 0xd6, 0x35, 0x0f, 0x0e, 0x8f, 0xbb, 0x7d, 0x7e, 0x2c, 0xaa, 0x01, 0xb4, 0xba, 0xcf,
+	0x27, 0xed, 0x35, 0xa8, 0x74, 0x0f, 0xa3, 0xa6, 0xd6, 0xc8, 0xe9, 0x9a, 0xf1, 0xab, 0x3c, 0x6c,
+	0xce, 0x93, 0x3d, 0xb2, 0xa1, 0xc0, 0xf5, 0xa8, 0x0e, 0xa6, 0xef, 0x5e, 0x8d, 0x02, 0x9d, 0x9b,
+	0x6f, 0x60, 0xa9, 0x48, 0x54, 0xc1, 0xe2, 0x1b, 0x99, 0x50, 0x1a, 0x59, 0x27, 0x64, 0x44, 0xeb,
+	0x79, 0x71, 0x75, 0xf3, 0xe4, 0x2a, 0x6b, 0xef, 0x0b, 0x24, 0x79, 0x6f, 0xa3, 0x60, 0x51, 0x1f,
+	0xaa, 0xdc, 0xd7, 0x52, 0x29, 0x3a, 0xe5, 0xfe, 0xb7, 0x33, 0xae, 0xb2, 0x3b, 0x99, 0x89, 0x93,
+	0x30, 0x8d, 0x7b, 0x50, 0x4d, 0x2c, 0x36, 0xe7, 0xda, 0x65, 0x33, 0x79, 0xed, 0x52, 0x49, 0xde,
+	0xa1, 0x3c, 0x84, 0xcd, 0x79, 0x32, 0xe2, 0x46, 0xb0, 0x7b, 0xd8, 0xeb, 0xcb, 0x03, 0xee, 0x13,
+	0x7c, 0x78, 0x7c, 0xa4, 0x6b, 0x9c, 0xd8, 0x6f, 0xf5, 0x9e, 0xea, 0xb9, 0xd8, 0x46, 0xf2, 0x46,
+	0x1b, 0xaa, 0x09, 0xbe, 0x52, 0xc1, 0x45, 0x4b, 0x07, 0x17, 0xee, 0xde, 0x2d, 0xdb, 0x0e, 0x09,
+	0xa5, 0x8a, 0x8f, 0xa8, 0x69, 0xbc, 0x80, 0xca, 0x4e, 0xb7, 0xa7, 0x20, 0xea, 0xb0, 0x42, 0x49,
+	0xc8, 0xff, 0xb7, 0xb8, 0x40, 0xab, 0xe0, 0xa8, 0xc9, 0xc1, 0x29, 0xb1, 0xc2, 0xc1, 0xa9, 0xf0,
+	0x8c, 0xbc, 0x2b, 0x6e, 0xf3, 0x59, 0xbe, 0xb8, 0x88, 0x92, 0xba, 0xab, 0xe0, 0xa8, 0x69, 0xfc,
+	0xef, 0x0a, 0xc0, 0xe4, 0x52, 0x04, 0xd5, 0x20, 0x17, 0xfb, 0xdc, 0x9c, 0x63, 0x73, 0x3b, 0x48,
+	0x84, 0x42, 0xf1, 0x8d, 0xb6, 0xe1, 0x86, 0x4b, 0x87, 0x81, 0x35, 0x38, 0x33, 0xd5, 0x5d, 0x86,
+	0xdc, 0xaa, 0xc2, 0x9f, 0xad, 0xe2, 0xeb, 0xaa, 0x53, 0xed, 0x44, 0x89, 0xbb, 0x0f, 0x79, 0xe2,
+	0x9d, 0x0b, 0xdf, 0x53, 0xdd, 0xbe, 0xbf, 0xf0, 0x65, 0x4d, 0xb3, 0xe3, 0x9d, 0x4b, 0x5b, 0xe1,
+	0x30, 0xc8, 0x04, 0xb0, 0xc9, 0xb9, 0x33, 0x20, 0x26, 0x07, 0x2d, 0x0a, 0xd0, 0x2f, 0x16, 0x07,
+	0xdd, 0x11, 0x18, 0x31, 0x74, 0xc5, 0x8e, 0xda, 0xe9, 0x30, 0x53, 0xba, 0x72, 0x98, 0x41, 0x3b,
+	0x50, 0x12, 0x7e, 0x87, 0x7b, 0x98, 0xfc, 0x6f, 0xbc, 0xf9, 0x4d, 0x83, 0x09, 0x4f, 0x82, 0xd5,
+	0x5c, 0xf4, 0x04, 0x56, 0x24, 0x8b, 0xb4, 0x5e, 0x16, 0x30, 0x1f, 0x67, 0x75, 0x8a, 0x62, 0x16,
+	0x8e, 0x66, 0x73, 0xad, 0x8e, 0x29, 0x09, 0xeb, 0x15, 0xa9, 0x55, 0xfe, 0x8d, 0xde, 0x87, 0x8a,
+	0x4c, 0x15, 0x6c, 0x27, 0xac, 0x83, 0x34, 0x4e, 0x41, 0xd8, 0x71, 0x42, 0xf4, 0x01, 0x54, 0x65,
+	0x4a, 0x68, 0x0a, 0xaf, 0x50, 0x15, 0xdd, 0x20, 0x49, 0x47, 0xdc, 0x37, 0xc8, 0x01, 0x24, 0x0c,
+	0xe5, 0x80, 0xd5, 0x78, 0x00, 0x09, 0x43, 0x31, 0xe0, 0x77, 0x61, 0x5d, 0x44, 0xf4, 0x61, 0xe8,
+	0x8f, 0x03, 0x53, 0xd8, 0xd4, 0x9a, 0x18, 0xb4, 0xc6, 0xc9, 0x4f, 0x38, 0xb5, 0xcb, 0x8d, 0xeb,
+	0x16, 0x94, 0x5f, 0xf9, 0x27, 0x72, 0x40, 0x4d, 0xee, 0x83, 0x57, 0xfe, 0x49, 0xd4, 0x15, 0x27,
+	0x33, 0xeb, 0xe9, 0x64, 0xe6, 0x6b, 0xb8, 0x39, 0x1b, 0xee, 0x44, 0x52, 0xa3, 0x5f, 0x3d, 0xa9,
+	0xd9, 0xf4, 0xe6, 0x50, 0xd1, 0x23, 0xc8, 0xdb, 0x1e, 0xad, 0x6f, 0x2c, 0x64, 0x1c, 0xf1, 0x3e,
+	0xc6, 0x7c, 0x72, 0xe3, 0x53, 0x28, 0x47, 0xd6, 0xb7, 0x88, 0x5f, 0x6a, 0x3c, 0x80, 0x5a, 0xda,
+	0x76, 0x17, 0xf2, 0x6a, 0xff, 0x9a, 0x83, 0x4a, 0x6c, 0xa5, 0xc8, 0x83, 0xeb, 0x42, 0x8a, 0x16,
+	0x23, 0xb6, 0x39, 0x31, 0x7a, 0x99, 0xbf, 0x7e, 0x9e, 0xf1, 0x7f, 0xb5, 0x22, 0x84, 0x74, 0x1a,
+	0x85, 0x62, 0xe4, 0xc9, 0x7a, 0x5f, 0xc1, 0xfa, 0xc8, 0xf1, 0xc6, 0x17, 0xe6, 0x74, 0x1e, 0xf7,
+	0xfb, 0x19, 0xd7, 0xda, 0xe7, 0xb3, 0x27, 0x6b, 0xd4, 0x46, 0xa9, 0x36, 0xda, 0x85, 0x62, 0xe0,
+	0x87, 0x2c, 0x0a, 0x52, 0x59, 0xc3, 0xc7, 0x91, 0x1f, 0xb2, 0x03, 0x2b, 0x08, 0xf8, 0xd9, 0x4a,
+	0x02, 0x18, 0xdf, 0xe6, 0xe0, 0xe6, 0xfc, 0x3f, 0x86, 0xba, 0x90, 0x1f, 0x04, 0x63, 0x25, 0xa4,
+	0x07, 0x8b, 0x0a, 0xa9, 0x1d, 0x8c, 0x27, 0xfc, 0x73, 0x20, 0x7e, 0xdf, 0xec, 0x12, 0xd7, 0x0f,
+	0x2f, 0x95, 0x2c, 0x1e, 0x2e, 0x0a, 0x79, 0x20, 0x66, 0x4f, 0x50, 0x15, 0x1c, 0xc2, 0x50, 0x56,
+	0xd6, 0x4b, 0x95, 0x9f, 0x5c, 0xf0, 0xf6, 0x2b, 0x82, 0xc4, 0x31, 0x8e, 0xf1, 0x29, 0xdc, 0x98,
+	0xfb, 0x57, 0xd0, 0x77, 0x00, 0x06, 0xc1, 0xd8, 0x14, 0xaf, 0x13, 0xd2, 0x82, 0xf2, 0xb8, 0x32,
+	0x08, 0xc6, 0x3d, 0x41, 0x30, 0x5e, 0x40, 0xfd, 0x4d, 0xfc, 0x72, 0xef, 0x23, 0x39, 0x36, 0xdd,
+	0x13, 0x21, 0x83, 0x3c, 0x2e, 0x4b, 0xc2, 0xc1, 0x09, 0x32, 0x60, 0x2d, 0xea, 0xb4, 0x2e, 0xf8,
+	0x80, 0xbc, 0x18, 0x50, 0x55, 0x03, 0xac, 0x8b, 0x83, 0x13, 0xe3, 0xe7, 0x39, 0x58, 0x9f, 0x62,
+	0x99, 0x9f, 0x30, 0xa5, 0xc7, 0x8b, 0x0e, 0x11, 0xb2, 0xc5, 0xdd, 0xdf, 0xc0, 0xb1, 0xa3, 0x5b,
+	0x5f, 0xf1, 0x2d, 0x02, 0x5f, 0xa0, 0x6e, 0x64, 0x73, 0x4e, 0xc0, 0xb7, 0x8f, 0x7b, 0xe2, 0x30,
+	0x2a, 0xb2, 0x90, 0x22, 0x96, 0x0d, 0xf4, 0x1c, 0x6a, 0x21, 0x11, 0x01, 0xd7, 0x36, 0xa5, 0x95,
+	0x15, 0x17, 0xb2, 0x32, 0xc5, 0x21, 0x37, 0x36, 0xbc, 0x16, 0x21, 0xf1, 0x16, 0x45, 0xcf, 0x60,
+	0xcd, 0xbe, 0xf4, 0x2c, 0xd7, 0x19, 0x28, 0xe4, 0xd2, 0xd2, 0xc8, 0xab, 0x0a, 0x48, 0x00, 0xf3,
+	0x87, 0xa0, 0x44, 0x27, 0xff, 0x63, 0x22, 0xdd, 0x52, 0x32, 0x91, 0x8d, 0xb4, 0xb7, 0x28, 0x2a,
+	0x6f, 0x61, 0x9c, 0x40, 0x35, 0xb1, 0x2f, 0x16, 0x99, 0xca, 0xe5, 0xc9, 0x7c, 0x21, 0xcf, 0x22,
+	0xce, 0x31, 0x9f, 0x9f, 0xe8, 0x78, 0xaa, 0x63, 0x3a, 0x81, 0x90, 0x68, 0x05, 0x97, 0x78, 0x73,
+	0x2f, 0x30, 0x7e, 0x91, 0x83, 0x5a, 0x7a, 0x4b, 0x47, 0x76, 0x14, 0x90, 0xd0, 0xf1, 0xed, 0x84,
+	0x1d, 0x1d, 0x09, 0x02, 0xb7, 0x15, 0xde, 0xfd, 0xf5, 0xd8, 0x67, 0x56, 0x64, 0x2b, 0x83, 0x60,
+	0xfc, 0x07, 0xbc, 0x3d, 0x65, 0x83, 0xf9, 0x29, 0x1b, 0x44, 0x1f, 0x01, 0x52, 0xa6, 0x34, 0x72,
+	0x5c, 0x87, 0x99, 0x27, 0x97, 0x8c, 0x48, 0x1d, 0xe7, 0xb1, 0x2e, 0x7b, 0xf6, 0x79, 0xc7, 0x23,
+	0x4e, 0xe7, 0x86, 0xe7, 0xfb, 0xae, 0x49, 0x07, 0x7e, 0x48, 0x4c, 0xcb, 0x7e, 0x25, 0x4e, 0x2d,
+	0x79, 0x5c, 0xf5, 0x7d, 0xb7, 0xc7, 0x69, 0x2d, 0xfb, 0x15, 0x8f, 0x7c, 0x83, 0x60, 0x4c, 0x09,
+	0x33, 0xf9, 0x8f, 0x48, 0x16, 0x2a, 0x18, 0x24, 0xa9, 0x1d, 0x8c, 0x29, 0xfa, 0x1d, 0x58, 0x8b,
+	0x06, 0x88, 0xe0, 0xa7, 0xa2, 0xee, 0xaa, 0x1a, 0x22, 0x68, 0xc8, 0x80, 0xd5, 0x23, 0x12, 0x0e,
+	0x88, 0xc7, 0xfa, 0xce, 0xe0, 0x8c, 0xc7, 0x77, 0x6d, 0x4b, 0xc3, 0x29, 0xda, 0x97, 0x85, 0xf2,
+	0x8a, 0x5e, 0xc6, 0xd1, 0x6a, 0x2e, 0x71, 0xa9, 0xf1, 0x8d, 0x06, 0x45, 0x91, 0x23, 0x70, 0xa1,
+	0x88, 0xf8, 0x2a, 0xc2, 0xaf, 0xca, 0x2d, 0x39, 0x41, 0x04, 0xdf, 0xf7, 0xa1, 0x22, 0x84, 0x9f,
+	0x48, 0xe9, 0x45, 0xe2, 0x29, 0x3a, 0x1b, 0x50, 0x0e, 0x89, 0x65, 0xfb, 0xde, 0x28, 0xba, 0xb4,
+	0x8a, 0xdb, 0xe8, 0xf7, 0x40, 0x0f, 0x42, 0x3f, 0xb0, 0x86, 0x93, 0x03, 0xa4, 0x52, 0xdf, 0x7a,
+	0x82, 0xce, 0x73, 0x62, 0xe3, 0x6b, 0x28, 0xc9, 0x98, 0x74, 0x05, 0x56, 0x3e, 0x06, 0x24, 0x65,
+	0xc4, 0x75, 0xef, 0x3a, 0x94, 0xaa, 0x8c, 0x55, 0x3c, 0xaa, 0xca, 0x9e, 0xa3, 0x49, 0x87, 0xf1,
+	0x5f, 0x1a, 0xc0, 0xe4, 0xb9, 0x8b, 0x27, 0xb9, 0x7c, 0x43, 0xf0, 0x93, 0xb5, 0xbc, 0x57, 0x8b,
+	0x9a, 0xfc, 0x4a, 0x49, 0xa5, 0xa8, 0xb9, 0x65, 0x5f, 0x0b, 0x15, 0x40, 0x74, 0xcb, 0x4e, 0xd4,
+	0xe1, 0x7d, 0xd1, 0x5b, 0x76, 0x22, 0x6f, 0xd9, 0x09, 0x3f, 0x79, 0xaa, 0xe4, 0x59, 0xc2, 0x15,
+	0x44, 0xee, 0x5c, 0xb5, 0xe3, 0xa7, 0x0c, 0x62, 0xfc, 0x8f, 0x16, 0xbb, 0xb4, 0xe8, 0xc9, 0x01,
+	0x7d, 0x05, 0x65, 0xee, 0x1d, 0x4c, 0xd7, 0x0a, 0xd4, 0x03, 0x7a, 0x7b, 0xb9, 0xd7, 0x8c, 0x28,
+	0xe0, 0xc9, 0xd4, 0x77, 0x25, 0x90, 0x2d, 0xee, 0x1a, 0xf9, 0xb1, 0x23, 0x72, 0x8d, 0xfc, 0x1b,
+	0x7d, 0x08, 0x35, 0x6b, 0xcc, 0x7c, 0xd3, 0xb2, 0xcf, 0x49, 0xc8, 0x1c, 0x4a, 0x94, 0x99, 0xac,
+	0x71, 0x6a, 0x2b, 0x22, 0x36, 0xee, 0xc3, 0x6a, 0x12, 0xf3, 0x6d, 0x29, 0x49, 0x31, 0x99, 0x92,
+	0xfc, 0x09, 0xc0, 0xe4, 0xfa, 0x8e, 0xdb, 0x08, 0xbf, 0x0b, 0x34, 0x07, 0xd1, 0x39, 0xb7, 0x88,
+	0xcb, 0x9c, 0xd0, 0xe6, 0x67, 0xaf, 0xf4, 0xdb, 0x42, 0x31, 0x7a, 0x5b, 0xe0, 0x1b, 0x9f, 0xef,
+	0xd5, 0x33, 0x67, 0x34, 0x8a, 0xaf, 0x14, 0x2b, 0xbe, 0xef, 0x3e, 0x15, 0x04, 0xe3, 0x97, 0x39,
+	0x69, 0x2b, 0xf2, 0x95, 0x28, 0xd3, 0x39, 0xe7, 0x5d, 0xa9, 0xfa, 0x1e, 0x00, 0x65, 0x56, 0xc8,
+	0xf3, 0x2b, 0x2b, 0xba, 0xd4, 0x6c, 0xcc, 0x3c, 0x4e, 0xf4, 0xa3, 0xb2, 0x15, 0x5c, 0x51, 0xa3,
+	0x5b, 0x0c, 0x7d, 0x0e, 0xab, 0x03, 0xdf, 0x0d, 0x46, 0x44, 0x4d, 0x2e, 0xbe, 0x75, 0x72, 0x35,
+	0x1e, 0xdf, 0x62, 0x89, 0xab, 0xd4, 0xd2, 0x55, 0xaf, 0x52, 0x7f, 0xa1, 0xc9, 0xc7, 0xae, 0xe4,
+	0x5b, 0x1b, 0x1a, 0xce, 0x29, 0xe8, 0x78, 0xb2, 0xe4, 0xc3, 0xdd, 0x6f, 0xaa, 0xe6, 0x68, 0x7c,
+	0x9e, 0xa5, 0x7c, 0xe2, 0xcd, 0x19, 0xef, 0x7f, 0xe4, 0xa1, 0x12, 0xa9, 0x65, 0x56, 0xf7, 0x9f,
+	0x41, 0x25, 0xae, 0x19, 0xaa, 0xe7, 0xde, 0x2a, 0xe1, 0xc9, 0x60, 0xf4, 0x12, 0x90, 0x35, 0x1c,
+	0xc6, 0x99, 0xac, 0x39, 0xa6, 0xd6, 0x30, 0x7a, 0x65, 0xfc, 0x6c, 0x01, 0x39, 0x44, 0xa1, 0xef,
+	0x98, 0xcf, 0xc7, 0xba, 0x35, 0x1c, 0xa6, 0x28, 0xe8, 0x4f, 0xe1, 0x46, 0x7a, 0x0d, 0xf3, 0xe4,
+	0xd2, 0x0c, 0x1c, 0x5b, 0x9d, 0xa7, 0x77, 0x17, 0x7d, 0xea, 0x6b, 0xa6, 0xe0, 0x1f, 0x5d, 0x1e,
+	0x39, 0xb6, 0x94, 0x39, 0x0a, 0x67, 0x3a, 0x1a, 0x7f, 0x0e, 0xef, 0xbd, 0x61, 0xf8, 0x1c, 0x1d,
+	0x74, 0xd3, 0x25, 0x2c, 0xcb, 0x0b, 0x21, 0xa1, 0xbd, 0x7f, 0xd1, 0x60, 0x63, 0x66, 0x00, 0x6a,
+	0x25, 0x53, 0xf0, 0x3b, 0x19, 0xd7, 0x69, 0x1f, 0x1d, 0x4b, 0x78, 0x3e, 0x17, 0x7d, 0x39, 0x95,
+	0x75, 0x67, 0xcd, 0xb5, 0x64, 0xf2, 0x2a, 0x81, 0x14, 0x82, 0xf1, 0x6f, 0x79, 0x28, 0x47, 0xe8,
+	0xe2, 0x34, 0x7c, 0x49, 0x19, 0x71, 0xcd, 0xf8, 0xaa, 0x4e, 0xc3, 0x20, 0x49, 0xe2, 0x02, 0xe9,
+	0x7d, 0xa8, 0x8c, 0x29, 0x09, 0x65, 0x77, 0x4e, 0x74, 0x97, 0x39, 0x41, 0x74, 0x7e, 0x00, 0x55,
+	0xe6, 0x33, 0x6b, 0x64, 0x32, 0x91, 0x0a, 0xe4, 0xe5, 0x6c, 0x41, 0x12, 0x89, 0x00, 0xfa, 0x3e,
+	0x6c, 0xb0, 0xd3, 0xd0, 0x67, 0x6c, 0xc4, 0xd3, 0x50, 0x91, 0x14, 0xc9, 0x1c, 0xa6, 0x80, 0xf5,
+	0xb8, 0x43, 0x26, 0x4b, 0x94, 0x7b, 0xef, 0xc9, 0x60, 0x6e, 0xba, 0xc2, 0x89, 0x14, 0xf0, 0x5a,
+	0x4c, 0xe5, 0xa6, 0xcd, 0x83, 0x67, 0x20, 0x93, 0x0d, 0xe1, 0x2b, 0x34, 0x1c, 0x35, 0x91, 0x09,
+	0xeb, 0x2e, 0xb1, 0xe8, 0x38, 0x24, 0xb6, 0xf9, 0xd2, 0x21, 0x23, 0x5b, 0x5e, 0x62, 0xd4, 0x32,
+	0x9f, 0x24, 0x22, 0xb1, 0x34, 0x1f, 0x8b, 0xd9, 0xb8, 0x16, 0xc1, 0xc9, 0x36, 0xcf, 0x1c, 0xe4,
+	0x17, 0x5a, 0x87, 0x6a, 0xef, 0x79, 0xaf, 0xdf, 0x39, 0x30, 0x0f, 0x0e, 0x77, 0x3a, 0xaa, 0x4a,
+	0xa9, 0xd7, 0xc1, 0xb2, 0xa9, 0xf1, 0xfe, 0xfe, 0x61, 0xbf, 0xb5, 0x6f, 0xf6, 0xf7, 0xda, 0x4f,
+	0x7b, 0x7a, 0x0e, 0xdd, 0x80, 0x8d, 0xfe, 0x2e, 0x3e, 0xec, 0xf7, 0xf7, 0x3b, 0x3b, 0xe6, 0x51,
+	0x07, 0xef, 0x1d, 0xee, 0xf4, 0xf4, 0x3c, 0xbf, 0x73, 0x9d, 0x90, 0xfb, 0x7b, 0x07, 0x1d, 0xbd,
+	0xc0, 0xeb, 0x52, 0x8e, 0x3a, 0xb8, 0xdd, 0xe9, 0xf6, 0xf5, 0xa2, 0xf1, 0xf3, 0x3c, 0x54, 0x13,
+	0x5a, 0xe4, 0x86, 0x1c, 0x52, 0x79, 0x64, 0x29, 0x60, 0xfe, 0x29, 0x5e, 0x55, 0xad, 0xc1, 0xa9,
+	0xd4, 0x4e, 0x01, 0xcb, 0x86, 0x38, 0xa6, 0x58, 0x17, 0x89, 0x7d, 0x5e, 0xc0, 0x65, 0xd7, 0xba,
+	0x90, 0x20, 0xdf, 0x85, 0xd5, 0x33, 0x12, 0x7a, 0x64, 0xa4, 0xfa, 0xa5, 0x46, 0xaa, 0x92, 0x26,
+	0x87, 0x6c, 0x81, 0xae, 0x86, 0x4c, 0x60, 0xa4, 0x3a, 0x6a, 0x92, 0x7e, 0x10, 0x81, 0x6d, 0x42,
+	0x51, 0x76, 0xaf, 0xc8, 0xf5, 0x45, 0x83, 0x87, 0x29, 0xfa, 0xda, 0x0a, 0x44, 0x7a, 0x58, 0xc0,
+	0xe2, 0x1b, 0x9d, 0xcc, 0xea, 0xa7, 0x24, 0xf4, 0x73, 0x6f, 0x71, 0x73, 0x7e, 0x93, 0x8a, 0x4e,
+	0x63, 0x15, 0xad, 0x40, 0x1e, 0x47, 0xa5, 0x3d, 0xed, 0x56, 0x7b, 0x97, 0xab, 0x65, 0x0d, 0x2a,
+	0x07, 0xad, 0x9f, 0x98, 0xc7, 0x3d, 0x71, 0x03, 0x8e, 0x74, 0x58, 0x7d, 0xda, 0xc1, 0xdd, 0xce,
+	0xbe, 0xa2, 0xe4, 0xd1, 0x26, 0xe8, 0x8a, 0x32, 0x19, 0x57, 0xe0, 0x08, 0xf2, 0xb3, 0xc8, 0x6f,
+	0x4c, 0x7b, 0xcf, 0x5a, 0x47, 0x7a, 0xc9, 0xf8, 0xef, 0x1c, 0xac, 0xcb, 0xb0, 0x10, 0x17, 0x21,
+	0xbc, 0xf9, 0x35, 0x28, 0x79, 0x23, 0x94, 0x4b, 0xdf, 0x08, 0x45, 0x49, 0xa8, 0x88, 0xea, 0xf9,
+	0x49, 0x12, 0x2a, 0x6e, 0x92, 0x52, 0x1e, 0xbf, 0xb0, 0x88, 0xc7, 0xaf, 0xc3, 0x8a, 0x4b, 0x68,
+	0xac, 0xb7, 0x0a, 0x8e, 0x9a, 0xc8, 0x81, 0xaa, 0xe5, 0x79, 0x3e, 0xb3, 0xe4, 0x35, 0x6b, 0x69,
+	0xa1, 0x60, 0x38, 0xf5, 0x8f, 0x9b, 0xad, 0x09, 0x92, 0x74, 0xcc, 0x49, 0xec, 0xc6, 0x8f, 0x41,
+	0x9f, 0x1e, 0xb0, 0x48, 0x38, 0xfc, 0xde, 0x0f, 0x26, 0xd1, 0x90, 0xf0, 0x7d, 0xa1, 0xde, 0x27,
+	0xf4, 0x6b, 0xbc, 0x81, 0x8f, 0xbb, 0xdd, 0xbd, 0xee, 0x13, 0x5d, 0xe3, 0x0f, 0x1c, 0x9d, 0x9f,
+	0xec, 0xf1, 0x72, 0xc1, 0xdc, 0xf6, 0xaf, 0x10, 0x94, 0x24, 0x93, 0xe8, 0x5b, 0x95, 0x09, 0x24,
+	0x0b, 0x5c, 0xd1, 0x8f, 0x17, 0xce, 0xa8, 0x53, 0x45, 0xb3, 0x8d, 0x87, 0x4b, 0xcf, 0x57, 0x2f,
+	0x75, 0xd7, 0xd0, 0xdf, 0x68, 0xb0, 0x9a, 0x7a, 0xa5, 0xcb, 0x7a, 0xcd, 0x3c, 0xa7, 0x9e, 0xb6,
+	0xf1, 0xa3, 0xa5, 0xe6, 0xc6, 0xbc, 0x7c, 0xa3, 0x41, 0x35, 0x51, 0x49, 0x8a, 0xee, 0x2d, 0x53,
+	0x7d, 0x2a, 0x39, 0xb9, 0xbf, 0x7c, 0xe1, 0xaa, 0x71, 0xed, 0x13, 0x0d, 0xfd, 0xb5, 0x06, 0xd5,
+	0x44, 0x4d, 0x65, 0x66, 0x56, 0x66, 0x2b, 0x40, 0x1b, 0xf7, 0x97, 0x99, 0x1a, 0xcb, 0xe4, 0x2f,
+	0x34, 0xa8, 0xc4, 0xf5, 0x91, 0xe8, 0xee, 0xe2, 0x15, 0x95, 0x92, 0x89, 0xcf, 0x96, 0x2d, 0xc5,
+	0x34, 0xae, 0xa1, 0x3f, 0x83, 0x72, 0x54, 0x4c, 0x88, 0xb2, 0x46, 0xaf, 0xa9, 0x4a, 0xc5, 0xc6,
+	0xdd, 0x85, 0xe7, 0x25, 0x97, 0x8f, 0x2a, 0xfc, 0x32, 0x2f, 0x3f, 0x55, 0x8b, 0xd8, 0xb8, 0xbb,
+	0xf0, 0xbc, 0x78, 0x79, 0x6e, 0x09, 0x89, 0x42, 0xc0, 0xcc, 0x96, 0x30, 0x5b, 0x81, 0xd8, 0xb8,
+	0xbf, 0xcc, 0xd4, 0x14, 0x23, 0x89, 0x52, 0xc2, 0xcc, 0x8c, 0xcc, 0x96, 0x2b, 0x36, 0xee, 0x2f,
+	0x33, 0x35, 0x66, 0xe4, 0x67, 0x5a, 0xf2, 0x5c, 0x70, 0x77, 0xe1, 0x8a, 0xb9, 0x05, 0x4d, 0x72,
+	0xa6, 0x66, 0x4f, 0x6c, 0xd0, 0x9f, 0xa9, 0x5b, 0x0c, 0x59, 0x70, 0x87, 0x16, 0x01, 0x4b, 0xd5,
+	0xe8, 0x35, 0x3e, 0x5d, 0x2e, 0xd8, 0x08, 0x26, 0xfe, 0x52, 0x03, 0x98, 0x94, 0xe6, 0x65, 0x66,
+	0x62, 0xa6, 0x26, 0xb0, 0x71, 0x6f, 0x89, 0x99, 0xc9, 0x0d, 0x12, 0x95, 0x0e, 0x65, 0xde, 0x20,
+	0x53, 0xa5, 0x83, 0x8d, 0xbb, 0x0b, 0xcf, 0x8b, 0x97, 0xff, 0x27, 0x0d, 0x36, 0x66, 0x4a, 0x97,
+	0xd0, 0xc3, 0x2b, 0x56, 0xaf, 0x35, 0xbe, 0x58, 0x1e, 0x20, 0x62, 0x6d, 0x4b, 0xfb, 0x44, 0x43,
+	0x7f, 0xab, 0xc1, 0x5a, 0xba, 0x56, 0x22, 0x73, 0x94, 0x9a, 0x53, 0x04, 0xd5, 0x78, 0xb0, 0xdc,
+	0xe4, 0x58, 0x5a, 0x7f, 0xaf, 0x41, 0x4d, 0xed, 0xef, 0x88, 0x9f, 0x07, 0x8b, 0xb9, 0x85, 0x29,
+	0x86, 0x3e, 0x5f, 0x72, 0x76, 0xcc, 0xd1, 0x3f, 0x6b, 0x70, 0x7d, 0x4e, 0x35, 0x0f, 0x6a, 0x65,
+	0x04, 0x7e, 0x73, 0x41, 0x52, 0xe3, 0xd1, 0x55, 0x20, 0x22, 0x06, 0x1f, 0xad, 0xfc, 0x61, 0x51,
+	0xa6, 0x97, 0x25, 0xf1, 0xf3, 0xc3, 0x5f, 0x0f, 0x00, 0xe1, 0xe8, 0x60, 0x79, 0x28, 0x35, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3998,6 +4082,9 @@ type DriverClient interface {
 	// DestroyNetwork destroys a previously created network. This rpc is only
 	// implemented if the driver needs to manage network namespace creation.
 	DestroyNetwork(ctx context.Context, in *DestroyNetworkRequest, opts ...grpc.CallOption) (*DestroyNetworkResponse, error)
+	// UpdateTaskResources changes the resources of a running task without
+	// restarting it.
+	UpdateTaskResources(ctx context.Context, in *UpdateTaskResourcesRequest, opts ...grpc.CallOption) (*UpdateTaskResourcesResponse, error)
 }
 
 type driverClient struct {
@@ -4243,6 +4330,15 @@ func (c *driverClient) DestroyNetwork(ctx context.Context, in *DestroyNetworkReq
 	return out, nil
 }
 
+func (c *driverClient) UpdateTaskResources(ctx context.Context, in *UpdateTaskResourcesRequest, opts ...grpc.CallOption) (*UpdateTaskResourcesResponse, error) {
+	out := new(UpdateTaskResourcesResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.nomad.plugins.drivers.proto.Driver/UpdateTaskResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DriverServer is the server API for Driver service.
 type DriverServer interface {
 	// TaskConfigSchema returns the schema for parsing the driver
@@ -4298,6 +4394,9 @@ type DriverServer interface {
 	// DestroyNetwork destroys a previously created network. This rpc is only
 	// implemented if the driver needs to manage network namespace creation.
 	DestroyNetwork(context.Context, *DestroyNetworkRequest) (*DestroyNetworkResponse, error)
+	// UpdateTaskResources changes the resources of a running task without
+	// restarting it.
+	UpdateTaskResources(context.Context, *UpdateTaskResourcesRequest) (*UpdateTaskResourcesResponse, error)
 }
 
 // UnimplementedDriverServer can be embedded to have forward compatible implementations.
@@ -4352,6 +4451,9 @@ func (*UnimplementedDriverServer) CreateNetwork(ctx context.Context, req *Create
 func (*UnimplementedDriverServer) DestroyNetwork(ctx context.Context, req *DestroyNetworkRequest) (*DestroyNetworkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyNetwork not implemented")
 }
+func (*UnimplementedDriverServer) UpdateTaskResources(ctx context.Context, req *UpdateTaskResourcesRequest) (*UpdateTaskResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskResources not implemented")
+}
 
 func RegisterDriverServer(s *grpc.Server, srv DriverServer) {
 	s.RegisterService(&_Driver_serviceDesc, srv)
@@ -4662,6 +4764,24 @@ func _Driver_DestroyNetwork_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Driver_UpdateTaskResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).UpdateTaskResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.nomad.plugins.drivers.proto.Driver/UpdateTaskResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).UpdateTaskResources(ctx, req.(*UpdateTaskResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Driver_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hashicorp.nomad.plugins.drivers.proto.Driver",
 	HandlerType: (*DriverServer)(nil),
@@ -4714,6 +4834,10 @@ var _Driver_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyNetwork",
 			Handler:    _Driver_DestroyNetwork_Handler,
 		},
+		{
+			MethodName: "UpdateTaskResources",
+			Handler:    _Driver_UpdateTaskResources_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -353,6 +353,20 @@ func (b *driverPluginServer) SignalTask(ctx context.Context, req *proto.SignalTa
 	return resp, nil
 }
 
+func (b *driverPluginServer) UpdateTaskResources(ctx context.Context, req *proto.UpdateTaskResourcesRequest) (*proto.UpdateTaskResourcesResponse, error) {
+	ru, ok := b.impl.(DriverResourceUpdater)
+	if !ok {
+		return nil, fmt.Errorf("UpdateTaskResources RPC not supported by driver")
+	}
+
+	err := ru.UpdateTaskResources(req.TaskId, ResourcesFromProto(req.Resources))
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.UpdateTaskResourcesResponse{}, nil
+}
+
 func (b *driverPluginServer) TaskEvents(req *proto.TaskEventsRequest, srv proto.Driver_TaskEventsServer) error {
 	ch, err := b.impl.TaskEvents(srv.Context())
 	if err != nil {
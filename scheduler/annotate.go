@@ -187,6 +187,12 @@ FieldsLoop:
 			switch oDiff.Name {
 			case "LogConfig", "Service", "Constraint":
 				continue
+			case "Resources":
+				if resourcesDiffInplace(oDiff) {
+					continue
+				}
+				destructive = true
+				break ObjectsLoop
 			default:
 				destructive = true
 				break ObjectsLoop
@@ -200,3 +206,44 @@ FieldsLoop:
 		diff.Annotations = append(diff.Annotations, AnnotationForcesInplaceUpdate)
 	}
 }
+
+// resourcesDiffInplace returns whether the resources diff of a task only
+// changes its cpu or memory in a way that resizeDestructive allows in place.
+// Fields missing from a non-contextual diff are unchanged and read as zero.
+func resourcesDiffInplace(diff *structs.ObjectDiff) bool {
+	if diff.Type != structs.DiffTypeEdited {
+		return false
+	}
+	for _, oDiff := range diff.Objects {
+		if oDiff.Type != structs.DiffTypeNone {
+			return false
+		}
+	}
+
+	old, new := &structs.Resources{}, &structs.Resources{}
+	for _, fDiff := range diff.Fields {
+		var oldField, newField *int
+		switch fDiff.Name {
+		case "CPU":
+			oldField, newField = &old.CPU, &new.CPU
+		case "MemoryMB":
+			oldField, newField = &old.MemoryMB, &new.MemoryMB
+		case "MemoryMaxMB":
+			oldField, newField = &old.MemoryMaxMB, &new.MemoryMaxMB
+		default:
+			if fDiff.Type == structs.DiffTypeNone {
+				continue
+			}
+			return false
+		}
+
+		var err error
+		if *oldField, err = strconv.Atoi(fDiff.Old); err != nil {
+			return false
+		}
+		if *newField, err = strconv.Atoi(fDiff.New); err != nil {
+			return false
+		}
+	}
+	return !resizeDestructive(old, new)
+}
@@ -252,6 +252,120 @@ func TestAnnotateTask(t *testing.T) {
 			Parent:  &structs.TaskGroupDiff{Type: structs.DiffTypeEdited},
 			Desired: AnnotationForcesDestructiveUpdate,
 		},
+		{
+			Diff: &structs.TaskDiff{
+				Type: structs.DiffTypeEdited,
+				Objects: []*structs.ObjectDiff{
+					{
+						Type: structs.DiffTypeEdited,
+						Name: "Resources",
+						Fields: []*structs.FieldDiff{
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "CPU",
+								Old:  "100",
+								New:  "200",
+							},
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "MemoryMB",
+								Old:  "100",
+								New:  "200",
+							},
+						},
+					},
+				},
+			},
+			Parent:  &structs.TaskGroupDiff{Type: structs.DiffTypeEdited},
+			Desired: AnnotationForcesInplaceUpdate,
+		},
+		{
+			Diff: &structs.TaskDiff{
+				Type: structs.DiffTypeEdited,
+				Objects: []*structs.ObjectDiff{
+					{
+						Type: structs.DiffTypeEdited,
+						Name: "Resources",
+						Fields: []*structs.FieldDiff{
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "MemoryMB",
+								Old:  "200",
+								New:  "100",
+							},
+						},
+					},
+				},
+			},
+			Parent:  &structs.TaskGroupDiff{Type: structs.DiffTypeEdited},
+			Desired: AnnotationForcesDestructiveUpdate,
+		},
+		{
+			Diff: &structs.TaskDiff{
+				Type: structs.DiffTypeEdited,
+				Objects: []*structs.ObjectDiff{
+					{
+						Type: structs.DiffTypeEdited,
+						Name: "Resources",
+						Fields: []*structs.FieldDiff{
+							{
+								Type: structs.DiffTypeNone,
+								Name: "CPU",
+								Old:  "500",
+								New:  "500",
+							},
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "MemoryMB",
+								Old:  "256",
+								New:  "1024",
+							},
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "MemoryMaxMB",
+								Old:  "1024",
+								New:  "0",
+							},
+						},
+						Objects: []*structs.ObjectDiff{
+							{
+								Type: structs.DiffTypeNone,
+								Name: "Network",
+							},
+						},
+					},
+				},
+			},
+			Parent:  &structs.TaskGroupDiff{Type: structs.DiffTypeEdited},
+			Desired: AnnotationForcesInplaceUpdate,
+		},
+		{
+			Diff: &structs.TaskDiff{
+				Type: structs.DiffTypeEdited,
+				Objects: []*structs.ObjectDiff{
+					{
+						Type: structs.DiffTypeEdited,
+						Name: "Resources",
+						Fields: []*structs.FieldDiff{
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "MemoryMB",
+								Old:  "256",
+								New:  "512",
+							},
+							{
+								Type: structs.DiffTypeEdited,
+								Name: "MemoryMaxMB",
+								Old:  "1024",
+								New:  "0",
+							},
+						},
+					},
+				},
+			},
+			Parent:  &structs.TaskGroupDiff{Type: structs.DiffTypeEdited},
+			Desired: AnnotationForcesDestructiveUpdate,
+		},
 		{
 			Diff: &structs.TaskDiff{
 				Type: structs.DiffTypeEdited,
@@ -132,6 +132,9 @@ type EvalContext struct {
 	logger      log.Logger
 	metrics     *structs.AllocMetric
 	eligibility *EvalEligibility
+
+	// explain toggles recording node explanations in the metrics
+	explain bool
 }
 
 // NewEvalContext constructs a new EvalContext
@@ -168,6 +171,18 @@ func (e *EvalContext) SetState(s State) {
 
 func (e *EvalContext) Reset() {
 	e.metrics = new(structs.AllocMetric)
+	if e.explain {
+		e.metrics.Explain()
+	}
+}
+
+// SetExplain toggles recording why each node was filtered or how it was
+// scored in the metrics of every placement.
+func (e *EvalContext) SetExplain(explain bool) {
+	e.explain = explain
+	if explain {
+		e.metrics.Explain()
+	}
 }
 
 func (e *EvalContext) ProposedAllocs(nodeID string) ([]*structs.Allocation, error) {
@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"fmt"
+	"sort"
 
 	"math"
 
@@ -46,16 +47,16 @@ func (d *deviceAllocator) AssignDevice(ask *structs.RequestedDevice) (out *struc
 	// Determine the devices that are feasible based on availability and
 	// constraints
 	for id, devInst := range d.Devices {
-		// Check if we have enough unused instances to use this
-		assignable := uint64(0)
-		for _, v := range devInst.Instances {
-			if v == 0 {
-				assignable++
-			}
+		// A share of a device can only be given by shareable devices
+		if ask.Share != 0 && devInst.Device.Capacity < ask.Share {
+			continue
 		}
 
+		// Check if we have enough unused instances to use this
+		assignable := d.assignableInstances(devInst, ask)
+
 		// This device doesn't have enough instances
-		if assignable < ask.Count {
+		if uint64(len(assignable)) < ask.Count {
 			continue
 		}
 
@@ -107,18 +108,8 @@ func (d *deviceAllocator) AssignDevice(ask *structs.RequestedDevice) (out *struc
 			Vendor:    id.Vendor,
 			Type:      id.Type,
 			Name:      id.Name,
-			DeviceIDs: make([]string, 0, ask.Count),
-		}
-
-		assigned := uint64(0)
-		for id, v := range devInst.Instances {
-			if v == 0 && assigned < ask.Count {
-				assigned++
-				offer.DeviceIDs = append(offer.DeviceIDs, id)
-				if assigned == ask.Count {
-					break
-				}
-			}
+			DeviceIDs: assignable[:ask.Count:ask.Count],
+			Share:     ask.Share,
 		}
 	}
 
@@ -129,3 +120,34 @@ func (d *deviceAllocator) AssignDevice(ask *structs.RequestedDevice) (out *struc
 
 	return offer, matchedWeights, nil
 }
+
+// assignableInstances returns the IDs of the instances of the device that can
+// be assigned to the request. Instances are assigned whole unless a share is
+// requested, in which case the instances with the least remaining capacity
+// that fits the share are returned first, so that tasks are bin-packed onto
+// as few devices as possible.
+func (d *deviceAllocator) assignableInstances(devInst *structs.DeviceAccounterInstance, ask *structs.RequestedDevice) []string {
+	var ids []string
+	if ask.Share == 0 {
+		for id, v := range devInst.Instances {
+			if v == 0 && devInst.Shares[id] == 0 {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+
+	for id := range devInst.Instances {
+		if devInst.RemainingCapacity(id) >= ask.Share {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		ri, rj := devInst.RemainingCapacity(ids[i]), devInst.RemainingCapacity(ids[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
 	psstructs "github.com/hashicorp/nomad/plugins/shared/structs"
+	"github.com/shoenig/test/must"
 	"github.com/stretchr/testify/require"
 )
 
@@ -367,3 +368,54 @@ func TestDeviceAllocator_Allocate_Affinities(t *testing.T) {
 		})
 	}
 }
+
+// Test that shares of a device are bin-packed onto the fullest instances
+func TestDeviceAllocator_Allocate_Shares(t *testing.T) {
+	ci.Parallel(t)
+
+	_, ctx := testContext(t)
+	n := devNode()
+	nvidia := n.NodeResources.Devices[0]
+	nvidia.Capacity = 8
+	d := newDeviceAllocator(ctx, n)
+
+	ask := deviceRequest("gpu", 1, nil, nil)
+	ask.Share = 3
+
+	// The first share uses the instance with the lowest ID, and the next
+	// shares are packed onto it until it is full
+	out, _, err := d.AssignDevice(ask)
+	must.NoError(t, err)
+	must.Eq(t, uint64(3), out.Share)
+	must.Len(t, 1, out.DeviceIDs)
+	first := out.DeviceIDs[0]
+	must.False(t, d.AddReserved(out))
+
+	out, _, err = d.AssignDevice(ask)
+	must.NoError(t, err)
+	must.Eq(t, []string{first}, out.DeviceIDs)
+	must.False(t, d.AddReserved(out))
+
+	out, _, err = d.AssignDevice(ask)
+	must.NoError(t, err)
+	must.NotEq(t, []string{first}, out.DeviceIDs)
+	must.False(t, d.AddReserved(out))
+
+	// A whole device can't be assigned once every instance is shared
+	out, _, err = d.AssignDevice(deviceRequest("nvidia/gpu", 1, nil, nil))
+	must.Nil(t, out)
+	must.Error(t, err)
+
+	// Shares larger than the capacity or of devices that aren't shareable
+	// can't be assigned
+	ask.Share = 9
+	out, _, err = d.AssignDevice(ask)
+	must.Nil(t, out)
+	must.Error(t, err)
+
+	fpga := deviceRequest("fpga", 1, nil, nil)
+	fpga.Share = 1
+	out, _, err = d.AssignDevice(fpga)
+	must.Nil(t, out)
+	must.Error(t, err)
+}
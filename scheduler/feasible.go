@@ -29,6 +29,7 @@ const (
 	FilterConstraintDrivers                        = "missing drivers"
 	FilterConstraintDevices                        = "missing devices"
 	FilterConstraintsCSIPluginTopology             = "did not meet topology requirement"
+	FilterConstraintNodePool                       = "node pool mismatch"
 )
 
 var (
@@ -455,6 +456,33 @@ func (c *NetworkChecker) hasNetwork(option *structs.Node) bool {
 	return false
 }
 
+// NodePoolChecker is a FeasibilityChecker which returns whether a node is part
+// of the node pool targeted by the job.
+type NodePoolChecker struct {
+	ctx  Context
+	pool string
+}
+
+// NewNodePoolChecker creates a NodePoolChecker for the given node pool.
+func NewNodePoolChecker(ctx Context, pool string) *NodePoolChecker {
+	return &NodePoolChecker{
+		ctx:  ctx,
+		pool: pool,
+	}
+}
+
+func (c *NodePoolChecker) SetNodePool(pool string) {
+	c.pool = pool
+}
+
+func (c *NodePoolChecker) Feasible(option *structs.Node) bool {
+	if structs.NodePoolMatches(c.pool, option.NodePool) {
+		return true
+	}
+	c.ctx.Metrics().FilterNode(option, FilterConstraintNodePool)
+	return false
+}
+
 // DriverChecker is a FeasibilityChecker which returns whether a node has the
 // drivers necessary to scheduler a task group.
 type DriverChecker struct {
@@ -1319,10 +1347,18 @@ OUTER:
 				continue
 			}
 
+			// A share of a device can only be given by shareable devices
+			if req.Share != 0 && d.Capacity < req.Share {
+				continue
+			}
+
 			// Check the constraints
 			if nodeDeviceMatches(c.ctx, d, req) {
-				// Consume the instances
-				available[d] -= desiredCount
+				// Consume the instances, unless they can be shared with other
+				// requests
+				if req.Share == 0 {
+					available[d] -= desiredCount
+				}
 
 				// Move on to the next request
 				continue OUTER
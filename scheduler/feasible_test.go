@@ -682,6 +682,52 @@ func TestNetworkChecker_bridge_upgrade_path(t *testing.T) {
 	})
 }
 
+func TestNodePoolChecker(t *testing.T) {
+	ci.Parallel(t)
+
+	_, ctx := testContext(t)
+	nodes := []*structs.Node{
+		mock.Node(),
+		mock.Node(),
+		mock.Node(),
+	}
+	nodes[0].NodePool = structs.NodePoolDefault
+	nodes[1].NodePool = "dev"
+	nodes[2].NodePool = ""
+
+	cases := []struct {
+		Pool   string
+		Result []bool
+	}{
+		{
+			Pool:   "",
+			Result: []bool{true, true, true},
+		},
+		{
+			Pool:   structs.NodePoolAll,
+			Result: []bool{true, true, true},
+		},
+		{
+			Pool:   structs.NodePoolDefault,
+			Result: []bool{true, false, true},
+		},
+		{
+			Pool:   "dev",
+			Result: []bool{false, true, false},
+		},
+	}
+
+	checker := NewNodePoolChecker(ctx, "")
+	for _, c := range cases {
+		checker.SetNodePool(c.Pool)
+		for i, node := range nodes {
+			if act := checker.Feasible(node); act != c.Result[i] {
+				t.Fatalf("pool %q node(%d) failed: got %v; want %v", c.Pool, i, act, c.Result[i])
+			}
+		}
+	}
+}
+
 func TestDriverChecker_DriverInfo(t *testing.T) {
 	ci.Parallel(t)
 
@@ -2587,6 +2633,15 @@ func TestDeviceChecker(t *testing.T) {
 		},
 	}
 
+	nvidiaShared := nvidia.Copy()
+	nvidiaShared.Capacity = 8
+
+	gpuShareReq := &structs.RequestedDevice{
+		Name:  "gpu",
+		Count: 2,
+		Share: 4,
+	}
+
 	cases := []struct {
 		Name             string
 		Result           bool
@@ -2653,6 +2708,24 @@ func TestDeviceChecker(t *testing.T) {
 			NodeDevices:      []*structs.NodeDeviceResource{nvidia},
 			RequestedDevices: []*structs.RequestedDevice{gpuTypeHighCountReq},
 		},
+		{
+			Name:             "share of devices that aren't shareable",
+			Result:           false,
+			NodeDevices:      []*structs.NodeDeviceResource{nvidia},
+			RequestedDevices: []*structs.RequestedDevice{gpuShareReq},
+		},
+		{
+			Name:             "shares of shareable devices",
+			Result:           true,
+			NodeDevices:      []*structs.NodeDeviceResource{nvidiaShared},
+			RequestedDevices: []*structs.RequestedDevice{gpuShareReq, gpuShareReq, gpuShareReq},
+		},
+		{
+			Name:             "share larger than capacity",
+			Result:           false,
+			NodeDevices:      []*structs.NodeDeviceResource{nvidiaShared},
+			RequestedDevices: []*structs.RequestedDevice{{Name: "gpu", Count: 1, Share: 16}},
+		},
 		{
 			Name:        "meets constraints requirement",
 			Result:      true,
@@ -52,6 +52,10 @@ const (
 	// allocRescheduled is the status used when an allocation failed and was rescheduled
 	allocRescheduled = "alloc was rescheduled because it failed"
 
+	// allocGangMemberLost is the status used when an allocation is replaced
+	// because another member of its gang task group was lost or failed
+	allocGangMemberLost = "alloc is being replaced because a member of its gang was lost"
+
 	// blockedEvalMaxPlanDesc is the description used for blocked evals that are
 	// a result of hitting the max number of plan attempts
 	blockedEvalMaxPlanDesc = "created due to placement conflicts"
@@ -112,9 +116,10 @@ type GenericScheduler struct {
 
 	deployment *structs.Deployment
 
-	blocked        *structs.Evaluation
-	failedTGAllocs map[string]*structs.AllocMetric
-	queuedAllocs   map[string]int
+	blocked          *structs.Evaluation
+	failedTGAllocs   map[string]*structs.AllocMetric
+	deferredTGAllocs map[string]string
+	queuedAllocs     map[string]int
 }
 
 // NewServiceScheduler is a factory function to instantiate a new service scheduler
@@ -166,7 +171,8 @@ func (s *GenericScheduler) Process(eval *structs.Evaluation) (err error) {
 		structs.EvalTriggerPeriodicJob, structs.EvalTriggerMaxPlans,
 		structs.EvalTriggerDeploymentWatcher, structs.EvalTriggerRetryFailedAlloc,
 		structs.EvalTriggerFailedFollowUp, structs.EvalTriggerPreemption,
-		structs.EvalTriggerScaling, structs.EvalTriggerMaxDisconnectTimeout, structs.EvalTriggerReconnect:
+		structs.EvalTriggerScaling, structs.EvalTriggerMaxDisconnectTimeout, structs.EvalTriggerReconnect,
+		structs.EvalTriggerRebalance, structs.EvalTriggerGroupDependency:
 	default:
 		desc := fmt.Sprintf("scheduler cannot handle '%s' evaluation reason",
 			eval.TriggeredBy)
@@ -210,8 +216,14 @@ func (s *GenericScheduler) Process(eval *structs.Evaluation) (err error) {
 		return s.planner.ReblockEval(newEval)
 	}
 
+	// Record the task groups still waiting for their dependencies
+	if len(s.deferredTGAllocs) != 0 {
+		eval = s.eval.Copy()
+		eval.DeferredTGAllocs = s.deferredTGAllocs
+	}
+
 	// Update the status to complete
-	return setStatus(s.logger, s.planner, s.eval, nil, s.blocked,
+	return setStatus(s.logger, s.planner, eval, nil, s.blocked,
 		s.failedTGAllocs, structs.EvalStatusComplete, "", s.queuedAllocs,
 		s.deployment.GetID())
 }
@@ -274,9 +286,11 @@ func (s *GenericScheduler) process() (bool, error) {
 
 	// Create an evaluation context
 	s.ctx = NewEvalContext(s.eventsCh, s.state, s.plan, s.logger)
+	s.ctx.SetExplain(s.eval.ExplainPlan)
 
 	// Construct the placement stack
 	s.stack = NewGenericStack(s.batch, s.ctx)
+	s.stack.SetExplain(s.eval.ExplainPlan)
 	if !s.job.Stopped() {
 		s.stack.SetJob(s.job)
 	}
@@ -385,9 +399,12 @@ func (s *GenericScheduler) computeJobAllocs() error {
 	results := reconciler.Compute()
 	s.logger.Debug("reconciled current state with desired state", "results", log.Fmt("%#v", results))
 
+	s.deferredTGAllocs = results.deferredTGAllocs
+
 	if s.eval.AnnotatePlan {
 		s.plan.Annotations = &structs.PlanAnnotations{
-			DesiredTGUpdates: results.desiredTGUpdates,
+			DesiredTGUpdates:    results.desiredTGUpdates,
+			SchedulerAlgorithms: schedulerAlgorithms(s.state, s.job),
 		}
 	}
 
@@ -522,6 +539,10 @@ func (s *GenericScheduler) computePlacements(destructive, place []placementResul
 	// Capture current time to use as the start time for any rescheduled allocations
 	now := time.Now()
 
+	// Track the placements of gang task groups so they can be undone if any
+	// allocation of the group fails to place.
+	gangs := make(map[string][]gangPlacement)
+
 	// Have to handle destructive changes first as we need to discount their
 	// resources. To understand this imagine the resources were reduced and the
 	// count was scaled up.
@@ -661,6 +682,17 @@ func (s *GenericScheduler) computePlacements(destructive, place []placementResul
 				// Track the placement
 				s.plan.AppendAlloc(alloc, downgradedJob)
 
+				if tg.Gang {
+					placement := gangPlacement{
+						alloc:     alloc,
+						preempted: option.PreemptedAllocs,
+					}
+					if stopPrevAlloc {
+						placement.stopped = prevAllocation
+					}
+					gangs[tg.Name] = append(gangs[tg.Name], placement)
+				}
+
 			} else {
 				// Lazy initialize the failed map
 				if s.failedTGAllocs == nil {
@@ -683,9 +715,101 @@ func (s *GenericScheduler) computePlacements(destructive, place []placementResul
 		}
 	}
 
+	s.rollbackFailedGangs(gangs)
 	return nil
 }
 
+// gangPlacement tracks the changes made to the plan when placing an
+// allocation of a gang task group.
+type gangPlacement struct {
+	alloc     *structs.Allocation
+	stopped   *structs.Allocation
+	preempted []*structs.Allocation
+}
+
+// rollbackFailedGangs removes from the plan the placements of gang task
+// groups that failed to place some of their allocations, so that a gang is
+// either placed as a whole or not at all. The members the reconciler stopped
+// to replace the gang are kept running. The removed placements are counted
+// as failures so the blocked evaluation accounts for the whole group.
+func (s *GenericScheduler) rollbackFailedGangs(gangs map[string][]gangPlacement) {
+	for _, tg := range s.job.TaskGroups {
+		if !tg.Gang {
+			continue
+		}
+
+		placements := gangs[tg.Name]
+		metric, failed := s.failedTGAllocs[tg.Name]
+		if !failed {
+			// The plan must be applied all at once, otherwise the plan
+			// applier could commit a partial gang.
+			if len(placements) > 0 {
+				s.plan.AllAtOnce = true
+			}
+			continue
+		}
+
+		s.logger.Debug("failed to place all allocations of gang task group, rolling back placements",
+			"task_group", tg.Name, "rolled_back", len(placements))
+
+		for _, placement := range placements {
+			s.plan.RemoveAlloc(placement.alloc)
+			if placement.stopped != nil {
+				s.plan.RemoveUpdate(placement.stopped)
+			}
+			for _, preempted := range placement.preempted {
+				s.plan.RemovePreemptedAlloc(preempted)
+				s.removePreemptionAnnotation(tg.Name, preempted)
+			}
+		}
+		s.removeGangStops(tg.Name)
+		metric.CoalescedFailures += len(placements)
+	}
+}
+
+// removeGangStops removes from the plan the stops of the gang members that
+// were only stopped so the gang could be replaced as a whole.
+func (s *GenericScheduler) removeGangStops(tgName string) {
+	var stops []*structs.Allocation
+	for _, updates := range s.plan.NodeUpdate {
+		for _, alloc := range updates {
+			if alloc.TaskGroup == tgName && alloc.DesiredDescription == allocGangMemberLost {
+				stops = append(stops, alloc)
+			}
+		}
+	}
+
+	for _, alloc := range stops {
+		s.plan.RemoveUpdate(alloc)
+	}
+
+	if !s.eval.AnnotatePlan || s.plan.Annotations == nil {
+		return
+	}
+	if desired, ok := s.plan.Annotations.DesiredTGUpdates[tgName]; ok {
+		desired.Stop -= uint64(len(stops))
+	}
+}
+
+// removePreemptionAnnotation removes a preemption that was rolled back from
+// the plan annotations.
+func (s *GenericScheduler) removePreemptionAnnotation(tgName string, preempted *structs.Allocation) {
+	if !s.eval.AnnotatePlan || s.plan.Annotations == nil {
+		return
+	}
+
+	stubs := s.plan.Annotations.PreemptedAllocs
+	for i, stub := range stubs {
+		if stub.ID == preempted.ID {
+			s.plan.Annotations.PreemptedAllocs = append(stubs[:i], stubs[i+1:]...)
+			break
+		}
+	}
+	if desired, ok := s.plan.Annotations.DesiredTGUpdates[tgName]; ok && desired.Preemptions > 0 {
+		desired.Preemptions--
+	}
+}
+
 // propagateTaskState copies task handles from previous allocations to
 // replacement allocations when the previous allocation is being drained or was
 // lost. Remote task drivers rely on this to reconnect to remote tasks when the
@@ -789,6 +913,19 @@ func updateRescheduleTracker(alloc *structs.Allocation, prev *structs.Allocation
 
 // findPreferredNode finds the preferred node for an allocation
 func (s *GenericScheduler) findPreferredNode(place placementResult) (*structs.Node, error) {
+	// Allocations migrated by the rebalancer prefer the node it picked
+	if prev := place.PreviousAllocation(); prev != nil && prev.DesiredTransition.ShouldMigrate() &&
+		prev.DesiredTransition.MigrateTargetNodeID != "" {
+		target, err := s.state.NodeByID(nil, prev.DesiredTransition.MigrateTargetNodeID)
+		if err != nil {
+			return nil, err
+		}
+
+		if target != nil && target.Ready() {
+			return target, nil
+		}
+	}
+
 	if prev := place.PreviousAllocation(); prev != nil && place.TaskGroup().EphemeralDisk.Sticky {
 		var preferredNode *structs.Node
 		ws := memdb.NewWatchSet()
@@ -269,7 +269,7 @@ func TestServiceSched_JobRegister_StickyAllocs(t *testing.T) {
 
 	// Update the job to force a rolling upgrade
 	updated := job.Copy()
-	updated.TaskGroups[0].Tasks[0].Resources.CPU += 10
+	updated.TaskGroups[0].Tasks[0].Resources.CPU -= 10
 	require.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), updated))
 
 	// Create a mock evaluation to handle the update
@@ -925,6 +925,9 @@ func TestServiceSched_JobRegister_Annotate(t *testing.T) {
 
 	// Create a job
 	job := mock.Job()
+	job.TaskGroups[0].Scheduling = &structs.Scheduling{
+		Algorithm: structs.SchedulerAlgorithmSpread,
+	}
 	require.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), job))
 
 	// Create a mock evaluation to register the job
@@ -991,6 +994,12 @@ func TestServiceSched_JobRegister_Annotate(t *testing.T) {
 	if !reflect.DeepEqual(desiredChanges, expected) {
 		t.Fatalf("Unexpected desired updates; got %#v; want %#v", desiredChanges, expected)
 	}
+
+	// Ensure the plan reports the effective scheduler algorithm.
+	expectedAlgorithms := map[string]structs.SchedulerAlgorithm{
+		"web": structs.SchedulerAlgorithmSpread,
+	}
+	require.Equal(t, expectedAlgorithms, plan.Annotations.SchedulerAlgorithms)
 }
 
 func TestServiceSched_JobRegister_CountZero(t *testing.T) {
@@ -1120,6 +1129,133 @@ func TestServiceSched_JobRegister_AllocFail(t *testing.T) {
 	h.AssertEvalStatus(t, structs.EvalStatusComplete)
 }
 
+func TestServiceSched_JobRegister_Gang(t *testing.T) {
+	ci.Parallel(t)
+
+	// Each node fits 8 of the 10 allocations of the mock job.
+	testCases := []struct {
+		name   string
+		nodes  int
+		placed int
+	}{
+		{
+			name:   "partial placement is rolled back",
+			nodes:  1,
+			placed: 0,
+		},
+		{
+			name:   "whole gang placed",
+			nodes:  2,
+			placed: 10,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewHarness(t)
+
+			for i := 0; i < tc.nodes; i++ {
+				node := mock.Node()
+				require.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+			}
+
+			job := mock.Job()
+			job.TaskGroups[0].Gang = true
+			require.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), job))
+
+			eval := &structs.Evaluation{
+				Namespace:   structs.DefaultNamespace,
+				ID:          uuid.Generate(),
+				Priority:    job.Priority,
+				TriggeredBy: structs.EvalTriggerJobRegister,
+				JobID:       job.ID,
+				Status:      structs.EvalStatusPending,
+			}
+			require.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+			require.NoError(t, h.Process(NewServiceScheduler, eval))
+
+			out, err := h.State.AllocsByJob(nil, job.Namespace, job.ID, false)
+			require.NoError(t, err)
+			require.Len(t, out, tc.placed)
+			require.Len(t, h.Evals, 1)
+			outEval := h.Evals[0]
+
+			if tc.placed == 0 {
+				require.Empty(t, h.Plans)
+
+				// The whole group is accounted as failed and blocked.
+				metrics, ok := outEval.FailedTGAllocs[job.TaskGroups[0].Name]
+				require.True(t, ok)
+				require.Equal(t, 9, metrics.CoalescedFailures)
+				require.Equal(t, 10, outEval.QueuedAllocations[job.TaskGroups[0].Name])
+				require.Len(t, h.CreateEvals, 1)
+				require.Equal(t, structs.EvalStatusBlocked, h.CreateEvals[0].Status)
+				return
+			}
+
+			require.Len(t, h.Plans, 1)
+			require.True(t, h.Plans[0].AllAtOnce)
+			require.Empty(t, outEval.FailedTGAllocs)
+		})
+	}
+}
+
+func TestServiceSched_NodeDown_Gang_Rollback(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+
+	// Each node fits 8 of the 10 allocations of the mock job, so the gang
+	// can't be replaced once one of the nodes is down.
+	up := mock.Node()
+	require.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), up))
+	down := mock.Node()
+	down.Status = structs.NodeStatusDown
+	require.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), down))
+
+	job := mock.Job()
+	job.TaskGroups[0].Gang = true
+	require.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), job))
+
+	var allocs []*structs.Allocation
+	for i := 0; i < 10; i++ {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = up.ID
+		if i%2 == 0 {
+			alloc.NodeID = down.ID
+		}
+		alloc.Name = structs.AllocName(job.ID, job.TaskGroups[0].Name, uint(i))
+		alloc.ClientStatus = structs.AllocClientStatusRunning
+		allocs = append(allocs, alloc)
+	}
+	require.NoError(t, h.State.UpsertAllocs(structs.MsgTypeTestSetup, h.NextIndex(), allocs))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerNodeUpdate,
+		JobID:       job.ID,
+		NodeID:      down.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+	require.NoError(t, h.Process(NewServiceScheduler, eval))
+
+	// Only the lost allocations are stopped, the members of the gang on the
+	// node that is up keep running
+	require.Len(t, h.Plans, 1)
+	plan := h.Plans[0]
+	require.Empty(t, plan.NodeAllocation)
+	require.Len(t, plan.NodeUpdate[down.ID], 5)
+	require.Empty(t, plan.NodeUpdate[up.ID])
+
+	require.Len(t, h.Evals, 1)
+	require.Contains(t, h.Evals[0].FailedTGAllocs, job.TaskGroups[0].Name)
+}
+
 func TestServiceSched_JobRegister_CreateBlockedEval(t *testing.T) {
 	ci.Parallel(t)
 
@@ -2046,6 +2182,78 @@ func TestServiceSched_JobModify_Rolling(t *testing.T) {
 	}
 }
 
+func TestServiceSched_JobModify_MaxSurge(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+
+	// Create some nodes
+	var nodes []*structs.Node
+	for i := 0; i < 10; i++ {
+		node := mock.Node()
+		nodes = append(nodes, node)
+		require.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+	}
+
+	// Generate a fake job with allocations
+	job := mock.Job()
+	require.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), job))
+
+	var allocs []*structs.Allocation
+	for i := 0; i < 10; i++ {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = nodes[i].ID
+		alloc.Name = fmt.Sprintf("my-job.web[%d]", i)
+		allocs = append(allocs, alloc)
+	}
+	require.NoError(t, h.State.UpsertAllocs(structs.MsgTypeTestSetup, h.NextIndex(), allocs))
+
+	// Update the job such that it cannot be done in-place
+	job2 := mock.Job()
+	job2.ID = job.ID
+	job2.TaskGroups[0].Update = &structs.UpdateStrategy{
+		MaxParallel:     1,
+		MaxSurge:        3,
+		HealthCheck:     structs.UpdateStrategyHealthCheck_Checks,
+		MinHealthyTime:  10 * time.Second,
+		HealthyDeadline: 10 * time.Minute,
+	}
+	job2.TaskGroups[0].Tasks[0].Config["command"] = "/bin/other"
+	require.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), job2))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    50,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+	require.NoError(t, h.Process(NewServiceScheduler, eval))
+	require.Len(t, h.Plans, 1)
+	plan := h.Plans[0]
+
+	// Nothing is stopped before the replacements are healthy
+	require.Empty(t, plan.NodeUpdate)
+
+	// Ensure the plan placed max_surge replacements as part of the deployment
+	require.NotNil(t, plan.Deployment)
+	var planned []*structs.Allocation
+	for _, allocList := range plan.NodeAllocation {
+		planned = append(planned, allocList...)
+	}
+	require.Len(t, planned, 3)
+	for _, alloc := range planned {
+		require.Equal(t, plan.Deployment.ID, alloc.DeploymentID)
+		require.Empty(t, alloc.PreviousAllocation)
+	}
+
+	h.AssertEvalStatus(t, structs.EvalStatusComplete)
+}
+
 // This tests that the old allocation is stopped before placing.
 // It is critical to test that the updated job attempts to place more
 // allocations as this allows us to assert that destructive changes are done
@@ -3337,6 +3545,69 @@ func TestServiceSched_NodeDrain(t *testing.T) {
 	h.AssertEvalStatus(t, structs.EvalStatusComplete)
 }
 
+func TestServiceSched_Rebalance(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+
+	// Register a busy node and some others to move the allocations to
+	busy := mock.Node()
+	require.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), busy))
+	var target *structs.Node
+	for i := 0; i < 10; i++ {
+		target = mock.Node()
+		require.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), target))
+	}
+
+	job := mock.Job()
+	job.TaskGroups[0].Count = 2
+	require.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), job))
+
+	// Mark one of the allocations on the busy node for migration, the way
+	// the rebalancer does
+	var allocs []*structs.Allocation
+	for i := 0; i < 2; i++ {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = busy.ID
+		alloc.Name = fmt.Sprintf("my-job.web[%d]", i)
+		allocs = append(allocs, alloc)
+	}
+	allocs[0].DesiredTransition.Migrate = pointer.Of(true)
+	allocs[0].DesiredTransition.MigrateTargetNodeID = target.ID
+	require.NoError(t, h.State.UpsertAllocs(structs.MsgTypeTestSetup, h.NextIndex(), allocs))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    50,
+		TriggeredBy: structs.EvalTriggerRebalance,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+	require.NoError(t, h.Process(NewServiceScheduler, eval))
+
+	// Ensure only the marked allocation was migrated
+	require.Len(t, h.Plans, 1)
+	plan := h.Plans[0]
+	require.Len(t, plan.NodeUpdate[busy.ID], 1)
+	require.Equal(t, allocs[0].ID, plan.NodeUpdate[busy.ID][0].ID)
+
+	var planned []*structs.Allocation
+	for _, allocList := range plan.NodeAllocation {
+		planned = append(planned, allocList...)
+	}
+	require.Len(t, planned, 1)
+	require.Equal(t, allocs[0].ID, planned[0].PreviousAllocation)
+
+	// Ensure the replacement was placed on the node picked by the rebalancer
+	require.Equal(t, target.ID, planned[0].NodeID)
+
+	h.AssertEvalStatus(t, structs.EvalStatusComplete)
+}
+
 func TestServiceSched_NodeDrain_Down(t *testing.T) {
 	ci.Parallel(t)
 
@@ -4218,6 +4489,74 @@ func TestDeployment_FailedAllocs_Reschedule(t *testing.T) {
 	}
 }
 
+func TestBatchSched_GroupDependency(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+
+	node := mock.Node()
+	require.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+
+	// Create a job whose web group waits for the migrate group to complete
+	job := mock.Job()
+	job.Type = structs.JobTypeBatch
+	job.TaskGroups[0].Count = 2
+	migrate := job.TaskGroups[0].Copy()
+	migrate.Name = "migrate"
+	migrate.Count = 1
+	job.TaskGroups = append(job.TaskGroups, migrate)
+	job.TaskGroups[0].DependsOn = []*structs.GroupDependency{
+		{Group: "migrate", Condition: structs.GroupDependencyComplete},
+	}
+	require.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), job))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+	require.NoError(t, h.Process(NewBatchScheduler, eval))
+
+	// Ensure only the migrate group was placed
+	require.Len(t, h.Plans, 1)
+	planned := h.Plans[0].NodeAllocation[node.ID]
+	require.Len(t, planned, 1)
+	require.Equal(t, "migrate", planned[0].TaskGroup)
+
+	// Ensure the eval records why the web group is waiting
+	require.Len(t, h.Evals, 1)
+	require.Equal(t, structs.EvalStatusComplete, h.Evals[0].Status)
+	require.Equal(t, `waiting for task group "migrate" to be complete (0/1)`, h.Evals[0].DeferredTGAllocs["web"])
+
+	// Complete the migrate allocation and ensure the web group is placed
+	done := planned[0].Copy()
+	done.ClientStatus = structs.AllocClientStatusComplete
+	require.NoError(t, h.State.UpdateAllocsFromClient(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Allocation{done}))
+
+	eval = &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerGroupDependency,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+	require.NoError(t, h.Process(NewBatchScheduler, eval))
+
+	require.Len(t, h.Plans, 2)
+	planned = h.Plans[1].NodeAllocation[node.ID]
+	require.Len(t, planned, 2)
+	for _, alloc := range planned {
+		require.Equal(t, "web", alloc.TaskGroup)
+	}
+	require.Empty(t, h.Evals[1].DeferredTGAllocs)
+}
+
 func TestBatchSched_Run_CompleteAlloc(t *testing.T) {
 	ci.Parallel(t)
 
@@ -0,0 +1,150 @@
+package scheduler
+
+import (
+	"sort"
+
+	"github.com/hashicorp/nomad/lib/cpuset"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// numaCoreSelector is used to select the cores reserved for a task, taking
+// the NUMA topology of the node into account so that the cores and memory of
+// a task come from a single NUMA node whenever possible.
+type numaCoreSelector struct {
+	numaNodes []*structs.NodeNUMANode
+
+	// usedMemoryMB is the memory used by tasks that have all their reserved
+	// cores in a given NUMA node, keyed by NUMA node ID.
+	usedMemoryMB map[uint8]int64
+}
+
+// newNUMACoreSelector returns a core selector for the given node, accounting
+// for the memory used by the tasks of the proposed allocations.
+func newNUMACoreSelector(node *structs.Node, proposed []*structs.Allocation) *numaCoreSelector {
+	s := &numaCoreSelector{
+		usedMemoryMB: make(map[uint8]int64),
+	}
+	if node.NodeResources != nil {
+		s.numaNodes = node.NodeResources.Cpu.NUMANodes
+	}
+
+	for _, alloc := range proposed {
+		if alloc.AllocatedResources == nil {
+			continue
+		}
+		for _, tr := range alloc.AllocatedResources.Tasks {
+			s.AddTask(tr)
+		}
+	}
+	return s
+}
+
+// AddTask accounts for the memory of a task that has reserved cores.
+func (s *numaCoreSelector) AddTask(tr *structs.AllocatedTaskResources) {
+	if tr == nil || len(tr.Cpu.ReservedCores) == 0 {
+		return
+	}
+	if numaNode := s.numaNodeFor(cpuset.New(tr.Cpu.ReservedCores...)); numaNode != nil {
+		s.usedMemoryMB[numaNode.ID] += tr.Memory.MemoryMB
+	}
+}
+
+// numaNodeFor returns the NUMA node that contains all the given cores, or nil
+// if the cores span multiple NUMA nodes.
+func (s *numaCoreSelector) numaNodeFor(cores cpuset.CPUSet) *structs.NodeNUMANode {
+	for _, numaNode := range s.numaNodes {
+		if cores.IsSubsetOf(numaNode.CPUSet()) {
+			return numaNode
+		}
+	}
+	return nil
+}
+
+// IsLocal returns whether the cores are all part of a single NUMA node. Nodes
+// without a known NUMA topology, or with a single NUMA node, are always local.
+func (s *numaCoreSelector) IsLocal(cores []uint16) bool {
+	if len(s.numaNodes) <= 1 {
+		return true
+	}
+	return s.numaNodeFor(cpuset.New(cores...)) != nil
+}
+
+// Select returns the cores to reserve for a task that asks for count cores
+// and memoryMB of memory, picking from the set of available cores. It
+// returns false if the NUMA affinity of the task can't be satisfied.
+//
+// When possible, the cores are taken from the NUMA node with the fewest
+// available cores that can still fit the task, to leave larger NUMA nodes
+// free for larger tasks. If no single NUMA node can fit the task, an affinity
+// of "prefer" spreads the task over as few NUMA nodes as possible, while an
+// affinity of "require" fails.
+func (s *numaCoreSelector) Select(available cpuset.CPUSet, count int, memoryMB int64, affinity string) ([]uint16, bool) {
+	if available.Size() < count {
+		return nil, false
+	}
+
+	// Nodes without a known NUMA topology, or with a single NUMA node, are
+	// treated as a single NUMA node.
+	if affinity == structs.NUMAAffinityNone || len(s.numaNodes) <= 1 {
+		return available.ToSlice()[0:count], true
+	}
+
+	type candidate struct {
+		id   uint8
+		free cpuset.CPUSet
+	}
+	candidates := make([]candidate, 0, len(s.numaNodes))
+	for _, numaNode := range s.numaNodes {
+		candidates = append(candidates, candidate{
+			id:   numaNode.ID,
+			free: available.Intersection(numaNode.CPUSet()),
+		})
+	}
+
+	var best *candidate
+	for i, c := range candidates {
+		if c.free.Size() < count {
+			continue
+		}
+		numaNode := s.numaNodes[i]
+		if numaNode.MemoryMB > 0 && s.usedMemoryMB[numaNode.ID]+memoryMB > numaNode.MemoryMB {
+			continue
+		}
+		if best == nil || c.free.Size() < best.free.Size() {
+			best = &candidates[i]
+		}
+	}
+	if best != nil {
+		return best.free.ToSlice()[0:count], true
+	}
+
+	if affinity == structs.NUMAAffinityRequire {
+		return nil, false
+	}
+
+	// Spread the task over as few NUMA nodes as possible by taking cores from
+	// the NUMA nodes with the most available cores first.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].free.Size() > candidates[j].free.Size()
+	})
+	cores := make([]uint16, 0, count)
+	remaining := available.Copy()
+	for _, c := range candidates {
+		for _, core := range c.free.ToSlice() {
+			if len(cores) == count {
+				return cores, true
+			}
+			cores = append(cores, core)
+			remaining = remaining.Difference(cpuset.New(core))
+		}
+	}
+
+	// Cores that are not part of any NUMA node are used last.
+	for _, core := range remaining.ToSlice() {
+		if len(cores) == count {
+			break
+		}
+		cores = append(cores, core)
+	}
+	return cores, true
+}
@@ -0,0 +1,228 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/ci"
+	"github.com/hashicorp/nomad/lib/cpuset"
+	"github.com/hashicorp/nomad/nomad/mock"
+	"github.com/hashicorp/nomad/nomad/structs"
+	"github.com/shoenig/test/must"
+)
+
+// numaNode returns a node with two NUMA nodes of four cores and 1024 MB of
+// memory each.
+func numaNode() *structs.Node {
+	node := mock.Node()
+	node.NodeResources.Cpu.TotalCpuCores = 8
+	node.NodeResources.Cpu.ReservableCpuCores = []uint16{0, 1, 2, 3, 4, 5, 6, 7}
+	node.NodeResources.Cpu.NUMANodes = []*structs.NodeNUMANode{
+		{ID: 0, Socket: 0, Cores: []uint16{0, 1, 2, 3}, MemoryMB: 1024},
+		{ID: 1, Socket: 1, Cores: []uint16{4, 5, 6, 7}, MemoryMB: 1024},
+	}
+	return node
+}
+
+func TestNUMACoreSelector_Select(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name      string
+		available cpuset.CPUSet
+		count     int
+		memoryMB  int64
+		affinity  string
+		expected  []uint16
+		ok        bool
+	}{
+		{
+			name:      "fits in single numa node",
+			available: cpuset.New(0, 1, 2, 3, 4, 5, 6, 7),
+			count:     2,
+			memoryMB:  256,
+			affinity:  structs.NUMAAffinityPrefer,
+			expected:  []uint16{0, 1},
+			ok:        true,
+		},
+		{
+			name:      "best fit numa node",
+			available: cpuset.New(0, 1, 2, 4, 5),
+			count:     2,
+			memoryMB:  256,
+			affinity:  structs.NUMAAffinityRequire,
+			expected:  []uint16{4, 5},
+			ok:        true,
+		},
+		{
+			name:      "prefer spans numa nodes",
+			available: cpuset.New(0, 1, 2, 4, 5),
+			count:     4,
+			memoryMB:  256,
+			affinity:  structs.NUMAAffinityPrefer,
+			expected:  []uint16{0, 1, 2, 4},
+			ok:        true,
+		},
+		{
+			name:      "require fails across numa nodes",
+			available: cpuset.New(0, 1, 2, 4, 5),
+			count:     4,
+			memoryMB:  256,
+			affinity:  structs.NUMAAffinityRequire,
+			ok:        false,
+		},
+		{
+			name:      "require fails without numa node memory",
+			available: cpuset.New(0, 1, 2, 3, 4, 5, 6, 7),
+			count:     2,
+			memoryMB:  2048,
+			affinity:  structs.NUMAAffinityRequire,
+			ok:        false,
+		},
+		{
+			name:      "none ignores topology",
+			available: cpuset.New(2, 3, 4, 5, 6, 7),
+			count:     3,
+			memoryMB:  256,
+			affinity:  structs.NUMAAffinityNone,
+			expected:  []uint16{2, 3, 4},
+			ok:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector := newNUMACoreSelector(numaNode(), nil)
+			cores, ok := selector.Select(tc.available, tc.count, tc.memoryMB, tc.affinity)
+			must.Eq(t, tc.ok, ok)
+			if tc.ok {
+				must.Eq(t, tc.expected, cores)
+			}
+		})
+	}
+}
+
+func TestNUMACoreSelector_UsedMemory(t *testing.T) {
+	ci.Parallel(t)
+
+	node := numaNode()
+
+	// An existing task uses most of the memory of the first NUMA node.
+	alloc := mock.Alloc()
+	alloc.AllocatedResources.Tasks["web"].Cpu.ReservedCores = []uint16{0}
+	alloc.AllocatedResources.Tasks["web"].Memory.MemoryMB = 900
+
+	selector := newNUMACoreSelector(node, []*structs.Allocation{alloc})
+	cores, ok := selector.Select(cpuset.New(1, 2, 3, 4, 5, 6, 7), 2, 256, structs.NUMAAffinityRequire)
+	must.True(t, ok)
+	must.Eq(t, []uint16{4, 5}, cores)
+}
+
+func TestBinPackIterator_NUMA(t *testing.T) {
+	ci.Parallel(t)
+
+	state, ctx := testContext(t)
+	node := numaNode()
+	nodes := []*RankedNode{{Node: node}}
+
+	// Reserve cores on both NUMA nodes so that neither one has four free
+	// cores.
+	alloc := mock.Alloc()
+	alloc.NodeID = node.ID
+	alloc.AllocatedResources.Tasks["web"].Cpu.ReservedCores = []uint16{0, 4}
+	must.NoError(t, state.UpsertJobSummary(999, mock.JobSummary(alloc.JobID)))
+	must.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 1000, []*structs.Allocation{alloc}))
+
+	taskGroup := &structs.TaskGroup{
+		EphemeralDisk: &structs.EphemeralDisk{},
+		Tasks: []*structs.Task{
+			{
+				Name: "web",
+				Resources: &structs.Resources{
+					Cores:    4,
+					MemoryMB: 256,
+					NUMA:     &structs.NUMA{Affinity: structs.NUMAAffinityRequire},
+				},
+			},
+		},
+	}
+
+	static := NewStaticRankIterator(ctx, nodes)
+	binp := NewBinPackIterator(ctx, static, false, 0, testSchedulerConfig)
+	binp.SetTaskGroup(taskGroup)
+	scoreNorm := NewScoreNormalizationIterator(ctx, binp)
+
+	out := collectRanked(scoreNorm)
+	must.Len(t, 0, out)
+	must.Eq(t, 1, ctx.metrics.DimensionExhausted["numa cores"])
+
+	// Preferring a single NUMA node still places the task.
+	taskGroup.Tasks[0].Resources.NUMA.Affinity = structs.NUMAAffinityPrefer
+	static = NewStaticRankIterator(ctx, nodes)
+	binp = NewBinPackIterator(ctx, static, false, 0, testSchedulerConfig)
+	binp.SetTaskGroup(taskGroup)
+	scoreNorm = NewScoreNormalizationIterator(ctx, binp)
+
+	out = collectRanked(scoreNorm)
+	must.Len(t, 1, out)
+	must.Eq(t, []uint16{1, 2, 3, 5}, out[0].TaskResources["web"].Cpu.ReservedCores)
+}
+
+func TestBinPackIterator_NUMAPreferScore(t *testing.T) {
+	ci.Parallel(t)
+
+	state, ctx := testContext(t)
+	local := numaNode()
+	spread := numaNode()
+	nodes := []*RankedNode{{Node: local}, {Node: spread}}
+
+	// Reserve a core on each NUMA node of the second node so that four cores
+	// can't be reserved from a single NUMA node.
+	alloc := mock.Alloc()
+	alloc.NodeID = spread.ID
+	alloc.AllocatedResources.Tasks["web"].Cpu.ReservedCores = []uint16{0, 4}
+	must.NoError(t, state.UpsertJobSummary(999, mock.JobSummary(alloc.JobID)))
+	must.NoError(t, state.UpsertAllocs(structs.MsgTypeTestSetup, 1000, []*structs.Allocation{alloc}))
+
+	taskGroup := &structs.TaskGroup{
+		EphemeralDisk: &structs.EphemeralDisk{},
+		Tasks: []*structs.Task{
+			{
+				Name: "web",
+				Resources: &structs.Resources{
+					Cores:    4,
+					MemoryMB: 256,
+					NUMA:     &structs.NUMA{Affinity: structs.NUMAAffinityPrefer},
+				},
+			},
+		},
+	}
+
+	static := NewStaticRankIterator(ctx, nodes)
+	binp := NewBinPackIterator(ctx, static, false, 0, testSchedulerConfig)
+	binp.SetTaskGroup(taskGroup)
+
+	out := collectRanked(binp)
+	must.Len(t, 2, out)
+	must.Len(t, 2, out[0].Scores)
+	must.Len(t, 2, out[1].Scores)
+
+	// The node where the task fits in a single NUMA node gets the full NUMA
+	// score, while the other one gets none
+	must.Eq(t, local, out[0].Node)
+	must.Eq(t, 1.0, out[0].Scores[1])
+	must.Eq(t, spread, out[1].Node)
+	must.Eq(t, 0.0, out[1].Scores[1])
+
+	// Without a NUMA preference there is no NUMA score
+	taskGroup.Tasks[0].Resources.NUMA.Affinity = structs.NUMAAffinityNone
+	for _, node := range nodes {
+		node.Scores = nil
+	}
+	static = NewStaticRankIterator(ctx, nodes)
+	binp = NewBinPackIterator(ctx, static, false, 0, testSchedulerConfig)
+	binp.SetTaskGroup(taskGroup)
+
+	out = collectRanked(binp)
+	must.Len(t, 2, out)
+	must.Len(t, 1, out[0].Scores)
+}
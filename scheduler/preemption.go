@@ -470,6 +470,10 @@ func newAllocDeviceGroup() *deviceGroupAllocs {
 // PreemptForDevice tries to find allocations to preempt to meet devices needed
 // This is called once per device request when assigning devices to the task
 func (p *Preemptor) PreemptForDevice(ask *structs.RequestedDevice, devAlloc *deviceAllocator) []*structs.Allocation {
+	// Preempting allocations to free a share of a device isn't supported
+	if ask.Share != 0 {
+		return nil
+	}
 
 	// Group allocations by device, tracking the number of
 	// instances used in each device by alloc id
@@ -483,6 +487,12 @@ func (p *Preemptor) PreemptForDevice(ask *structs.RequestedDevice, devAlloc *dev
 
 			// Go through each assigned device group
 			for _, device := range tr.Devices {
+				// Ignore shares of devices, as preempting a single share
+				// doesn't free the device
+				if device.Share != 0 {
+					continue
+				}
+
 				// Look up the device instance from the device allocator
 				deviceIdTuple := *device.ID()
 				devInst := devAlloc.Devices[deviceIdTuple]
@@ -154,8 +154,12 @@ type BinPackIterator struct {
 	evict                  bool
 	priority               int
 	jobId                  structs.NamespacedID
+	job                    *structs.Job
+	namespace              *structs.Namespace
 	taskGroup              *structs.TaskGroup
 	memoryOversubscription bool
+	schedConfig            *structs.SchedulerConfiguration
+	algorithm              structs.SchedulerAlgorithm
 	scoreFit               func(*structs.Node, *structs.ComparableResources) float64
 }
 
@@ -163,31 +167,52 @@ type BinPackIterator struct {
 // potentially evicting other tasks based on a given priority.
 func NewBinPackIterator(ctx Context, source RankIterator, evict bool, priority int, schedConfig *structs.SchedulerConfiguration) *BinPackIterator {
 
-	algorithm := schedConfig.EffectiveSchedulerAlgorithm()
-	scoreFn := structs.ScoreFitBinPack
-	if algorithm == structs.SchedulerAlgorithmSpread {
-		scoreFn = structs.ScoreFitSpread
-	}
-
 	iter := &BinPackIterator{
 		ctx:                    ctx,
 		source:                 source,
 		evict:                  evict,
 		priority:               priority,
 		memoryOversubscription: schedConfig != nil && schedConfig.MemoryOversubscriptionEnabled,
-		scoreFit:               scoreFn,
+		schedConfig:            schedConfig,
 	}
-	iter.ctx.Logger().Named("binpack").Trace("NewBinPackIterator created", "algorithm", algorithm)
+	iter.setAlgorithm(schedConfig.EffectiveSchedulerAlgorithm())
+	iter.ctx.Logger().Named("binpack").Trace("NewBinPackIterator created", "algorithm", iter.algorithm)
 	return iter
 }
 
 func (iter *BinPackIterator) SetJob(job *structs.Job) {
 	iter.priority = job.Priority
 	iter.jobId = job.NamespacedID()
+	iter.job = job
+
+	// The namespace may override the cluster scheduler algorithm. A failed
+	// lookup falls back to the cluster configuration.
+	iter.namespace = nil
+	if ns, err := iter.ctx.State().NamespaceByName(nil, job.Namespace); err == nil {
+		iter.namespace = ns
+	}
+	iter.setAlgorithm(structs.EffectiveSchedulerAlgorithm(iter.schedConfig, iter.namespace, job, nil))
 }
 
 func (iter *BinPackIterator) SetTaskGroup(taskGroup *structs.TaskGroup) {
 	iter.taskGroup = taskGroup
+	iter.setAlgorithm(structs.EffectiveSchedulerAlgorithm(iter.schedConfig, iter.namespace, iter.job, taskGroup))
+}
+
+// Algorithm returns the scheduler algorithm used to score nodes for the
+// current job and task group.
+func (iter *BinPackIterator) Algorithm() structs.SchedulerAlgorithm {
+	return iter.algorithm
+}
+
+// setAlgorithm sets the function used to score the fit of a node.
+func (iter *BinPackIterator) setAlgorithm(algorithm structs.SchedulerAlgorithm) {
+	iter.algorithm = algorithm
+	if algorithm == structs.SchedulerAlgorithmSpread {
+		iter.scoreFit = structs.ScoreFitSpread
+	} else {
+		iter.scoreFit = structs.ScoreFitBinPack
+	}
 }
 
 func (iter *BinPackIterator) Next() *RankedNode {
@@ -243,6 +268,10 @@ OUTER:
 		totalDeviceAffinityWeight := 0.0
 		sumMatchingAffinities := 0.0
 
+		// Track the tasks that prefer NUMA-local cores and how many of them
+		// got all their cores from a single NUMA node
+		numaPreferTasks, numaLocalTasks := 0, 0
+
 		// Assign the resources for each task
 		total := &structs.AllocatedResources{
 			Tasks: make(map[string]*structs.AllocatedTaskResources,
@@ -355,6 +384,7 @@ OUTER:
 				Memory: structs.AllocatedMemoryResources{
 					MemoryMB: int64(task.Resources.MemoryMB),
 				},
+				Custom: task.Resources.Custom.Copy(),
 			}
 			if iter.memoryOversubscription {
 				taskResources.Memory.MemoryMaxMB = int64(task.Resources.MemoryMaxMB)
@@ -482,8 +512,28 @@ OUTER:
 					continue OUTER
 				}
 
+				// Select the cores to reserve based on the NUMA topology of
+				// the node, accounting for the other tasks of the group that
+				// already have cores reserved.
+				numaSelector := newNUMACoreSelector(option.Node, proposed)
+				for _, tr := range total.Tasks {
+					numaSelector.AddTask(tr)
+				}
+				reservedCores, ok := numaSelector.Select(availableCPUSet, task.Resources.Cores,
+					taskResources.Memory.MemoryMB, task.Resources.NUMA.GetAffinity())
+				if !ok {
+					iter.ctx.Metrics().ExhaustedNode(option.Node, "numa cores")
+					continue OUTER
+				}
+				if task.Resources.NUMA.GetAffinity() == structs.NUMAAffinityPrefer {
+					numaPreferTasks++
+					if numaSelector.IsLocal(reservedCores) {
+						numaLocalTasks++
+					}
+				}
+
 				// Set the task's reserved cores
-				taskResources.Cpu.ReservedCores = availableCPUSet.ToSlice()[0:task.Resources.Cores]
+				taskResources.Cpu.ReservedCores = reservedCores
 				// Total CPU usage on the node is still tracked by CPUShares. Even though the task will have the entire
 				// core reserved, we still track overall usage by cpu shares.
 				taskResources.Cpu.CpuShares = option.Node.NodeResources.Cpu.SharesPerCore() * int64(task.Resources.Cores)
@@ -546,6 +596,14 @@ OUTER:
 			iter.ctx.Metrics().ScoreNode(option.Node, "devices", sumMatchingAffinities)
 		}
 
+		// Score the NUMA locality of the tasks that prefer it, so that nodes
+		// where their cores fit in a single NUMA node are ranked higher
+		if numaPreferTasks != 0 {
+			numaScore := float64(numaLocalTasks) / float64(numaPreferTasks)
+			option.Scores = append(option.Scores, numaScore)
+			iter.ctx.Metrics().ScoreNode(option.Node, "numa", numaScore)
+		}
+
 		return option
 	}
 }
@@ -758,6 +816,115 @@ func matchesAffinity(ctx Context, affinity *structs.Affinity, option *structs.No
 	return checkAffinity(ctx, affinity.Operand, lVal, rVal, lOk, rOk)
 }
 
+// AllocAffinityIterator is used to resolve the allocation affinity rules of
+// the job or task group against the allocations proposed for each node.
+// Required rules filter out the nodes that don't satisfy them while the other
+// rules apply a weighted score.
+type AllocAffinityIterator struct {
+	ctx           Context
+	source        RankIterator
+	namespace     string
+	jobAffinities []*structs.AllocAffinity
+	affinities    []*structs.AllocAffinity
+}
+
+// NewAllocAffinityIterator is used to create an AllocAffinityIterator that
+// applies the allocation affinities of the job or task group.
+func NewAllocAffinityIterator(ctx Context, source RankIterator) *AllocAffinityIterator {
+	return &AllocAffinityIterator{
+		ctx:    ctx,
+		source: source,
+	}
+}
+
+func (iter *AllocAffinityIterator) SetJob(job *structs.Job) {
+	iter.namespace = job.Namespace
+	iter.jobAffinities = job.AllocAffinities
+}
+
+func (iter *AllocAffinityIterator) SetTaskGroup(tg *structs.TaskGroup) {
+	// Merge job and task group allocation affinities
+	iter.affinities = append(iter.affinities, iter.jobAffinities...)
+	iter.affinities = append(iter.affinities, tg.AllocAffinities...)
+}
+
+func (iter *AllocAffinityIterator) Reset() {
+	iter.source.Reset()
+	// This method is called between each task group, so only reset the merged list
+	iter.affinities = nil
+}
+
+func (iter *AllocAffinityIterator) hasAffinities() bool {
+	return len(iter.affinities) > 0
+}
+
+func (iter *AllocAffinityIterator) Next() *RankedNode {
+OUTER:
+	for {
+		option := iter.source.Next()
+		if option == nil {
+			return nil
+		}
+		if !iter.hasAffinities() {
+			iter.ctx.Metrics().ScoreNode(option.Node, "alloc-affinity", 0)
+			return option
+		}
+
+		proposed, err := option.ProposedAllocs(iter.ctx)
+		if err != nil {
+			iter.ctx.Logger().Named("alloc_affinity").Error("failed retrieving proposed allocations", "error", err)
+			continue
+		}
+
+		sumWeight := 0.0
+		totalAffinityScore := 0.0
+		for _, affinity := range iter.affinities {
+			matched := iter.matchesAllocAffinity(affinity, proposed)
+
+			if affinity.Required {
+				if matched == affinity.Anti {
+					iter.ctx.Metrics().FilterNode(option.Node, affinity.String())
+					continue OUTER
+				}
+				continue
+			}
+
+			sumWeight += float64(affinity.Weight)
+			if matched {
+				if affinity.Anti {
+					totalAffinityScore -= float64(affinity.Weight)
+				} else {
+					totalAffinityScore += float64(affinity.Weight)
+				}
+			}
+		}
+
+		if totalAffinityScore != 0.0 {
+			normScore := totalAffinityScore / sumWeight
+			option.Scores = append(option.Scores, normScore)
+			iter.ctx.Metrics().ScoreNode(option.Node, "alloc-affinity", normScore)
+		}
+		return option
+	}
+}
+
+// matchesAllocAffinity returns true if any of the proposed allocations matches
+// the allocation affinity.
+func (iter *AllocAffinityIterator) matchesAllocAffinity(affinity *structs.AllocAffinity, proposed []*structs.Allocation) bool {
+	for _, alloc := range proposed {
+		// Allocations in the plan may not have their job set when they use
+		// the plan job.
+		job := alloc.Job
+		if job == nil {
+			job = iter.ctx.Plan().Job
+		}
+		if affinity.MatchesAlloc(iter.namespace, alloc, job) {
+			return true
+		}
+	}
+	return false
+}
+
 // ScoreNormalizationIterator is used to combine scores from various prior
 // iterators and combine them into one final score. The current implementation
 // averages the scores together.
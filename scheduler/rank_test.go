@@ -4,6 +4,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/hashicorp/nomad/ci"
 	"github.com/hashicorp/nomad/helper/uuid"
 	"github.com/hashicorp/nomad/nomad/mock"
 	"github.com/hashicorp/nomad/nomad/structs"
@@ -1942,6 +1943,184 @@ func TestBinPackIterator_Devices(t *testing.T) {
 	}
 }
 
+// TestBinPackIterator_Custom asserts that custom resources are assigned from
+// the node and that nodes without enough of them are exhausted.
+func TestBinPackIterator_Custom(t *testing.T) {
+	ci.Parallel(t)
+
+	_, ctx := testContext(t)
+	require := require.New(t)
+
+	nodes := []*RankedNode{
+		{
+			Node: &structs.Node{
+				NodeResources: &structs.NodeResources{
+					Cpu: structs.NodeCpuResources{
+						CpuShares: 2048,
+					},
+					Memory: structs.NodeMemoryResources{
+						MemoryMB: 2048,
+					},
+					Custom: structs.CustomResources{"license_seat": 2},
+				},
+			},
+		},
+		{
+			Node: &structs.Node{
+				NodeResources: &structs.NodeResources{
+					Cpu: structs.NodeCpuResources{
+						CpuShares: 2048,
+					},
+					Memory: structs.NodeMemoryResources{
+						MemoryMB: 2048,
+					},
+					Custom: structs.CustomResources{"license_seat": 1},
+				},
+			},
+		},
+		{
+			Node: &structs.Node{
+				NodeResources: &structs.NodeResources{
+					Cpu: structs.NodeCpuResources{
+						CpuShares: 2048,
+					},
+					Memory: structs.NodeMemoryResources{
+						MemoryMB: 2048,
+					},
+				},
+			},
+		},
+	}
+	static := NewStaticRankIterator(ctx, nodes)
+
+	taskGroup := &structs.TaskGroup{
+		EphemeralDisk: &structs.EphemeralDisk{},
+		Tasks: []*structs.Task{
+			{
+				Name: "web",
+				Resources: &structs.Resources{
+					CPU:      1024,
+					MemoryMB: 1024,
+					Custom:   structs.CustomResources{"license_seat": 2},
+				},
+			},
+		},
+	}
+	binp := NewBinPackIterator(ctx, static, false, 0, testSchedulerConfig)
+	binp.SetTaskGroup(taskGroup)
+
+	scoreNorm := NewScoreNormalizationIterator(ctx, binp)
+
+	out := collectRanked(scoreNorm)
+	require.Len(out, 1)
+	require.Equal(nodes[0], out[0])
+	require.Equal(structs.CustomResources{"license_seat": 2}, out[0].TaskResources["web"].Custom)
+	require.Equal(2, ctx.metrics.DimensionExhausted["custom resource license_seat"])
+}
+
+// TestBinPackIterator_SchedulingAlgorithm asserts that the scheduler algorithm
+// set in the namespace, job or task group overrides the cluster configuration.
+func TestBinPackIterator_SchedulingAlgorithm(t *testing.T) {
+	ci.Parallel(t)
+
+	newNode := func(cpu, memory int64) *structs.Node {
+		return &structs.Node{
+			ID: uuid.Generate(),
+			NodeResources: &structs.NodeResources{
+				Cpu: structs.NodeCpuResources{
+					CpuShares: cpu,
+				},
+				Memory: structs.NodeMemoryResources{
+					MemoryMB: memory,
+				},
+			},
+		}
+	}
+
+	// The small node is a perfect fit while the large node stays mostly
+	// empty, so binpack prefers the former and spread the latter.
+	small := newNode(1024, 1024)
+	large := newNode(4096, 4096)
+
+	cases := []struct {
+		name      string
+		nsAlgo    structs.SchedulerAlgorithm
+		jobAlgo   structs.SchedulerAlgorithm
+		groupAlgo structs.SchedulerAlgorithm
+		expected  structs.SchedulerAlgorithm
+	}{
+		{
+			name:     "cluster default",
+			expected: structs.SchedulerAlgorithmBinpack,
+		},
+		{
+			name:     "namespace",
+			nsAlgo:   structs.SchedulerAlgorithmSpread,
+			expected: structs.SchedulerAlgorithmSpread,
+		},
+		{
+			name:     "job overrides namespace",
+			nsAlgo:   structs.SchedulerAlgorithmSpread,
+			jobAlgo:  structs.SchedulerAlgorithmBinpack,
+			expected: structs.SchedulerAlgorithmBinpack,
+		},
+		{
+			name:      "group overrides job",
+			jobAlgo:   structs.SchedulerAlgorithmBinpack,
+			groupAlgo: structs.SchedulerAlgorithmSpread,
+			expected:  structs.SchedulerAlgorithmSpread,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			state, ctx := testContext(t)
+
+			ns := mock.Namespace()
+			if tc.nsAlgo != "" {
+				ns.Scheduling = &structs.Scheduling{Algorithm: tc.nsAlgo}
+			}
+			require.NoError(t, state.UpsertNamespaces(1000, []*structs.Namespace{ns}))
+
+			job := mock.Job()
+			job.Namespace = ns.Name
+			if tc.jobAlgo != "" {
+				job.Scheduling = &structs.Scheduling{Algorithm: tc.jobAlgo}
+			}
+			taskGroup := &structs.TaskGroup{
+				EphemeralDisk: &structs.EphemeralDisk{},
+				Tasks: []*structs.Task{
+					{
+						Name: "web",
+						Resources: &structs.Resources{
+							CPU:      1024,
+							MemoryMB: 1024,
+						},
+					},
+				},
+			}
+			if tc.groupAlgo != "" {
+				taskGroup.Scheduling = &structs.Scheduling{Algorithm: tc.groupAlgo}
+			}
+
+			nodes := []*RankedNode{{Node: small}, {Node: large}}
+			static := NewStaticRankIterator(ctx, nodes)
+			binp := NewBinPackIterator(ctx, static, false, 0, testSchedulerConfig)
+			binp.SetJob(job)
+			binp.SetTaskGroup(taskGroup)
+			require.Equal(t, tc.expected, binp.Algorithm())
+
+			out := collectRanked(NewScoreNormalizationIterator(ctx, binp))
+			require.Len(t, out, 2)
+			if tc.expected == structs.SchedulerAlgorithmSpread {
+				require.Greater(t, out[1].FinalScore, out[0].FinalScore)
+			} else {
+				require.Greater(t, out[0].FinalScore, out[1].FinalScore)
+			}
+		})
+	}
+}
+
 func TestJobAntiAffinity_PlannedAlloc(t *testing.T) {
 	_, ctx := testContext(t)
 	nodes := []*RankedNode{
@@ -2011,6 +2190,95 @@ func TestJobAntiAffinity_PlannedAlloc(t *testing.T) {
 	}
 }
 
+func TestAllocAffinityIterator(t *testing.T) {
+	_, ctx := testContext(t)
+	nodes := []*RankedNode{
+		{Node: mock.Node()},
+		{Node: mock.Node()},
+		{Node: mock.Node()},
+		{Node: mock.Node()},
+	}
+
+	cache := mock.Job()
+	cache.ID = "cache"
+	payments := mock.Job()
+	payments.ID = "payments"
+	payments.Meta = map[string]string{"team": "payments"}
+
+	// Node 0 runs the cache, node 1 runs the cache and a payments job, node 2
+	// runs the web job and node 3 runs nothing.
+	plan := ctx.Plan()
+	plan.NodeAllocation[nodes[0].Node.ID] = []*structs.Allocation{
+		{ID: uuid.Generate(), Namespace: cache.Namespace, JobID: cache.ID, TaskGroup: "web", Job: cache},
+	}
+	plan.NodeAllocation[nodes[1].Node.ID] = []*structs.Allocation{
+		{ID: uuid.Generate(), Namespace: cache.Namespace, JobID: cache.ID, TaskGroup: "web", Job: cache},
+		{ID: uuid.Generate(), Namespace: payments.Namespace, JobID: payments.ID, TaskGroup: "web", Job: payments},
+	}
+	plan.NodeAllocation[nodes[2].Node.ID] = []*structs.Allocation{
+		{ID: uuid.Generate(), Namespace: structs.DefaultNamespace, JobID: "web", TaskGroup: "web"},
+	}
+
+	job := mock.Job()
+	job.ID = "web"
+	job.AllocAffinities = []*structs.AllocAffinity{
+		{
+			JobID:  "cache",
+			Weight: 100,
+		},
+	}
+	tg := job.TaskGroups[0]
+	tg.AllocAffinities = []*structs.AllocAffinity{
+		{
+			JobID:     "web",
+			TaskGroup: "web",
+			Anti:      true,
+			Required:  true,
+		},
+		{
+			JobMeta: map[string]string{"team": "payments"},
+			Anti:    true,
+			Weight:  50,
+		},
+	}
+
+	static := NewStaticRankIterator(ctx, nodes)
+	allocAffinity := NewAllocAffinityIterator(ctx, static)
+	allocAffinity.SetJob(job)
+	allocAffinity.SetTaskGroup(tg)
+
+	scoreNorm := NewScoreNormalizationIterator(ctx, allocAffinity)
+
+	out := collectRanked(scoreNorm)
+	require.Len(t, out, 3)
+
+	expectedScores := make(map[string]float64)
+	// Total weight = 150
+	// Node 0 matches the cache affinity, weight = 100
+	expectedScores[nodes[0].Node.ID] = 2.0 / 3.0
+
+	// Node 1 matches the cache affinity and the payments anti affinity,
+	// weight = 50
+	expectedScores[nodes[1].Node.ID] = 1.0 / 3.0
+
+	// Node 2 runs the web job and is filtered out by the required anti
+	// affinity
+
+	// Node 3 matches nothing
+	expectedScores[nodes[3].Node.ID] = 0
+
+	for _, n := range out {
+		require.NotEqual(t, nodes[2].Node.ID, n.Node.ID)
+		require.Equal(t, expectedScores[n.Node.ID], n.FinalScore)
+	}
+	require.Equal(t, 1, ctx.Metrics().NodesFiltered)
+	require.Equal(t, 1, ctx.Metrics().ConstraintFiltered[tg.AllocAffinities[0].String()])
+
+	// Reset clears the task group rules
+	allocAffinity.Reset()
+	require.False(t, allocAffinity.hasAffinities())
+}
+
 func collectRanked(iter RankIterator) (out []*RankedNode) {
 	for {
 		next := iter.Next()
@@ -133,6 +133,10 @@ type reconcileResults struct {
 	// desiredFollowupEvals is the map of follow up evaluations to create per task group
 	// This is used to create a delayed evaluation for rescheduling failed allocations.
 	desiredFollowupEvals map[string][]*structs.Evaluation
+
+	// deferredTGAllocs is the map of task groups whose placements wait for
+	// the task groups they depend on, to the reason they are waiting.
+	deferredTGAllocs map[string]string
 }
 
 // delayedRescheduleInfo contains the allocation id and a time when its eligible to be rescheduled.
@@ -162,6 +166,9 @@ func (r *reconcileResults) GoString() string {
 	for tg, u := range r.desiredTGUpdates {
 		base += fmt.Sprintf("\nDesired Changes for %q: %#v", tg, u)
 	}
+	for tg, reason := range r.deferredTGAllocs {
+		base += fmt.Sprintf("\nDeferred Placements for %q: %s", tg, reason)
+	}
 	return base
 }
 
@@ -195,6 +202,7 @@ func NewAllocReconciler(logger log.Logger, allocUpdateFn allocUpdateType, batch
 			reconnectUpdates:     make(map[string]*structs.Allocation),
 			desiredTGUpdates:     make(map[string]*structs.DesiredUpdates),
 			desiredFollowupEvals: make(map[string][]*structs.Evaluation),
+			deferredTGAllocs:     make(map[string]string),
 		},
 	}
 }
@@ -412,6 +420,10 @@ func (a *allocReconciler) computeGroup(groupName string, all allocSet) bool {
 
 	dstate, existingDeployment := a.initializeDeploymentState(groupName, tg)
 
+	// Determine whether placements have to wait for the task groups this
+	// group depends on.
+	dependencyWait := a.computeDependencyWait(tg)
+
 	// Filter allocations that do not need to be considered because they are
 	// from an older job version and are terminal.
 	all, ignore := a.filterOldTerminalAllocs(all)
@@ -442,6 +454,18 @@ func (a *allocReconciler) computeGroup(groupName string, all allocSet) bool {
 	// lostLaterEvals so that computeStop can add them to the stop set.
 	lostLaterEvals = helper.MergeMapStringString(lostLaterEvals, timeoutLaterEvals)
 
+	// Replace the remaining members of a gang when one of them is gone.
+	// Members waiting for a delayed reschedule are replaced along with the
+	// rest of the gang, so their follow-up evaluations don't replace them
+	// again on their own.
+	gangStop, gangReschedule := a.computeGangStop(tg, untainted, rescheduleNow, lost, rescheduleLater)
+	desiredChanges.Stop += uint64(len(gangStop))
+	untainted = untainted.difference(gangStop, gangReschedule)
+	if len(gangReschedule) > 0 {
+		rescheduleNow = rescheduleNow.union(gangReschedule)
+		rescheduleLater = nil
+	}
+
 	// Create batched follow-up evaluations for allocations that are
 	// reschedulable later and mark the allocations for in place updating
 	a.createRescheduleLaterEvals(rescheduleLater, all, tg.Name)
@@ -451,6 +475,12 @@ func (a *allocReconciler) computeGroup(groupName string, all allocSet) bool {
 	// nodes, and allocs on down nodes (includes canaries)
 	nameIndex := newAllocNameIndex(a.jobID, groupName, tg.Count, untainted.union(migrate, rescheduleNow, lost))
 
+	// Allocations placed ahead of the ones they replace by a max_surge
+	// rolling update are not counted against the group count until the
+	// allocations they replace are stopped.
+	surge := a.filterSurge(tg, untainted)
+	untainted = untainted.difference(surge)
+
 	// Stop any unneeded allocations and update the untainted set to not
 	// include stopped allocations.
 	isCanarying := dstate != nil && dstate.DesiredCanaries != 0 && !dstate.Promoted
@@ -477,13 +507,14 @@ func (a *allocReconciler) computeGroup(groupName string, all allocSet) bool {
 		untainted = untainted.difference(canaries)
 	}
 	requiresCanaries := a.requiresCanaries(tg, dstate, destructive, canaries)
-	if requiresCanaries {
+	if requiresCanaries && dependencyWait == "" {
 		a.computeCanaries(tg, dstate, destructive, canaries, desiredChanges, nameIndex)
 	}
 
 	// Determine how many non-canary allocs we can place
 	isCanarying = dstate != nil && dstate.DesiredCanaries != 0 && !dstate.Promoted
 	underProvisionedBy := a.computeUnderProvisionedBy(tg, untainted, destructive, migrate, isCanarying)
+	underProvisionedBy = a.computeStageLimit(dstate, untainted, underProvisionedBy)
 
 	// Place if:
 	// * The deployment is not paused or failed
@@ -492,9 +523,15 @@ func (a *allocReconciler) computeGroup(groupName string, all allocSet) bool {
 	// * There is no delayed stop_after_client_disconnect alloc, which delays scheduling for the whole group
 	// * An alloc was lost
 	// * There is not a corresponding reconnecting alloc.
+	// * The task groups the group depends on are ready, unless the placement
+	//   replaces a lost or rescheduled alloc
 	var place []allocPlaceResult
+	deferred := 0
 	if len(lostLater) == 0 {
 		place = a.computePlacements(tg, nameIndex, untainted, migrate, rescheduleNow, lost, reconnecting, isCanarying)
+		if dependencyWait != "" {
+			place, deferred = filterReplacements(place)
+		}
 		if !existingDeployment {
 			dstate.DesiredTotal += len(place)
 		}
@@ -506,12 +543,28 @@ func (a *allocReconciler) computeGroup(groupName string, all allocSet) bool {
 
 	underProvisionedBy = a.computeReplacements(deploymentPlaceReady, desiredChanges, place, rescheduleNow, lost, underProvisionedBy)
 
-	if deploymentPlaceReady {
+	// Destructive updates, like initial placements, wait for the task groups
+	// the group depends on.
+	updateReady := deploymentPlaceReady && dependencyWait == ""
+
+	// remaining is the set of allocations that still require a destructive
+	// update once this evaluation is applied.
+	remaining := destructive
+	if updateReady && tg.Update != nil && tg.Update.MaxSurge > 0 {
+		remaining = a.computeSurgeUpdates(tg, dstate, destructive, untainted, surge, desiredChanges)
+	} else if updateReady {
 		a.computeDestructiveUpdates(destructive, underProvisionedBy, desiredChanges, tg)
 	} else {
 		desiredChanges.Ignore += uint64(len(destructive))
 	}
 
+	// Record the wait only when it held back placements or updates.
+	if dependencyWait != "" && (deferred > 0 || len(destructive) > 0 || requiresCanaries) {
+		a.result.deferredTGAllocs[groupName] = dependencyWait
+	}
+
+	// Migrations move running allocs off draining nodes without changing
+	// what runs, so they don't wait for the task groups the group depends on.
 	a.computeMigrations(desiredChanges, migrate, tg, isCanarying)
 	a.createDeployment(tg.Name, tg.Update, existingDeployment, dstate, all, destructive)
 
@@ -521,7 +574,7 @@ func (a *allocReconciler) computeGroup(groupName string, all allocSet) bool {
 		a.result.deployment = a.deployment
 	}
 
-	deploymentComplete := a.isDeploymentComplete(groupName, destructive, inplace,
+	deploymentComplete := a.isDeploymentComplete(groupName, remaining, inplace,
 		migrate, rescheduleNow, place, rescheduleLater, requiresCanaries)
 
 	return deploymentComplete
@@ -538,15 +591,105 @@ func (a *allocReconciler) initializeDeploymentState(group string, tg *structs.Ta
 	if !existingDeployment {
 		dstate = &structs.DeploymentState{}
 		if !tg.Update.IsEmpty() {
-			dstate.AutoRevert = tg.Update.AutoRevert
+			// Staged rollouts are always reverted when a stage fails.
+			dstate.AutoRevert = tg.Update.AutoRevert || len(tg.Update.Stages) > 0
 			dstate.AutoPromote = tg.Update.AutoPromote
 			dstate.ProgressDeadline = tg.Update.ProgressDeadline
+			dstate.Stages = structs.CopySliceUpdateStages(tg.Update.Stages)
 		}
 	}
 
 	return dstate, existingDeployment
 }
 
+// computeGangStop returns the set of allocations to stop because another
+// member of their gang task group was lost or needs to be rescheduled. The
+// allocations of a gang only make progress together, so the whole group is
+// replaced instead of just the missing members. Members waiting for a delayed
+// reschedule are returned as the second set so they are rescheduled now with
+// the rest of the gang. Paused and failed deployments only replace the
+// missing members, so the gang is left as is.
+func (a *allocReconciler) computeGangStop(tg *structs.TaskGroup, untainted, rescheduleNow, lost allocSet,
+	rescheduleLater []*delayedRescheduleInfo) (allocSet, allocSet) {
+	if !tg.Gang || (len(rescheduleNow) == 0 && len(lost) == 0) {
+		return nil, nil
+	}
+	if a.deploymentPaused || a.deploymentFailed {
+		return nil, nil
+	}
+
+	reschedule := make(allocSet, len(rescheduleLater))
+	for _, info := range rescheduleLater {
+		reschedule[info.allocID] = info.alloc
+	}
+
+	stop := make(allocSet)
+	for id, alloc := range untainted {
+		if alloc.TerminalStatus() {
+			continue
+		}
+		stop[id] = alloc
+	}
+	a.markStop(stop, "", allocGangMemberLost)
+	return stop, reschedule
+}
+
+// computeDependencyWait returns the reason the placements of the task group
+// wait for the task groups it depends on, or an empty string if every
+// dependency is ready.
+func (a *allocReconciler) computeDependencyWait(tg *structs.TaskGroup) string {
+	for _, dep := range tg.DependsOn {
+		depTG := a.job.LookupTaskGroup(dep.Group)
+		if depTG == nil {
+			continue
+		}
+
+		ready := 0
+		for _, alloc := range a.existingAllocs {
+			if alloc.TaskGroup == dep.Group && a.dependencyReady(alloc, dep.Condition) {
+				ready++
+			}
+		}
+		if ready < depTG.Count {
+			return fmt.Sprintf("waiting for task group %q to be %s (%d/%d)",
+				dep.Group, dep.Condition, ready, depTG.Count)
+		}
+	}
+	return ""
+}
+
+// filterReplacements returns the placements that replace a lost or rescheduled
+// allocation, which don't wait for the task groups the group depends on, and
+// the number of initial placements that were removed.
+func filterReplacements(place []allocPlaceResult) ([]allocPlaceResult, int) {
+	replacements := make([]allocPlaceResult, 0, len(place))
+	for _, p := range place {
+		if p.previousAlloc != nil {
+			replacements = append(replacements, p)
+		}
+	}
+	return replacements, len(place) - len(replacements)
+}
+
+// dependencyReady returns whether the allocation has reached the condition of
+// a group dependency.
+func (a *allocReconciler) dependencyReady(alloc *structs.Allocation, condition string) bool {
+	switch condition {
+	case structs.GroupDependencyComplete:
+		// Complete allocations from older versions of a batch job are ignored
+		// and placed again, so they don't count either.
+		older := alloc.Job.Version < a.job.Version || alloc.Job.CreateIndex < a.job.CreateIndex
+		return !older && alloc.ClientStatus == structs.AllocClientStatusComplete
+	case structs.GroupDependencyHealthy:
+		if alloc.TerminalStatus() || alloc.ClientStatus != structs.AllocClientStatusRunning {
+			return false
+		}
+		// Allocations placed by a deployment also have to be healthy.
+		return alloc.DeploymentID == "" || alloc.DeploymentStatus.IsHealthy()
+	}
+	return false
+}
+
 // If we have destructive updates, and have fewer canaries than is desired, we need to create canaries.
 func (a *allocReconciler) requiresCanaries(tg *structs.TaskGroup, dstate *structs.DeploymentState, destructive, canaries allocSet) bool {
 	canariesPromoted := dstate != nil && dstate.Promoted
@@ -695,6 +838,29 @@ func (a *allocReconciler) computeUnderProvisionedBy(group *structs.TaskGroup, un
 	return underProvisionedBy
 }
 
+// computeStageLimit caps the number of allocs that can be replaced so that
+// a staged rollout does not go beyond its current stage until promoted.
+func (a *allocReconciler) computeStageLimit(dstate *structs.DeploymentState, untainted allocSet, underProvisionedBy int) int {
+	if dstate == nil || !dstate.StagedRollout() {
+		return underProvisionedBy
+	}
+
+	var placed int
+	if a.deployment != nil {
+		partOf, _ := untainted.filterByDeployment(a.deployment.ID)
+		placed = len(partOf)
+	}
+
+	limit := dstate.StageAllowance() - placed
+	if limit < 0 {
+		limit = 0
+	}
+	if limit < underProvisionedBy {
+		return limit
+	}
+	return underProvisionedBy
+}
+
 // computePlacements returns the set of allocations to place given the group
 // definition, the set of untainted, migrating and reschedule allocations for the group.
 //
@@ -851,6 +1017,93 @@ func (a *allocReconciler) computeDestructiveUpdates(destructive allocSet, underP
 	}
 }
 
+// filterSurge returns the allocations of the deployment placed by a max_surge
+// rolling update that run alongside the older allocation with the same name
+// they replace.
+func (a *allocReconciler) filterSurge(group *structs.TaskGroup, untainted allocSet) allocSet {
+	surge := make(allocSet)
+	if group.Update == nil || group.Update.MaxSurge == 0 || a.deployment == nil {
+		return surge
+	}
+
+	older := make(map[string]struct{})
+	for _, alloc := range untainted {
+		if alloc.Job.Version != a.job.Version || alloc.Job.CreateIndex != a.job.CreateIndex {
+			older[alloc.Name] = struct{}{}
+		}
+	}
+
+	for id, alloc := range untainted {
+		if alloc.DeploymentID != a.deployment.ID || alloc.DeploymentStatus.IsCanary() {
+			continue
+		}
+		if _, ok := older[alloc.Name]; ok {
+			surge[id] = alloc
+		}
+	}
+	return surge
+}
+
+// computeSurgeUpdates replaces the allocations requiring a destructive update
+// by placing their replacement first and stopping them once the replacement
+// is healthy. At most max_surge replacements can be placed and not yet
+// healthy. It returns the allocations that are not stopped.
+func (a *allocReconciler) computeSurgeUpdates(group *structs.TaskGroup, dstate *structs.DeploymentState,
+	destructive, untainted, surge allocSet, desiredChanges *structs.DesiredUpdates) allocSet {
+
+	replacements := make(map[string]*structs.Allocation, len(surge))
+	limit := group.Update.MaxSurge
+	unhealthy := false
+	for _, alloc := range surge {
+		replacements[alloc.Name] = alloc
+		if alloc.DeploymentStatus.IsUnhealthy() {
+			unhealthy = true
+		} else if !alloc.DeploymentStatus.IsHealthy() {
+			limit--
+		}
+	}
+
+	// An unhealthy replacement means nothing else should be placed.
+	if unhealthy {
+		limit = 0
+	}
+	limit = a.computeStageLimit(dstate, untainted.union(surge), limit)
+
+	remaining := make(allocSet)
+	for _, alloc := range destructive.nameOrder() {
+		if replacement, ok := replacements[alloc.Name]; ok {
+			if replacement.DeploymentStatus.IsHealthy() {
+				desiredChanges.Stop++
+				a.result.stop = append(a.result.stop, allocStopResult{
+					alloc:             alloc,
+					statusDescription: allocUpdating,
+				})
+			} else {
+				desiredChanges.Ignore++
+				remaining[alloc.ID] = alloc
+			}
+			continue
+		}
+
+		remaining[alloc.ID] = alloc
+		if limit <= 0 {
+			desiredChanges.Ignore++
+			continue
+		}
+		limit--
+
+		// The replacement isn't chained to the allocation it replaces since
+		// clients wait for the previous allocation to stop before starting
+		// a new one.
+		desiredChanges.DestructiveUpdate++
+		a.result.place = append(a.result.place, allocPlaceResult{
+			name:      alloc.Name,
+			taskGroup: group,
+		})
+	}
+	return remaining
+}
+
 func (a *allocReconciler) computeMigrations(desiredChanges *structs.DesiredUpdates, migrate allocSet, tg *structs.TaskGroup, isCanarying bool) {
 	desiredChanges.Migrate += uint64(len(migrate))
 	for _, alloc := range migrate.nameOrder() {
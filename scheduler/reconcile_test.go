@@ -933,6 +933,325 @@ func TestReconciler_LostNode(t *testing.T) {
 	assertNamesHaveIndexes(t, intRange(0, 1), placeResultsToNames(r.place))
 }
 
+// Tests the reconciler replaces the whole gang when a member is lost
+func TestReconciler_LostNode_Gang(t *testing.T) {
+	ci.Parallel(t)
+
+	job := mock.Job()
+	job.TaskGroups[0].Gang = true
+
+	// Create 10 existing allocations
+	var allocs []*structs.Allocation
+	for i := 0; i < 10; i++ {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = uuid.Generate()
+		alloc.Name = structs.AllocName(job.ID, job.TaskGroups[0].Name, uint(i))
+		allocs = append(allocs, alloc)
+	}
+
+	// Mark the node of a single allocation as down
+	n := mock.Node()
+	n.ID = allocs[0].NodeID
+	n.Status = structs.NodeStatusDown
+	tainted := map[string]*structs.Node{n.ID: n}
+
+	reconciler := NewAllocReconciler(testlog.HCLogger(t), allocUpdateFnIgnore, false, job.ID, job,
+		nil, allocs, tainted, "", 50, true)
+	r := reconciler.Compute()
+
+	// Assert the whole gang is stopped and placed again
+	assertResults(t, r, &resultExpectation{
+		createDeployment:  nil,
+		deploymentUpdates: nil,
+		place:             10,
+		inplace:           0,
+		stop:              10,
+		desiredTGUpdates: map[string]*structs.DesiredUpdates{
+			job.TaskGroups[0].Name: {
+				Place: 10,
+				Stop:  10,
+			},
+		},
+	})
+
+	assertNamesHaveIndexes(t, intRange(0, 9), stopResultsToNames(r.stop))
+	assertNamesHaveIndexes(t, intRange(0, 9), placeResultsToNames(r.place))
+	for _, stop := range r.stop {
+		if stop.alloc.ID == allocs[0].ID {
+			require.Equal(t, structs.AllocClientStatusLost, stop.clientStatus)
+		} else {
+			require.Equal(t, allocGangMemberLost, stop.statusDescription)
+		}
+	}
+}
+
+// Tests the reconciler replaces members of a gang waiting for a delayed
+// reschedule along with the rest of the gang
+func TestReconciler_LostNode_Gang_RescheduleLater(t *testing.T) {
+	ci.Parallel(t)
+
+	job := mock.Job()
+	job.TaskGroups[0].Gang = true
+	job.TaskGroups[0].ReschedulePolicy = &structs.ReschedulePolicy{
+		Delay:         time.Hour,
+		DelayFunction: "constant",
+		Unlimited:     true,
+	}
+
+	// Create 10 existing allocations
+	var allocs []*structs.Allocation
+	for i := 0; i < 10; i++ {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = uuid.Generate()
+		alloc.Name = structs.AllocName(job.ID, job.TaskGroups[0].Name, uint(i))
+		allocs = append(allocs, alloc)
+	}
+
+	// Mark one allocation as failed, to be rescheduled in an hour
+	now := time.Now()
+	allocs[1].ClientStatus = structs.AllocClientStatusFailed
+	allocs[1].TaskStates = map[string]*structs.TaskState{"web": {State: "start",
+		StartedAt:  now.Add(-1 * time.Hour),
+		FinishedAt: now}}
+
+	// Mark the node of another allocation as down
+	n := mock.Node()
+	n.ID = allocs[0].NodeID
+	n.Status = structs.NodeStatusDown
+	tainted := map[string]*structs.Node{n.ID: n}
+
+	reconciler := NewAllocReconciler(testlog.HCLogger(t), allocUpdateFnIgnore, false, job.ID, job,
+		nil, allocs, tainted, "", 50, true)
+	r := reconciler.Compute()
+
+	// Assert the failed allocation is replaced with the gang instead of
+	// waiting for its own follow-up evaluation
+	require.Empty(t, r.desiredFollowupEvals)
+	assertResults(t, r, &resultExpectation{
+		createDeployment:  nil,
+		deploymentUpdates: nil,
+		place:             10,
+		inplace:           0,
+		stop:              10,
+		desiredTGUpdates: map[string]*structs.DesiredUpdates{
+			job.TaskGroups[0].Name: {
+				Place: 10,
+				Stop:  10,
+			},
+		},
+	})
+
+	assertNamesHaveIndexes(t, intRange(0, 9), placeResultsToNames(r.place))
+	for _, place := range r.place {
+		if place.previousAlloc != nil && place.previousAlloc.ID == allocs[1].ID {
+			require.True(t, place.reschedule)
+		}
+	}
+}
+
+// Tests the reconciler defers the placements of a group until the groups it
+// depends on are ready
+func TestReconciler_GroupDependency(t *testing.T) {
+	ci.Parallel(t)
+
+	testCases := []struct {
+		name          string
+		condition     string
+		migrateStatus string
+		deploymentID  string
+		deferred      bool
+	}{
+		{
+			name:      "not placed",
+			condition: structs.GroupDependencyComplete,
+			deferred:  true,
+		},
+		{
+			name:          "running not complete",
+			condition:     structs.GroupDependencyComplete,
+			migrateStatus: structs.AllocClientStatusRunning,
+			deferred:      true,
+		},
+		{
+			name:          "complete",
+			condition:     structs.GroupDependencyComplete,
+			migrateStatus: structs.AllocClientStatusComplete,
+		},
+		{
+			name:          "running healthy",
+			condition:     structs.GroupDependencyHealthy,
+			migrateStatus: structs.AllocClientStatusRunning,
+		},
+		{
+			name:          "running in deployment without health",
+			condition:     structs.GroupDependencyHealthy,
+			migrateStatus: structs.AllocClientStatusRunning,
+			deploymentID:  uuid.Generate(),
+			deferred:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			job := mock.BatchJob()
+			job.TaskGroups[0].Count = 2
+			migrate := job.TaskGroups[0].Copy()
+			migrate.Name = "migrate"
+			migrate.Count = 1
+			job.TaskGroups = append(job.TaskGroups, migrate)
+			job.TaskGroups[0].DependsOn = []*structs.GroupDependency{
+				{Group: "migrate", Condition: tc.condition},
+			}
+
+			var allocs []*structs.Allocation
+			if tc.migrateStatus != "" {
+				alloc := mock.Alloc()
+				alloc.Job = job
+				alloc.JobID = job.ID
+				alloc.NodeID = uuid.Generate()
+				alloc.TaskGroup = migrate.Name
+				alloc.Name = structs.AllocName(job.ID, migrate.Name, 0)
+				alloc.ClientStatus = tc.migrateStatus
+				alloc.DeploymentID = tc.deploymentID
+				allocs = append(allocs, alloc)
+			}
+
+			reconciler := NewAllocReconciler(testlog.HCLogger(t), allocUpdateFnIgnore, true, job.ID, job,
+				nil, allocs, nil, "", 50, true)
+			r := reconciler.Compute()
+
+			migratePlace := 0
+			if tc.migrateStatus == "" {
+				migratePlace = 1
+			}
+			webPlace := 2
+			if tc.deferred {
+				webPlace = 0
+				require.Contains(t, r.deferredTGAllocs["web"], `waiting for task group "migrate"`)
+			} else {
+				require.Empty(t, r.deferredTGAllocs)
+			}
+
+			assertResults(t, r, &resultExpectation{
+				place: migratePlace + webPlace,
+				desiredTGUpdates: map[string]*structs.DesiredUpdates{
+					"web": {
+						Place: uint64(webPlace),
+					},
+					"migrate": {
+						Place:  uint64(migratePlace),
+						Ignore: uint64(len(allocs)),
+					},
+				},
+			})
+		})
+	}
+}
+
+// Tests the reconciler replaces lost, rescheduled and migrating allocations
+// of a group while the groups it depends on are not ready, but defers its
+// destructive updates
+func TestReconciler_GroupDependency_Replacements(t *testing.T) {
+	ci.Parallel(t)
+
+	newJob := func() *structs.Job {
+		job := mock.Job()
+		job.TaskGroups[0].Count = 4
+		job.TaskGroups[0].ReschedulePolicy = &structs.ReschedulePolicy{
+			Attempts: 1,
+			Interval: 24 * time.Hour,
+		}
+		db := job.TaskGroups[0].Copy()
+		db.Name = "db"
+		db.Count = 1
+		job.TaskGroups = append(job.TaskGroups, db)
+		job.TaskGroups[0].DependsOn = []*structs.GroupDependency{
+			{Group: "db", Condition: structs.GroupDependencyHealthy},
+		}
+		return job
+	}
+	newAllocs := func(job *structs.Job) []*structs.Allocation {
+		var allocs []*structs.Allocation
+		for i := 0; i < 4; i++ {
+			alloc := mock.Alloc()
+			alloc.Job = job
+			alloc.JobID = job.ID
+			alloc.NodeID = uuid.Generate()
+			alloc.Name = structs.AllocName(job.ID, "web", uint(i))
+			alloc.ClientStatus = structs.AllocClientStatusRunning
+			allocs = append(allocs, alloc)
+		}
+		return allocs
+	}
+
+	t.Run("replacements", func(t *testing.T) {
+		job := newJob()
+		allocs := newAllocs(job)
+
+		tainted := make(map[string]*structs.Node, 2)
+		lostNode := mock.Node()
+		lostNode.ID = allocs[0].NodeID
+		lostNode.Status = structs.NodeStatusDown
+		tainted[lostNode.ID] = lostNode
+
+		drainNode := mock.DrainNode()
+		drainNode.ID = allocs[1].NodeID
+		allocs[1].DesiredTransition.Migrate = pointer.Of(true)
+		tainted[drainNode.ID] = drainNode
+
+		allocs[2].ClientStatus = structs.AllocClientStatusFailed
+
+		reconciler := NewAllocReconciler(testlog.HCLogger(t), allocUpdateFnIgnore, false, job.ID, job,
+			nil, allocs, tainted, "", 50, true)
+		r := reconciler.Compute()
+
+		require.Empty(t, r.deferredTGAllocs)
+		assertResults(t, r, &resultExpectation{
+			place: 4,
+			stop:  3,
+			desiredTGUpdates: map[string]*structs.DesiredUpdates{
+				"web": {
+					Place:   2,
+					Stop:    2,
+					Migrate: 1,
+					Ignore:  1,
+				},
+				"db": {
+					Place: 1,
+				},
+			},
+		})
+		assertPlaceResultsHavePreviousAllocs(t, 3, r.place)
+		assertPlacementsAreRescheduled(t, 1, r.place)
+	})
+
+	t.Run("destructive updates", func(t *testing.T) {
+		job := newJob()
+		allocs := newAllocs(job)
+
+		reconciler := NewAllocReconciler(testlog.HCLogger(t), allocUpdateFnDestructive, false, job.ID, job,
+			nil, allocs, nil, "", 50, true)
+		r := reconciler.Compute()
+
+		require.Contains(t, r.deferredTGAllocs["web"], `waiting for task group "db"`)
+		assertResults(t, r, &resultExpectation{
+			place: 1,
+			desiredTGUpdates: map[string]*structs.DesiredUpdates{
+				"web": {
+					Ignore: 4,
+				},
+				"db": {
+					Place: 1,
+				},
+			},
+		})
+	})
+}
+
 // Tests the reconciler properly handles lost nodes with allocations while
 // scaling up
 func TestReconciler_LostNode_ScaleUp(t *testing.T) {
@@ -2028,6 +2347,71 @@ func TestReconciler_RescheduleNow_Service(t *testing.T) {
 	assertPlacementsAreRescheduled(t, 1, r.place)
 }
 
+// Tests that failed service allocations whose task exit is classified as a
+// failure by the reschedule policy are not rescheduled
+func TestReconciler_RescheduleNow_OnExit(t *testing.T) {
+	ci.Parallel(t)
+
+	job := mock.Job()
+	job.TaskGroups[0].Count = 3
+	now := time.Now()
+
+	job.TaskGroups[0].ReschedulePolicy = &structs.ReschedulePolicy{
+		Attempts: 1,
+		Interval: 24 * time.Hour,
+		Delay:    5 * time.Second,
+		MaxDelay: 1 * time.Hour,
+		OnExit: structs.ExitRules{
+			{ExitCodes: []int{2}, Action: structs.ExitActionFail},
+		},
+	}
+	job.TaskGroups[0].Update = noCanaryUpdate
+
+	var allocs []*structs.Allocation
+	for i := 0; i < 3; i++ {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = uuid.Generate()
+		alloc.Name = structs.AllocName(job.ID, job.TaskGroups[0].Name, uint(i))
+		alloc.ClientStatus = structs.AllocClientStatusRunning
+		allocs = append(allocs, alloc)
+	}
+
+	// Fail two allocations, one with an exit code that is not retried
+	for i, exitCode := range []int{2, 1} {
+		allocs[i].ClientStatus = structs.AllocClientStatusFailed
+		allocs[i].TaskStates = map[string]*structs.TaskState{"web": {
+			State:      structs.TaskStateDead,
+			Failed:     true,
+			StartedAt:  now.Add(-1 * time.Hour),
+			FinishedAt: now.Add(-10 * time.Second),
+			Events: []*structs.TaskEvent{
+				structs.NewTaskEvent(structs.TaskTerminated).SetExitCode(exitCode),
+			},
+		}}
+	}
+
+	reconciler := NewAllocReconciler(testlog.HCLogger(t), allocUpdateFnIgnore, false, job.ID, job,
+		nil, allocs, nil, "", 50, true)
+	r := reconciler.Compute()
+
+	// Verify that only the allocation with the retried exit is rescheduled
+	assertResults(t, r, &resultExpectation{
+		place: 1,
+		stop:  1,
+		desiredTGUpdates: map[string]*structs.DesiredUpdates{
+			job.TaskGroups[0].Name: {
+				Place:  1,
+				Ignore: 2,
+				Stop:   1,
+			},
+		},
+	})
+	assertNamesHaveIndexes(t, intRange(1, 1), placeResultsToNames(r.place))
+	assertPlacementsAreRescheduled(t, 1, r.place)
+}
+
 // Tests rescheduling failed service allocations when there's clock drift (upto a second)
 func TestReconciler_RescheduleNow_WithinAllowedTimeWindow(t *testing.T) {
 	ci.Parallel(t)
@@ -3847,6 +4231,246 @@ func TestReconciler_PromoteCanaries_Unblock(t *testing.T) {
 	assertNamesHaveIndexes(t, intRange(0, 1), stopResultsToNames(r.stop))
 }
 
+// Tests the reconciler only replaces the allocations allowed by the current
+// stage of a staged rollout once the canaries are promoted
+func TestReconciler_PromoteCanaries_Stages(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name        string
+		stage       int
+		destructive int
+	}{
+		{
+			name:        "first stage",
+			stage:       0,
+			destructive: 1,
+		},
+		{
+			name:        "last stage",
+			stage:       1,
+			destructive: 4,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			job := mock.Job()
+			update := canaryUpdate.Copy()
+			update.MaxParallel = 4
+			update.Stages = []*structs.UpdateStage{
+				{Percent: 30},
+				{Percent: 100},
+			}
+			job.TaskGroups[0].Update = update
+
+			// Create an existing deployment that has placed and promoted
+			// some canaries
+			d := structs.NewDeployment(job, 50)
+			s := &structs.DeploymentState{
+				Promoted:        true,
+				DesiredTotal:    10,
+				DesiredCanaries: 2,
+				PlacedAllocs:    2,
+				HealthyAllocs:   2,
+				Stages:          update.Stages,
+				Stage:           tc.stage,
+			}
+			d.TaskGroups[job.TaskGroups[0].Name] = s
+
+			// Create 10 allocations from the old job
+			var allocs []*structs.Allocation
+			for i := 0; i < 10; i++ {
+				alloc := mock.Alloc()
+				alloc.Job = job
+				alloc.JobID = job.ID
+				alloc.NodeID = uuid.Generate()
+				alloc.Name = structs.AllocName(job.ID, job.TaskGroups[0].Name, uint(i))
+				alloc.TaskGroup = job.TaskGroups[0].Name
+				allocs = append(allocs, alloc)
+			}
+
+			// Create the healthy canaries
+			handled := make(map[string]allocUpdateType)
+			for i := 0; i < 2; i++ {
+				canary := mock.Alloc()
+				canary.Job = job
+				canary.JobID = job.ID
+				canary.NodeID = uuid.Generate()
+				canary.Name = structs.AllocName(job.ID, job.TaskGroups[0].Name, uint(i))
+				canary.TaskGroup = job.TaskGroups[0].Name
+				s.PlacedCanaries = append(s.PlacedCanaries, canary.ID)
+				canary.DeploymentID = d.ID
+				canary.DeploymentStatus = &structs.AllocDeploymentStatus{
+					Healthy: pointer.Of(true),
+				}
+				allocs = append(allocs, canary)
+				handled[canary.ID] = allocUpdateFnIgnore
+			}
+
+			mockUpdateFn := allocUpdateFnMock(handled, allocUpdateFnDestructive)
+			reconciler := NewAllocReconciler(testlog.HCLogger(t), mockUpdateFn, false, job.ID, job,
+				d, allocs, nil, "", 50, true)
+			r := reconciler.Compute()
+
+			assertResults(t, r, &resultExpectation{
+				createDeployment:  nil,
+				deploymentUpdates: nil,
+				destructive:       tc.destructive,
+				stop:              2,
+				desiredTGUpdates: map[string]*structs.DesiredUpdates{
+					job.TaskGroups[0].Name: {
+						Stop:              2,
+						DestructiveUpdate: uint64(tc.destructive),
+						Ignore:            uint64(10 - tc.destructive),
+					},
+				},
+			})
+			assertNoCanariesStopped(t, d, r.stop)
+		})
+	}
+}
+
+// Tests the reconciler places replacements ahead of the allocations they
+// replace with max_surge and stops those once the replacement is healthy
+func TestReconciler_MaxSurge(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name        string
+		deployment  bool
+		count       int
+		healthy     int
+		pending     int
+		place       int
+		stop        int
+		ignore      int
+		destructive int
+		complete    bool
+	}{
+		{
+			name:        "first placements",
+			count:       10,
+			place:       2,
+			ignore:      8,
+			destructive: 2,
+		},
+		{
+			name:        "stop replaced",
+			deployment:  true,
+			count:       10,
+			healthy:     1,
+			pending:     1,
+			place:       1,
+			stop:        1,
+			ignore:      8,
+			destructive: 1,
+		},
+		{
+			name:       "complete",
+			deployment: true,
+			count:      2,
+			healthy:    2,
+			stop:       2,
+			complete:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldJob := mock.Job()
+			job := oldJob.Copy()
+			job.Version++
+			job.TaskGroups[0].Count = tc.count
+			update := noCanaryUpdate.Copy()
+			update.MaxSurge = 2
+			job.TaskGroups[0].Update = update
+
+			var d *structs.Deployment
+			if tc.deployment {
+				d = structs.NewDeployment(job, 50)
+				d.TaskGroups[job.TaskGroups[0].Name] = &structs.DeploymentState{
+					DesiredTotal:  tc.count,
+					PlacedAllocs:  tc.healthy + tc.pending,
+					HealthyAllocs: tc.healthy,
+				}
+			}
+
+			// Create the allocations of the old job
+			var allocs []*structs.Allocation
+			for i := 0; i < tc.count; i++ {
+				alloc := mock.Alloc()
+				alloc.Job = oldJob
+				alloc.JobID = job.ID
+				alloc.NodeID = uuid.Generate()
+				alloc.Name = structs.AllocName(job.ID, job.TaskGroups[0].Name, uint(i))
+				alloc.TaskGroup = job.TaskGroups[0].Name
+				allocs = append(allocs, alloc)
+			}
+
+			// Create the replacements placed ahead of them
+			for i := 0; i < tc.healthy+tc.pending; i++ {
+				alloc := mock.Alloc()
+				alloc.Job = job
+				alloc.JobID = job.ID
+				alloc.NodeID = uuid.Generate()
+				alloc.Name = structs.AllocName(job.ID, job.TaskGroups[0].Name, uint(i))
+				alloc.TaskGroup = job.TaskGroups[0].Name
+				alloc.DeploymentID = d.ID
+				alloc.DeploymentStatus = &structs.AllocDeploymentStatus{}
+				if i < tc.healthy {
+					alloc.DeploymentStatus.Healthy = pointer.Of(true)
+				}
+				allocs = append(allocs, alloc)
+			}
+
+			reconciler := NewAllocReconciler(testlog.HCLogger(t), allocUpdateFnDestructive, false, job.ID, job,
+				d, allocs, nil, "", 50, true)
+			r := reconciler.Compute()
+
+			var createDeployment *structs.Deployment
+			if !tc.deployment {
+				createDeployment = structs.NewDeployment(job, 50)
+				createDeployment.TaskGroups[job.TaskGroups[0].Name] = &structs.DeploymentState{
+					DesiredTotal: tc.count,
+				}
+			}
+			var deploymentUpdates []*structs.DeploymentStatusUpdate
+			if tc.complete {
+				deploymentUpdates = []*structs.DeploymentStatusUpdate{
+					{
+						DeploymentID:      d.ID,
+						Status:            structs.DeploymentStatusSuccessful,
+						StatusDescription: structs.DeploymentStatusDescriptionSuccessful,
+					},
+				}
+			}
+
+			assertResults(t, r, &resultExpectation{
+				createDeployment:  createDeployment,
+				deploymentUpdates: deploymentUpdates,
+				place:             tc.place,
+				stop:              tc.stop,
+				desiredTGUpdates: map[string]*structs.DesiredUpdates{
+					job.TaskGroups[0].Name: {
+						Stop:              uint64(tc.stop),
+						DestructiveUpdate: uint64(tc.destructive),
+						Ignore:            uint64(tc.ignore),
+					},
+				},
+			})
+
+			// Only the allocations of the old job are stopped
+			for _, stop := range r.stop {
+				require.Equal(t, oldJob.Version, stop.alloc.Job.Version)
+			}
+			for _, place := range r.place {
+				require.Nil(t, place.PreviousAllocation())
+			}
+		})
+	}
+}
+
 // Tests the reconciler handles canary promotion when the canary count equals
 // the total correctly
 func TestReconciler_PromoteCanaries_CanariesEqualCount(t *testing.T) {
@@ -43,6 +43,13 @@ type SystemScheduler struct {
 	notReadyNodes map[string]struct{}
 	nodesByDC     map[string]int
 
+	// deployment is the deployment of the job version, if any of its task
+	// groups is rolled out by one.
+	deployment *structs.Deployment
+
+	// canaries is the set of allocations replaced by canaries.
+	canaries map[string]struct{}
+
 	limitReached bool
 	nextEval     *structs.Evaluation
 
@@ -92,7 +99,7 @@ func (s *SystemScheduler) Process(eval *structs.Evaluation) (err error) {
 	if !s.canHandle(eval.TriggeredBy) {
 		desc := fmt.Sprintf("scheduler cannot handle '%s' evaluation reason", eval.TriggeredBy)
 		return setStatus(s.logger, s.planner, s.eval, s.nextEval, nil, s.failedTGAllocs, structs.EvalStatusFailed, desc,
-			s.queuedAllocs, "")
+			s.queuedAllocs, s.deployment.GetID())
 	}
 
 	limit := maxSystemScheduleAttempts
@@ -105,14 +112,14 @@ func (s *SystemScheduler) Process(eval *structs.Evaluation) (err error) {
 	if err := retryMax(limit, s.process, progress); err != nil {
 		if statusErr, ok := err.(*SetStatusError); ok {
 			return setStatus(s.logger, s.planner, s.eval, s.nextEval, nil, s.failedTGAllocs, statusErr.EvalStatus, err.Error(),
-				s.queuedAllocs, "")
+				s.queuedAllocs, s.deployment.GetID())
 		}
 		return err
 	}
 
 	// Update the status to complete
 	return setStatus(s.logger, s.planner, s.eval, s.nextEval, nil, s.failedTGAllocs, structs.EvalStatusComplete, "",
-		s.queuedAllocs, "")
+		s.queuedAllocs, s.deployment.GetID())
 }
 
 // process is wrapped in retryMax to iteratively run the handler until we have no
@@ -126,15 +133,25 @@ func (s *SystemScheduler) process() (bool, error) {
 		return false, fmt.Errorf("failed to get job '%s': %v", s.eval.JobID, err)
 	}
 
+	// Get any existing deployment
+	if !s.sysbatch {
+		s.deployment, err = s.state.LatestDeploymentByJobID(ws, s.eval.Namespace, s.eval.JobID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get job deployment %q: %v", s.eval.JobID, err)
+		}
+	}
+
 	numTaskGroups := 0
 	if !s.job.Stopped() {
 		numTaskGroups = len(s.job.TaskGroups)
 	}
 	s.queuedAllocs = make(map[string]int, numTaskGroups)
 
-	// Get the ready nodes in the required datacenters
+	// Get the ready nodes in the required datacenters and node pool. Nodes
+	// outside of the job node pool are filtered out before any other check,
+	// so existing allocations on them are stopped.
 	if !s.job.Stopped() {
-		s.nodes, s.notReadyNodes, s.nodesByDC, err = readyNodesInDCs(s.state, s.job.Datacenters)
+		s.nodes, s.notReadyNodes, s.nodesByDC, err = readyNodesInDCsAndPool(s.state, s.job.Datacenters, s.job.NodePool)
 		if err != nil {
 			return false, fmt.Errorf("failed to get ready nodes: %v", err)
 		}
@@ -148,6 +165,7 @@ func (s *SystemScheduler) process() (bool, error) {
 
 	// Create an evaluation context
 	s.ctx = NewEvalContext(s.eventsCh, s.state, s.plan, s.logger)
+	s.ctx.SetExplain(s.eval.ExplainPlan)
 
 	// Construct the placement stack
 	s.stack = NewSystemStack(s.sysbatch, s.ctx)
@@ -266,18 +284,26 @@ func (s *SystemScheduler) computeJobAllocs() error {
 
 	if s.eval.AnnotatePlan {
 		s.plan.Annotations = &structs.PlanAnnotations{
-			DesiredTGUpdates: desiredUpdates(diff, inplaceUpdates, destructiveUpdates),
+			DesiredTGUpdates:    desiredUpdates(diff, inplaceUpdates, destructiveUpdates),
+			SchedulerAlgorithms: schedulerAlgorithms(s.state, s.job),
 		}
 	}
 
+	// Task groups rolled out by a deployment are updated separately.
+	deployed, rolling := s.splitDeployedUpdates(diff.update)
+
 	// Check if a rolling upgrade strategy is being used
-	limit := len(diff.update)
+	limit := len(rolling)
 	if !s.job.Stopped() && s.job.Update.Rolling() {
 		limit = s.job.Update.MaxParallel
 	}
 
 	// Treat non in-place updates as an eviction and new placement.
-	s.limitReached = evictAndPlace(s.ctx, diff, diff.update, allocUpdating, &limit)
+	s.limitReached = evictAndPlace(s.ctx, diff, rolling, allocUpdating, &limit)
+
+	// Replace the allocations of the task groups rolled out by a deployment
+	// within the limits of their canaries and of the deployment health.
+	s.computeDeployment(diff, deployed, live)
 
 	// Nothing remaining to do if placement is not required
 	if len(diff.place) == 0 {
@@ -286,6 +312,7 @@ func (s *SystemScheduler) computeJobAllocs() error {
 				s.queuedAllocs[tg.Name] = 0
 			}
 		}
+		s.completeDeployment(deployed)
 		return nil
 	}
 
@@ -295,7 +322,12 @@ func (s *SystemScheduler) computeJobAllocs() error {
 	}
 
 	// Compute the placements
-	return s.computePlacements(diff.place)
+	if err := s.computePlacements(diff.place); err != nil {
+		return err
+	}
+
+	s.completeDeployment(deployed)
+	return nil
 }
 
 func mergeNodeFiltered(acc, curr *structs.AllocMetric) *structs.AllocMetric {
@@ -319,6 +351,7 @@ func mergeNodeFiltered(acc, curr *structs.AllocMetric) *structs.AllocMetric {
 		acc.ConstraintFiltered[k] += v
 	}
 	acc.AllocationTime += curr.AllocationTime
+	acc.MergeNodeExplanations(curr)
 	return acc
 }
 
@@ -388,6 +421,7 @@ func (s *SystemScheduler) computePlacements(place []allocTuple) error {
 			if metric, ok := s.failedTGAllocs[tgName]; ok {
 				metric.CoalescedFailures += 1
 				metric.ExhaustResources(missing.TaskGroup)
+				metric.MergeNodeExplanations(s.ctx.Metrics())
 				continue
 			}
 
@@ -432,6 +466,15 @@ func (s *SystemScheduler) computePlacements(place []allocTuple) error {
 			resources.Shared.Ports = option.AllocResources.Ports
 		}
 
+		// Allocations of task groups rolled out by a deployment are part
+		// of it
+		var deploymentID string
+		if s.deployment != nil && s.deployment.Active() {
+			if _, ok := s.deployment.TaskGroups[tgName]; ok {
+				deploymentID = s.deployment.ID
+			}
+		}
+
 		// Create an allocation for this
 		alloc := &structs.Allocation{
 			ID:                 uuid.Generate(),
@@ -443,6 +486,7 @@ func (s *SystemScheduler) computePlacements(place []allocTuple) error {
 			Metrics:            s.ctx.Metrics(),
 			NodeID:             option.Node.ID,
 			NodeName:           option.Node.Name,
+			DeploymentID:       deploymentID,
 			TaskResources:      resources.OldTaskResources(),
 			AllocatedResources: resources,
 			DesiredStatus:      structs.AllocDesiredStatusRun,
@@ -459,6 +503,14 @@ func (s *SystemScheduler) computePlacements(place []allocTuple) error {
 		// older allocation id so that they are chained
 		if missing.Alloc != nil {
 			alloc.PreviousAllocation = missing.Alloc.ID
+
+			// Mark the allocations replacing older ones during the canary
+			// phase of the deployment as canaries.
+			if _, ok := s.canaries[missing.Alloc.ID]; ok && deploymentID != "" {
+				alloc.DeploymentStatus = &structs.AllocDeploymentStatus{
+					Canary: true,
+				}
+			}
 		}
 
 		// If this placement involves preemption, set DesiredState to evict for those allocations
@@ -78,6 +78,47 @@ func TestSystemSched_JobRegister(t *testing.T) {
 	h.AssertEvalStatus(t, structs.EvalStatusComplete)
 }
 
+func TestSystemSched_JobRegister_NodePool(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+
+	// Create some nodes in two different node pools.
+	nodes := createNodes(t, h, 4)
+	for _, node := range nodes[:2] {
+		node.NodePool = "dev"
+		require.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), node))
+	}
+
+	// Create a job that only targets the dev node pool.
+	job := mock.SystemJob()
+	job.NodePool = "dev"
+	require.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), job))
+
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    job.Priority,
+		TriggeredBy: structs.EvalTriggerJobRegister,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	require.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+
+	// Process the evaluation
+	require.NoError(t, h.Process(NewSystemScheduler, eval))
+	require.Len(t, h.Plans, 1)
+
+	// Ensure allocations were only placed in the dev node pool.
+	plan := h.Plans[0]
+	require.Len(t, plan.NodeAllocation, 2)
+	for nodeID := range plan.NodeAllocation {
+		require.True(t, nodeID == nodes[0].ID || nodeID == nodes[1].ID)
+	}
+
+	h.AssertEvalStatus(t, structs.EvalStatusComplete)
+}
+
 func TestSystemSched_JobRegister_StickyAllocs(t *testing.T) {
 	ci.Parallel(t)
 
@@ -3074,3 +3115,261 @@ func TestSystemSched_CSITopology(t *testing.T) {
 	must.Eq(t, structs.EvalStatusComplete, h.Evals[0].Status)
 
 }
+
+// systemDeploymentTestJob upserts a system job with allocations on the nodes
+// and a destructive update of it using the given update strategy.
+func systemDeploymentTestJob(t *testing.T, h *Harness, nodes []*structs.Node, update *structs.UpdateStrategy) *structs.Job {
+	job := mock.SystemJob()
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), job))
+
+	var allocs []*structs.Allocation
+	for _, node := range nodes {
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = node.ID
+		alloc.Name = "my-job.web[0]"
+		allocs = append(allocs, alloc)
+	}
+	must.NoError(t, h.State.UpsertAllocs(structs.MsgTypeTestSetup, h.NextIndex(), allocs))
+
+	job2 := job.Copy()
+	job2.TaskGroups[0].Update = update
+	job2.TaskGroups[0].Tasks[0].Config["command"] = "/bin/other"
+	must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), job2))
+
+	out, err := h.State.JobByID(nil, job.Namespace, job.ID)
+	must.NoError(t, err)
+	return out
+}
+
+func systemDeploymentTestEval(t *testing.T, h *Harness, job *structs.Job, trigger string) *structs.Evaluation {
+	eval := &structs.Evaluation{
+		Namespace:   structs.DefaultNamespace,
+		ID:          uuid.Generate(),
+		Priority:    50,
+		TriggeredBy: trigger,
+		JobID:       job.ID,
+		Status:      structs.EvalStatusPending,
+	}
+	must.NoError(t, h.State.UpsertEvals(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Evaluation{eval}))
+	return eval
+}
+
+func TestSystemSched_JobModify_Canaries(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+	nodes := createNodes(t, h, 10)
+
+	update := structs.DefaultUpdateStrategy.Copy()
+	update.MaxParallel = 3
+	update.CanaryPercent = 20
+	job := systemDeploymentTestJob(t, h, nodes, update)
+
+	eval := systemDeploymentTestEval(t, h, job, structs.EvalTriggerJobRegister)
+	must.NoError(t, h.Process(NewSystemScheduler, eval))
+	must.Len(t, 1, h.Plans)
+	plan := h.Plans[0]
+
+	// A deployment is created with 20% of the nodes as canaries
+	must.NotNil(t, plan.Deployment)
+	dstate := plan.Deployment.TaskGroups["web"]
+	must.NotNil(t, dstate)
+	must.Eq(t, 2, dstate.DesiredCanaries)
+	must.Eq(t, 10, dstate.DesiredTotal)
+	must.Eq(t, structs.DeploymentStatusDescriptionRunningNeedsPromotion, plan.Deployment.StatusDescription)
+
+	// Only the canaries replace allocations
+	var stopped, placed []*structs.Allocation
+	for _, allocs := range plan.NodeUpdate {
+		stopped = append(stopped, allocs...)
+	}
+	for _, allocs := range plan.NodeAllocation {
+		placed = append(placed, allocs...)
+	}
+	must.Len(t, 2, stopped)
+	must.Len(t, 2, placed)
+	for _, alloc := range placed {
+		must.Eq(t, plan.Deployment.ID, alloc.DeploymentID)
+		must.True(t, alloc.DeploymentStatus.IsCanary())
+	}
+
+	// The deployment drives the rollout instead of the stagger
+	must.Len(t, 0, h.CreateEvals)
+	must.Eq(t, plan.Deployment.ID, h.Evals[0].DeploymentID)
+
+	// Nothing else is replaced until the canaries are promoted
+	eval = systemDeploymentTestEval(t, h, job, structs.EvalTriggerDeploymentWatcher)
+	must.NoError(t, h.Process(NewSystemScheduler, eval))
+	must.Len(t, 1, h.Plans)
+
+	// Promote the healthy canaries
+	for _, alloc := range placed {
+		alloc = alloc.Copy()
+		alloc.DeploymentStatus.Healthy = pointer.Of(true)
+		must.NoError(t, h.State.UpsertAllocs(structs.MsgTypeTestSetup, h.NextIndex(), []*structs.Allocation{alloc}))
+	}
+	must.NoError(t, h.State.UpdateDeploymentPromotion(structs.MsgTypeTestSetup, h.NextIndex(),
+		&structs.ApplyDeploymentPromoteRequest{
+			DeploymentPromoteRequest: structs.DeploymentPromoteRequest{
+				DeploymentID: plan.Deployment.ID,
+				All:          true,
+			},
+		}))
+
+	// The remaining allocations are replaced max_parallel at a time
+	eval = systemDeploymentTestEval(t, h, job, structs.EvalTriggerDeploymentWatcher)
+	must.NoError(t, h.Process(NewSystemScheduler, eval))
+	must.Len(t, 2, h.Plans)
+	plan = h.Plans[1]
+	must.Nil(t, plan.Deployment)
+
+	placed = nil
+	for _, allocs := range plan.NodeAllocation {
+		placed = append(placed, allocs...)
+	}
+	must.Len(t, 3, placed)
+	for _, alloc := range placed {
+		must.Eq(t, h.Plans[0].Deployment.ID, alloc.DeploymentID)
+		must.False(t, alloc.DeploymentStatus.IsCanary())
+	}
+
+	// Nothing else is replaced until those allocations are healthy
+	eval = systemDeploymentTestEval(t, h, job, structs.EvalTriggerDeploymentWatcher)
+	must.NoError(t, h.Process(NewSystemScheduler, eval))
+	must.Len(t, 2, h.Plans)
+}
+
+func TestSystemSched_JobModify_CanaryPercent_EligibleNodes(t *testing.T) {
+	ci.Parallel(t)
+
+	h := NewHarness(t)
+	nodes := createNodes(t, h, 4)
+
+	update := structs.DefaultUpdateStrategy.Copy()
+	update.CanaryPercent = 20
+	job := systemDeploymentTestJob(t, h, nodes, update)
+
+	// New nodes are eligible for the task group, but a node failing the
+	// job constraint isn't
+	createNodes(t, h, 5)
+	ineligible := mock.Node()
+	ineligible.Attributes["kernel.name"] = "darwin"
+	must.NoError(t, h.State.UpsertNode(structs.MsgTypeTestSetup, h.NextIndex(), ineligible))
+
+	eval := systemDeploymentTestEval(t, h, job, structs.EvalTriggerJobRegister)
+	must.NoError(t, h.Process(NewSystemScheduler, eval))
+	must.Len(t, 1, h.Plans)
+	plan := h.Plans[0]
+
+	// The canaries are 20% of the 9 eligible nodes rather than of the 4
+	// allocations being updated
+	must.NotNil(t, plan.Deployment)
+	dstate := plan.Deployment.TaskGroups["web"]
+	must.NotNil(t, dstate)
+	must.Eq(t, 2, dstate.DesiredCanaries)
+}
+
+func TestSystemSched_Deployment_Status(t *testing.T) {
+	ci.Parallel(t)
+
+	cases := []struct {
+		name    string
+		stop    bool
+		version bool
+		healthy int
+		status  string
+		desc    string
+	}{
+		{
+			name:    "successful",
+			healthy: 4,
+			status:  structs.DeploymentStatusSuccessful,
+			desc:    structs.DeploymentStatusDescriptionSuccessful,
+		},
+		{
+			name:    "running",
+			healthy: 3,
+		},
+		{
+			name:    "newer job",
+			version: true,
+			healthy: 4,
+			status:  structs.DeploymentStatusCancelled,
+			desc:    structs.DeploymentStatusDescriptionNewerJob,
+		},
+		{
+			name:    "stopped job",
+			stop:    true,
+			healthy: 4,
+			status:  structs.DeploymentStatusCancelled,
+			desc:    structs.DeploymentStatusDescriptionStoppedJob,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewHarness(t)
+			nodes := createNodes(t, h, 4)
+
+			job := mock.SystemJob()
+			job.TaskGroups[0].Update = structs.DefaultUpdateStrategy.Copy()
+			job.TaskGroups[0].Update.AutoRevert = true
+			must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), job))
+
+			d := mock.Deployment()
+			d.JobID = job.ID
+			d.JobVersion = job.Version
+			d.JobCreateIndex = job.CreateIndex
+			d.TaskGroups = map[string]*structs.DeploymentState{
+				"web": {
+					AutoRevert:   true,
+					DesiredTotal: 4,
+				},
+			}
+			must.NoError(t, h.State.UpsertDeployment(h.NextIndex(), d))
+
+			var allocs []*structs.Allocation
+			for i, node := range nodes {
+				alloc := mock.Alloc()
+				alloc.Job = job
+				alloc.JobID = job.ID
+				alloc.NodeID = node.ID
+				alloc.Name = "my-job.web[0]"
+				alloc.DeploymentID = d.ID
+				alloc.DeploymentStatus = &structs.AllocDeploymentStatus{
+					Healthy: pointer.Of(i < tc.healthy),
+				}
+				allocs = append(allocs, alloc)
+			}
+			must.NoError(t, h.State.UpsertAllocs(structs.MsgTypeTestSetup, h.NextIndex(), allocs))
+
+			d = d.Copy()
+			d.TaskGroups["web"].HealthyAllocs = tc.healthy
+			must.NoError(t, h.State.UpsertDeployment(h.NextIndex(), d))
+
+			if tc.stop || tc.version {
+				job2 := job.Copy()
+				job2.Stop = tc.stop
+				job2.Meta = map[string]string{"version": "2"}
+				must.NoError(t, h.State.UpsertJob(structs.MsgTypeTestSetup, h.NextIndex(), job2))
+			}
+
+			eval := systemDeploymentTestEval(t, h, job, structs.EvalTriggerDeploymentWatcher)
+			must.NoError(t, h.Process(NewSystemScheduler, eval))
+
+			if tc.status == "" {
+				must.Len(t, 0, h.Plans)
+				return
+			}
+
+			must.Len(t, 1, h.Plans)
+			updates := h.Plans[0].DeploymentUpdates
+			must.Len(t, 1, updates)
+			must.Eq(t, d.ID, updates[0].DeploymentID)
+			must.Eq(t, tc.status, updates[0].Status)
+			must.Eq(t, tc.desc, updates[0].StatusDescription)
+		})
+	}
+}
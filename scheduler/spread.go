@@ -1,6 +1,8 @@
 package scheduler
 
 import (
+	"math"
+
 	"github.com/hashicorp/nomad/nomad/structs"
 )
 
@@ -38,6 +40,15 @@ type SpreadIterator struct {
 	// existing allocs are computed once, and allocs from the plan are updated
 	// when Reset is called
 	groupPropertySets map[string][]*propertySet
+
+	// feasibleNodes is a memoized map from task group to the nodes that pass
+	// its feasibility checks. It is used to find the distinct attribute values
+	// for topology spreads.
+	feasibleNodes map[string][]*structs.Node
+
+	// attributeValues is a memoized map from task group to the distinct
+	// values of each spread attribute across its feasible nodes
+	attributeValues map[string]map[string]map[string]struct{}
 }
 
 type spreadAttributeMap map[string]*spreadInfo
@@ -45,6 +56,11 @@ type spreadAttributeMap map[string]*spreadInfo
 type spreadInfo struct {
 	weight        int8
 	desiredCounts map[string]float64
+
+	// maxSkew and required are set for topology spreads
+	maxSkew  int
+	required bool
+	str      string
 }
 
 func NewSpreadIterator(ctx Context, source RankIterator) *SpreadIterator {
@@ -53,10 +69,42 @@ func NewSpreadIterator(ctx Context, source RankIterator) *SpreadIterator {
 		source:            source,
 		groupPropertySets: make(map[string][]*propertySet),
 		tgSpreadInfo:      make(map[string]spreadAttributeMap),
+		feasibleNodes:     make(map[string][]*structs.Node),
+		attributeValues:   make(map[string]map[string]map[string]struct{}),
 	}
 	return iter
 }
 
+// SetFeasibleNodes sets the nodes that pass the feasibility checks of the
+// current task group. Only these nodes count as topology domains, so that a
+// value found only on nodes the task group can't be placed on doesn't block
+// placements.
+func (iter *SpreadIterator) SetFeasibleNodes(nodes []*structs.Node) {
+	iter.feasibleNodes[iter.tg.Name] = nodes
+	delete(iter.attributeValues, iter.tg.Name)
+}
+
+// needsFeasibleNodes returns whether the current task group has topology
+// spreads but its feasible nodes haven't been set yet.
+func (iter *SpreadIterator) needsFeasibleNodes() bool {
+	if _, ok := iter.feasibleNodes[iter.tg.Name]; ok {
+		return false
+	}
+	for _, info := range iter.tgSpreadInfo[iter.tg.Name] {
+		if info.maxSkew > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// resetFeasibleNodes clears the feasible nodes of every task group, when the
+// base nodes or the job they were computed for change.
+func (iter *SpreadIterator) resetFeasibleNodes() {
+	iter.feasibleNodes = make(map[string][]*structs.Node)
+	iter.attributeValues = make(map[string]map[string]map[string]struct{})
+}
+
 func (iter *SpreadIterator) Reset() {
 	iter.source.Reset()
 	for _, sets := range iter.groupPropertySets {
@@ -77,6 +125,7 @@ func (iter *SpreadIterator) SetJob(job *structs.Job) {
 	// versions of spread/properties to the new job version
 	iter.tgSpreadInfo = make(map[string]spreadAttributeMap)
 	iter.groupPropertySets = make(map[string][]*propertySet)
+	iter.resetFeasibleNodes()
 }
 
 func (iter *SpreadIterator) SetTaskGroup(tg *structs.TaskGroup) {
@@ -114,6 +163,7 @@ func (iter *SpreadIterator) hasSpreads() bool {
 }
 
 func (iter *SpreadIterator) Next() *RankedNode {
+OUTER:
 	for {
 		option := iter.source.Next()
 
@@ -129,16 +179,21 @@ func (iter *SpreadIterator) Next() *RankedNode {
 		for _, pset := range propertySets {
 			nValue, errorMsg, usedCount := pset.UsedCount(option.Node, tgName)
 
+			spreadAttributeMap := iter.tgSpreadInfo[tgName]
+			spreadDetails := spreadAttributeMap[pset.targetAttribute]
+
 			// Add one to include placement on this node in the scoring calculation
 			usedCount += 1
 			// Set score to -1 if there were errors in building this attribute
 			if errorMsg != "" {
 				iter.ctx.Logger().Named("spread").Debug("error building spread attributes for task group", "task_group", tgName, "error", errorMsg)
+				if spreadDetails != nil && spreadDetails.required {
+					iter.ctx.Metrics().FilterNode(option.Node, spreadDetails.str)
+					continue OUTER
+				}
 				totalSpreadScore -= 1.0
 				continue
 			}
-			spreadAttributeMap := iter.tgSpreadInfo[tgName]
-			spreadDetails := spreadAttributeMap[pset.targetAttribute]
 
 			if spreadDetails == nil {
 				iter.ctx.Logger().Named("spread").Error(
@@ -149,7 +204,17 @@ func (iter *SpreadIterator) Next() *RankedNode {
 				continue
 			}
 
-			if len(spreadDetails.desiredCounts) == 0 {
+			if spreadDetails.maxSkew > 0 {
+				// Topology spread, computed from the skew the placement
+				// would cause across the distinct attribute values
+				skew := topologySpreadSkew(pset, iter.distinctValues(tgName, pset.targetAttribute), nValue)
+				if spreadDetails.required && skew > spreadDetails.maxSkew {
+					iter.ctx.Metrics().FilterNode(option.Node, spreadDetails.str)
+					continue OUTER
+				}
+				spreadWeight := float64(spreadDetails.weight) / float64(iter.sumSpreadWeights)
+				totalSpreadScore += topologySpreadScoreBoost(skew, spreadDetails.maxSkew) * spreadWeight
+			} else if len(spreadDetails.desiredCounts) == 0 {
 				// When desired counts map is empty the user didn't specify any targets
 				// Use even spreading scoring algorithm for this scenario
 				scoreBoost := evenSpreadScoreBoost(pset, option.Node)
@@ -242,6 +307,65 @@ func evenSpreadScoreBoost(pset *propertySet, option *structs.Node) float64 {
 
 }
 
+// distinctValues returns the distinct values of the attribute across the nodes
+// the task group may be placed on.
+func (iter *SpreadIterator) distinctValues(tgName, attribute string) map[string]struct{} {
+	tgValues, ok := iter.attributeValues[tgName]
+	if !ok {
+		tgValues = make(map[string]map[string]struct{})
+		iter.attributeValues[tgName] = tgValues
+	}
+	if values, ok := tgValues[attribute]; ok {
+		return values
+	}
+
+	values := make(map[string]struct{})
+	for _, node := range iter.feasibleNodes[tgName] {
+		if nValue, ok := getProperty(node, attribute); ok {
+			values[nValue] = struct{}{}
+		}
+	}
+	tgValues[attribute] = values
+	return values
+}
+
+// topologySpreadSkew returns the skew across the attribute values if an
+// allocation was placed on a node with the attribute value nValue. The skew is
+// the difference between the allocation count of nValue, including the
+// placement, and the lowest allocation count across all values.
+func topologySpreadSkew(pset *propertySet, values map[string]struct{}, nValue string) int {
+	combinedUseMap := pset.GetCombinedUseMap()
+	usedCount := combinedUseMap[nValue] + 1
+
+	// Values without any allocation yet are not in the use map, so start
+	// from the values known from the nodes
+	minCount := usedCount
+	for value := range values {
+		if count := combinedUseMap[value]; count < minCount {
+			minCount = count
+		}
+	}
+	if len(values) == 0 {
+		for _, count := range combinedUseMap {
+			if count < minCount {
+				minCount = count
+			}
+		}
+	}
+	return int(usedCount - minCount)
+}
+
+// topologySpreadScoreBoost is a scoring helper that calculates the score for
+// a placement causing the given skew. The boost is 1 for placements on the
+// least used values, decreases as the skew gets closer to maxSkew and is
+// negative when the skew exceeds maxSkew.
+func topologySpreadScoreBoost(skew, maxSkew int) float64 {
+	if skew <= maxSkew {
+		return 1.0 - float64(skew-1)/float64(maxSkew)
+	}
+	return -math.Min(1.0, float64(skew-maxSkew)/float64(maxSkew))
+}
+
 // computeSpreadInfo computes and stores percentages and total values
 // from all spreads that apply to a specific task group
 func (iter *SpreadIterator) computeSpreadInfo(tg *structs.TaskGroup) {
@@ -253,7 +377,13 @@ func (iter *SpreadIterator) computeSpreadInfo(tg *structs.TaskGroup) {
 	combinedSpreads = append(combinedSpreads, tg.Spreads...)
 	combinedSpreads = append(combinedSpreads, iter.jobSpreads...)
 	for _, spread := range combinedSpreads {
-		si := &spreadInfo{weight: spread.Weight, desiredCounts: make(map[string]float64)}
+		si := &spreadInfo{
+			weight:        spread.Weight,
+			desiredCounts: make(map[string]float64),
+			maxSkew:       spread.MaxSkew,
+			required:      spread.Required,
+			str:           "spread " + spread.String(),
+		}
 		sumDesiredCounts := 0.0
 		for _, st := range spread.SpreadTarget {
 			desiredCount := (float64(st.Percent) / float64(100)) * float64(totalCount)
@@ -558,6 +558,173 @@ func TestSpreadIterator_MaxPenalty(t *testing.T) {
 
 }
 
+func TestSpreadIterator_MaxSkew(t *testing.T) {
+	ci.Parallel(t)
+
+	state, ctx := testContext(t)
+	racks := []string{"r1", "r1", "r2", "r2", "r3"}
+	var nodes []*RankedNode
+	var baseNodes []*structs.Node
+
+	// Add these nodes to the state store
+	for i, rack := range racks {
+		node := mock.Node()
+		node.Meta["rack"] = rack
+		require.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, uint64(100+i), node))
+		nodes = append(nodes, &RankedNode{Node: node})
+		baseNodes = append(baseNodes, node)
+	}
+
+	job := mock.Job()
+	tg := job.TaskGroups[0]
+	tg.Count = 6
+	tg.Spreads = []*structs.Spread{
+		{
+			Weight:    100,
+			Attribute: "${meta.rack}",
+			MaxSkew:   1,
+		},
+	}
+
+	// Place an alloc on each node in r1 and on one node in r2, so another
+	// placement would cause a skew of 3 in r1 and of 2 in r2 against r3
+	for _, i := range []int{0, 1, 2} {
+		ctx.plan.NodeAllocation[nodes[i].Node.ID] = []*structs.Allocation{
+			{
+				Namespace: structs.DefaultNamespace,
+				TaskGroup: tg.Name,
+				JobID:     job.ID,
+				Job:       job,
+				ID:        uuid.Generate(),
+				NodeID:    nodes[i].Node.ID,
+			},
+		}
+	}
+
+	collect := func() map[string]float64 {
+		for _, node := range nodes {
+			node.Scores = nil
+			node.FinalScore = 0
+		}
+		static := NewStaticRankIterator(ctx, nodes)
+		spreadIter := NewSpreadIterator(ctx, static)
+		spreadIter.SetJob(job)
+		spreadIter.SetTaskGroup(tg)
+		spreadIter.SetFeasibleNodes(baseNodes)
+		scoreNorm := NewScoreNormalizationIterator(ctx, spreadIter)
+
+		scores := make(map[string]float64)
+		for _, rn := range collectRanked(scoreNorm) {
+			scores[rn.Node.ID] = rn.FinalScore
+		}
+		return scores
+	}
+
+	// Soft spread penalizes the racks that would exceed the max skew and
+	// boosts the least used rack
+	scores := collect()
+	require.Len(t, scores, 5)
+	require.Equal(t, -1.0, scores[nodes[0].Node.ID])
+	require.Equal(t, -1.0, scores[nodes[1].Node.ID])
+	require.Equal(t, -1.0, scores[nodes[2].Node.ID])
+	require.Equal(t, -1.0, scores[nodes[3].Node.ID])
+	require.Equal(t, 1.0, scores[nodes[4].Node.ID])
+
+	// Hard spread filters out every rack but the least used one
+	tg.Spreads[0].Required = true
+	scores = collect()
+	require.Len(t, scores, 1)
+	require.Equal(t, 1.0, scores[nodes[4].Node.ID])
+
+	// Once r3 is used, r2 and r3 are within the max skew again
+	ctx.plan.NodeAllocation[nodes[4].Node.ID] = []*structs.Allocation{
+		{
+			Namespace: structs.DefaultNamespace,
+			TaskGroup: tg.Name,
+			JobID:     job.ID,
+			Job:       job,
+			ID:        uuid.Generate(),
+			NodeID:    nodes[4].Node.ID,
+		},
+	}
+	scores = collect()
+	require.Len(t, scores, 3)
+	require.Equal(t, 1.0, scores[nodes[2].Node.ID])
+	require.Equal(t, 1.0, scores[nodes[3].Node.ID])
+	require.Equal(t, 1.0, scores[nodes[4].Node.ID])
+}
+
+// TestSpreadIterator_MaxSkew_FeasibleNodes asserts that the values of nodes
+// the task group can't be placed on don't count towards the skew.
+func TestSpreadIterator_MaxSkew_FeasibleNodes(t *testing.T) {
+	ci.Parallel(t)
+
+	state, ctx := testContext(t)
+	var nodes []*structs.Node
+	for _, rack := range []string{"r1", "r1", "r2", "r2"} {
+		node := mock.Node()
+		node.Meta["rack"] = rack
+		require.NoError(t, node.ComputeClass())
+		nodes = append(nodes, node)
+	}
+
+	// r3 is only on a node that doesn't meet the job constraints and r4 only
+	// on a node of another node pool
+	infeasible := mock.Node()
+	infeasible.Meta["rack"] = "r3"
+	infeasible.Attributes["kernel.name"] = "freebsd"
+	require.NoError(t, infeasible.ComputeClass())
+	otherPool := mock.Node()
+	otherPool.Meta["rack"] = "r4"
+	otherPool.NodePool = "other"
+	require.NoError(t, otherPool.ComputeClass())
+	nodes = append(nodes, infeasible, otherPool)
+	for i, node := range nodes {
+		require.NoError(t, state.UpsertNode(structs.MsgTypeTestSetup, uint64(100+i), node))
+	}
+
+	job := mock.Job()
+	tg := job.TaskGroups[0]
+	tg.Count = 4
+	tg.Spreads = []*structs.Spread{
+		{
+			Weight:    100,
+			Attribute: "${meta.rack}",
+			MaxSkew:   1,
+			Required:  true,
+		},
+	}
+
+	stack := NewGenericStack(false, ctx)
+	stack.SetNodes(nodes)
+	stack.SetJob(job)
+
+	racks := make(map[string]int)
+	for i := 0; i < tg.Count; i++ {
+		option := stack.Select(tg, &SelectOptions{})
+		require.NotNil(t, option, "placement %d failed: %#v", i, ctx.Metrics())
+		racks[option.Node.Meta["rack"]]++
+
+		alloc := mock.Alloc()
+		alloc.Job = job
+		alloc.JobID = job.ID
+		alloc.NodeID = option.Node.ID
+		ctx.plan.NodeAllocation[option.Node.ID] = append(ctx.plan.NodeAllocation[option.Node.ID], alloc)
+	}
+	require.Equal(t, map[string]int{"r1": 2, "r2": 2}, racks)
+}
+
+func Test_topologySpreadScoreBoost(t *testing.T) {
+	ci.Parallel(t)
+
+	require.Equal(t, 1.0, topologySpreadScoreBoost(1, 1))
+	require.Equal(t, -1.0, topologySpreadScoreBoost(2, 1))
+	require.Equal(t, 1.0, topologySpreadScoreBoost(1, 2))
+	require.Equal(t, 0.5, topologySpreadScoreBoost(2, 2))
+	require.Equal(t, -0.5, topologySpreadScoreBoost(3, 2))
+	require.Equal(t, -1.0, topologySpreadScoreBoost(10, 2))
+}
+
 func Test_evenSpreadScoreBoost(t *testing.T) {
 	ci.Parallel(t)
 
@@ -41,13 +41,16 @@ type SelectOptions struct {
 // GenericStack is the Stack used for the Generic scheduler. It is
 // designed to make better placement decisions at the cost of performance.
 type GenericStack struct {
-	batch  bool
-	ctx    Context
-	source *StaticIterator
+	batch   bool
+	explain bool
+	ctx     Context
+	nodes   []*structs.Node
+	source  *StaticIterator
 
 	wrappedChecks        *FeasibilityWrapper
 	quota                FeasibleIterator
 	jobVersion           *uint64
+	jobNodePool          *NodePoolChecker
 	jobConstraint        *ConstraintChecker
 	taskGroupDrivers     *DriverChecker
 	taskGroupConstraint  *ConstraintChecker
@@ -64,6 +67,7 @@ type GenericStack struct {
 	limit                      *LimitIterator
 	maxScore                   *MaxScoreIterator
 	nodeAffinity               *NodeAffinityIterator
+	allocAffinity              *AllocAffinityIterator
 	spread                     *SpreadIterator
 	scoreNorm                  *ScoreNormalizationIterator
 }
@@ -74,7 +78,9 @@ func (s *GenericStack) SetNodes(baseNodes []*structs.Node) {
 	shuffleNodes(s.ctx.Plan(), idx, baseNodes)
 
 	// Update the set of base nodes
+	s.nodes = baseNodes
 	s.source.SetNodes(baseNodes)
+	s.spread.resetFeasibleNodes()
 
 	// Apply a limit function. This is to avoid scanning *every* possible node.
 	// For batch jobs we only need to evaluate 2 options and depend on the
@@ -91,6 +97,12 @@ func (s *GenericStack) SetNodes(baseNodes []*structs.Node) {
 	s.limit.SetLimit(limit)
 }
 
+// SetExplain toggles visiting every node on each placement, so that all of
+// them are explained in the metrics.
+func (s *GenericStack) SetExplain(explain bool) {
+	s.explain = explain
+}
+
 func (s *GenericStack) SetJob(job *structs.Job) {
 	if s.jobVersion != nil && *s.jobVersion == job.Version {
 		return
@@ -99,12 +111,14 @@ func (s *GenericStack) SetJob(job *structs.Job) {
 	jobVer := job.Version
 	s.jobVersion = &jobVer
 
+	s.jobNodePool.SetNodePool(job.NodePool)
 	s.jobConstraint.SetConstraints(job.Constraints)
 	s.distinctHostsConstraint.SetJob(job)
 	s.distinctPropertyConstraint.SetJob(job)
 	s.binPack.SetJob(job)
 	s.jobAntiAff.SetJob(job)
 	s.nodeAffinity.SetJob(job)
+	s.allocAffinity.SetJob(job)
 	s.spread.SetJob(job)
 	s.ctx.Eligibility().SetJob(job)
 	s.taskGroupCSIVolumes.SetNamespace(job.Namespace)
@@ -132,10 +146,8 @@ func (s *GenericStack) Select(tg *structs.TaskGroup, options *SelectOptions) *Ra
 		return s.Select(tg, &optionsNew)
 	}
 
-	// Reset the max selector and context
+	// Reset the max selector
 	s.maxScore.Reset()
-	s.ctx.Reset()
-	start := time.Now()
 
 	// Get the task groups constraints.
 	tgConstr := taskGroupConstraints(tg)
@@ -161,9 +173,21 @@ func (s *GenericStack) Select(tg *structs.TaskGroup, options *SelectOptions) *Ra
 		s.nodeReschedulingPenalty.SetPenaltyNodes(options.PenaltyNodeIDs)
 	}
 	s.nodeAffinity.SetTaskGroup(tg)
+	s.allocAffinity.SetTaskGroup(tg)
 	s.spread.SetTaskGroup(tg)
 
-	if s.nodeAffinity.hasAffinities() || s.spread.hasSpreads() {
+	// Topology spreads only count the values of nodes the task group may be
+	// placed on. The feasibility checks record filtered nodes, so this must
+	// happen before the context is reset.
+	if s.spread.needsFeasibleNodes() {
+		s.spread.SetFeasibleNodes(s.feasibleNodes())
+	}
+
+	// Reset the context
+	s.ctx.Reset()
+	start := time.Now()
+
+	if s.nodeAffinity.hasAffinities() || s.allocAffinity.hasAffinities() || s.spread.hasSpreads() {
 		// scoring spread across all nodes has quadratic behavior, so
 		// we need to consider a subset of nodes to keep evaluaton times
 		// reasonable but enough to ensure spread is correct. this
@@ -174,6 +198,12 @@ func (s *GenericStack) Select(tg *structs.TaskGroup, options *SelectOptions) *Ra
 		}
 	}
 
+	// Explaining a placement requires visiting every node, not only the
+	// subset the limit iterator would otherwise return.
+	if s.explain {
+		s.limit.SetLimit(math.MaxInt32)
+	}
+
 	if contextual, ok := s.quota.(ContextualIterator); ok {
 		contextual.SetTaskGroup(tg)
 	}
@@ -186,6 +216,29 @@ func (s *GenericStack) Select(tg *structs.TaskGroup, options *SelectOptions) *Ra
 	return option
 }
 
+// feasibleNodes returns the base nodes that pass the feasibility checks of the
+// job and the current task group. Unlike the FeasibilityWrapper it doesn't
+// mark the computed classes as eligible or ineligible, so that the metrics
+// of the placement still record why nodes were filtered.
+func (s *GenericStack) feasibleNodes() []*structs.Node {
+	var checks []FeasibilityChecker
+	checks = append(checks, s.wrappedChecks.jobCheckers...)
+	checks = append(checks, s.wrappedChecks.tgCheckers...)
+	checks = append(checks, s.wrappedChecks.tgAvailable...)
+
+	var nodes []*structs.Node
+OUTER:
+	for _, node := range s.nodes {
+		for _, check := range checks {
+			if !check.Feasible(node) {
+				continue OUTER
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
 // SystemStack is the Stack used for the System scheduler. It is designed to
 // attempt to make placements on all nodes.
 type SystemStack struct {
@@ -355,6 +408,10 @@ func NewGenericStack(batch bool, ctx Context) *GenericStack {
 	// balancing across eligible nodes.
 	s.source = NewRandomIterator(ctx, nil)
 
+	// Filter on the job node pool first, since nodes outside of the pool
+	// must never be considered. The job is filled in later.
+	s.jobNodePool = NewNodePoolChecker(ctx, "")
+
 	// Attach the job constraints. The job is filled in later.
 	s.jobConstraint = NewConstraintChecker(ctx, nil)
 
@@ -380,7 +437,7 @@ func NewGenericStack(batch bool, ctx Context) *GenericStack {
 	// which feasibility checking can be skipped if the computed node class has
 	// previously been marked as eligible or ineligible. Generally this will be
 	// checks that only needs to examine the single node to determine feasibility.
-	jobs := []FeasibilityChecker{s.jobConstraint}
+	jobs := []FeasibilityChecker{s.jobNodePool, s.jobConstraint}
 	tgs := []FeasibilityChecker{
 		s.taskGroupDrivers,
 		s.taskGroupConstraint,
@@ -423,8 +480,11 @@ func NewGenericStack(batch bool, ctx Context) *GenericStack {
 	// Apply scores based on affinity stanza
 	s.nodeAffinity = NewNodeAffinityIterator(ctx, s.nodeReschedulingPenalty)
 
+	// Apply scores and filters based on alloc_affinity stanza
+	s.allocAffinity = NewAllocAffinityIterator(ctx, s.nodeAffinity)
+
 	// Apply scores based on spread stanza
-	s.spread = NewSpreadIterator(ctx, s.nodeAffinity)
+	s.spread = NewSpreadIterator(ctx, s.allocAffinity)
 
 	// Add the preemption options scoring iterator
 	preemptionScorer := NewPreemptionScoringIterator(ctx, s.spread)
@@ -128,6 +128,31 @@ func TestServiceStack_Select_Size(t *testing.T) {
 	}
 }
 
+func TestServiceStack_Select_Explain(t *testing.T) {
+	ci.Parallel(t)
+
+	_, ctx := testContext(t)
+	nodes := make([]*structs.Node, 20)
+	for i := range nodes {
+		nodes[i] = mock.Node()
+	}
+
+	job := mock.Job()
+	ctx.SetExplain(true)
+
+	// Without explain the limit iterator only visits a few nodes
+	stack := NewGenericStack(false, ctx)
+	stack.SetNodes(nodes)
+	stack.SetJob(job)
+	require.NotNil(t, stack.Select(job.TaskGroups[0], &SelectOptions{}))
+	require.Less(t, len(ctx.Metrics().NodeExplanations), len(nodes))
+
+	// With explain every node is visited and explained
+	stack.SetExplain(true)
+	require.NotNil(t, stack.Select(job.TaskGroups[0], &SelectOptions{}))
+	require.Len(t, ctx.Metrics().NodeExplanations, len(nodes))
+}
+
 func TestServiceStack_Select_PreferringNodes(t *testing.T) {
 	ci.Parallel(t)
 
@@ -0,0 +1,327 @@
+package scheduler
+
+import (
+	"github.com/hashicorp/nomad/helper"
+	"github.com/hashicorp/nomad/nomad/structs"
+)
+
+// systemDeploymentEnabled returns whether a system job task group with the
+// given update strategy is rolled out by a deployment. Deployments are only
+// created for task groups using canaries, staged rollouts or auto revert, all
+// other task groups are rolled out using the job stagger.
+func systemDeploymentEnabled(u *structs.UpdateStrategy) bool {
+	if u == nil {
+		return false
+	}
+	return u.Canary > 0 || u.CanaryPercent > 0 || u.AutoRevert || len(u.Stages) > 0
+}
+
+// splitDeployedUpdates splits the destructive updates into the ones of task
+// groups rolled out by a deployment and the ones rolled out using the job
+// stagger.
+func (s *SystemScheduler) splitDeployedUpdates(updates []allocTuple) ([]allocTuple, []allocTuple) {
+	if s.sysbatch || s.job.Stopped() {
+		return nil, updates
+	}
+
+	var deployed, rolling []allocTuple
+	for _, update := range updates {
+		if systemDeploymentEnabled(update.TaskGroup.Update) {
+			deployed = append(deployed, update)
+		} else {
+			rolling = append(rolling, update)
+		}
+	}
+	return deployed, rolling
+}
+
+// cancelUnneededDeployment cancels the deployment if it is for an older job
+// version or the job is stopped, and clears it if it is successful so a new
+// one can be created.
+func (s *SystemScheduler) cancelUnneededDeployment() {
+	d := s.deployment
+	if d == nil {
+		return
+	}
+
+	if s.job.Stopped() {
+		if d.Active() {
+			s.plan.DeploymentUpdates = append(s.plan.DeploymentUpdates, &structs.DeploymentStatusUpdate{
+				DeploymentID:      d.ID,
+				Status:            structs.DeploymentStatusCancelled,
+				StatusDescription: structs.DeploymentStatusDescriptionStoppedJob,
+			})
+		}
+		s.deployment = nil
+		return
+	}
+
+	if d.JobCreateIndex != s.job.CreateIndex || d.JobVersion != s.job.Version {
+		if d.Active() {
+			s.plan.DeploymentUpdates = append(s.plan.DeploymentUpdates, &structs.DeploymentStatusUpdate{
+				DeploymentID:      d.ID,
+				Status:            structs.DeploymentStatusCancelled,
+				StatusDescription: structs.DeploymentStatusDescriptionNewerJob,
+			})
+		}
+		s.deployment = nil
+		return
+	}
+
+	if d.Status == structs.DeploymentStatusSuccessful {
+		s.deployment = nil
+	}
+}
+
+// computeDeployment creates the deployment of the job version if needed and
+// replaces the allocations of the task groups it rolls out. While a task
+// group is canarying only its canaries replace allocations, once promoted
+// its allocations are replaced max_parallel at a time as the previous ones
+// become healthy.
+func (s *SystemScheduler) computeDeployment(diff *diffResult, updates []allocTuple, live []*structs.Allocation) {
+	s.canaries = nil
+	s.cancelUnneededDeployment()
+	if s.sysbatch || s.job.Stopped() {
+		return
+	}
+
+	byGroup := make(map[string][]allocTuple)
+	for _, update := range updates {
+		byGroup[update.TaskGroup.Name] = append(byGroup[update.TaskGroup.Name], update)
+	}
+	placements := make(map[string]int)
+	for _, place := range diff.place {
+		placements[place.TaskGroup.Name]++
+	}
+	current := make(map[string]int)
+	for _, ignore := range diff.ignore {
+		current[ignore.TaskGroup.Name]++
+	}
+
+	created := false
+	for _, tg := range s.job.TaskGroups {
+		if !systemDeploymentEnabled(tg.Update) {
+			continue
+		}
+
+		group := byGroup[tg.Name]
+
+		var dstate *structs.DeploymentState
+		if s.deployment != nil {
+			dstate = s.deployment.TaskGroups[tg.Name]
+		}
+
+		// Attach the task group to a new deployment if it is updating or
+		// running for the first time.
+		updating := len(group) > 0 || (placements[tg.Name] > 0 && current[tg.Name] == 0)
+		if dstate == nil && updating && (s.deployment == nil || created) {
+			if s.deployment == nil {
+				s.deployment = structs.NewDeployment(s.job, s.eval.Priority)
+				created = true
+			}
+			dstate = newSystemDeploymentState(tg, len(group), s.eligibleNodes(tg))
+			s.deployment.TaskGroups[tg.Name] = dstate
+		}
+
+		limit, canarying := s.deploymentLimit(tg, dstate, len(group), live)
+		if canarying {
+			for i := 0; i < len(group) && i < limit; i++ {
+				if s.canaries == nil {
+					s.canaries = make(map[string]struct{})
+				}
+				s.canaries[group[i].Alloc.ID] = struct{}{}
+			}
+		}
+		evictAndPlace(s.ctx, diff, group, allocUpdating, &limit)
+	}
+
+	if created {
+		s.plan.Deployment = s.deployment
+	}
+}
+
+// completeDeployment is called once the allocations are placed. It adds the
+// allocations placed on new nodes to a created deployment and marks an
+// existing one as successful once every allocation it rolls out is healthy.
+// Placements are only known at this point since nodes not meeting the task
+// group constraints are filtered when placing.
+func (s *SystemScheduler) completeDeployment(updates []allocTuple) {
+	d := s.deployment
+	if d == nil {
+		return
+	}
+
+	remaining := make(map[string]int)
+	replaced := make(map[string]struct{}, len(updates))
+	for _, update := range updates {
+		remaining[update.TaskGroup.Name]++
+		replaced[update.Alloc.ID] = struct{}{}
+	}
+
+	placed := make(map[string]int)
+	for _, allocs := range s.plan.NodeAllocation {
+		for _, alloc := range allocs {
+			if alloc.DeploymentID != d.ID {
+				continue
+			}
+			if _, ok := replaced[alloc.PreviousAllocation]; !ok {
+				placed[alloc.TaskGroup]++
+			}
+		}
+	}
+
+	if s.plan.Deployment != nil {
+		for name, dstate := range d.TaskGroups {
+			dstate.DesiredTotal += placed[name]
+			if dstate.DesiredTotal == 0 {
+				delete(d.TaskGroups, name)
+			}
+		}
+
+		// Drop the deployment if none of its task groups could be placed
+		if len(d.TaskGroups) == 0 {
+			s.plan.Deployment = nil
+			s.deployment = nil
+			return
+		}
+
+		if d.RequiresPromotion() {
+			if d.HasAutoPromote() {
+				d.StatusDescription = structs.DeploymentStatusDescriptionRunningAutoPromotion
+			} else {
+				d.StatusDescription = structs.DeploymentStatusDescriptionRunningNeedsPromotion
+			}
+		}
+		return
+	}
+
+	if d.Status != structs.DeploymentStatusRunning {
+		return
+	}
+
+	for name, dstate := range d.TaskGroups {
+		if remaining[name] > 0 || placed[name] > 0 ||
+			dstate.HealthyAllocs < helper.Max(dstate.DesiredTotal, dstate.DesiredCanaries) ||
+			(dstate.DesiredCanaries > 0 && !dstate.Promoted) {
+			return
+		}
+	}
+
+	s.plan.DeploymentUpdates = append(s.plan.DeploymentUpdates, &structs.DeploymentStatusUpdate{
+		DeploymentID:      d.ID,
+		Status:            structs.DeploymentStatusSuccessful,
+		StatusDescription: structs.DeploymentStatusDescriptionSuccessful,
+	})
+}
+
+// newSystemDeploymentState returns the deployment state of a system job task
+// group given the number of its destructive updates and of the nodes eligible
+// to run it. Canaries expressed as a percentage are computed from the eligible
+// nodes. DesiredTotal is the number of allocations placed by the deployment:
+// it starts at the number of destructive updates and completeDeployment adds
+// the placements on nodes that weren't running the task group.
+func newSystemDeploymentState(tg *structs.TaskGroup, updates, eligible int) *structs.DeploymentState {
+	u := tg.Update
+
+	canaries := u.Canary
+	if u.CanaryPercent > 0 {
+		canaries = (eligible*u.CanaryPercent + 99) / 100
+	}
+
+	// Canaries replace existing allocations so there can't be more of them
+	// than destructive updates.
+	if canaries > updates {
+		canaries = updates
+	}
+
+	return &structs.DeploymentState{
+		// Staged rollouts are always reverted when a stage fails.
+		AutoRevert:       u.AutoRevert || len(u.Stages) > 0,
+		AutoPromote:      u.AutoPromote,
+		ProgressDeadline: u.ProgressDeadline,
+		Stages:           structs.CopySliceUpdateStages(u.Stages),
+		DesiredCanaries:  canaries,
+		DesiredTotal:     updates,
+	}
+}
+
+// eligibleNodes returns the number of ready nodes that meet the constraints
+// and drivers of the task group. The checks use their own context so they
+// aren't recorded in the metrics of the placements.
+func (s *SystemScheduler) eligibleNodes(tg *structs.TaskGroup) int {
+	ctx := NewEvalContext(nil, s.state, s.plan, s.logger)
+	tgConstr := taskGroupConstraints(tg)
+	checkers := []FeasibilityChecker{
+		NewConstraintChecker(ctx, s.job.Constraints),
+		NewConstraintChecker(ctx, tgConstr.constraints),
+		NewDriverChecker(ctx, tgConstr.drivers),
+	}
+
+	eligible := 0
+OUTER:
+	for _, node := range s.nodes {
+		for _, c := range checkers {
+			if !c.Feasible(node) {
+				continue OUTER
+			}
+		}
+		eligible++
+	}
+	return eligible
+}
+
+// deploymentLimit returns how many allocations of the task group can be
+// replaced given its deployment state and whether they are canaries.
+func (s *SystemScheduler) deploymentLimit(tg *structs.TaskGroup, dstate *structs.DeploymentState,
+	updates int, live []*structs.Allocation) (int, bool) {
+
+	limit := tg.Update.MaxParallel
+	if limit <= 0 {
+		limit = updates
+	}
+
+	d := s.deployment
+	if d == nil {
+		return limit, false
+	}
+
+	// Nothing is replaced while the deployment is paused or after it failed
+	if !d.Active() || d.Status == structs.DeploymentStatusPaused {
+		return 0, false
+	}
+
+	if dstate == nil {
+		return limit, false
+	}
+
+	var placed, canaries int
+	for _, alloc := range live {
+		if alloc.TaskGroup != tg.Name || alloc.DeploymentID != d.ID {
+			continue
+		}
+
+		placed++
+		if alloc.DeploymentStatus.IsCanary() {
+			canaries++
+		}
+
+		// Wait for the allocations placed by the deployment to be healthy
+		// before replacing more of them, and stop on the first unhealthy
+		// one.
+		if alloc.DeploymentStatus.IsUnhealthy() {
+			return 0, false
+		} else if !alloc.DeploymentStatus.IsHealthy() {
+			limit--
+		}
+	}
+
+	if dstate.DesiredCanaries > 0 && !dstate.Promoted {
+		return helper.Max(dstate.DesiredCanaries-canaries, 0), true
+	}
+
+	if dstate.StagedRollout() {
+		limit = helper.Min(limit, dstate.StageAllowance()-placed)
+	}
+
+	return helper.Max(limit, 0), false
+}
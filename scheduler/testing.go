@@ -86,6 +86,17 @@ func NewHarnessWithState(t testing.TB, state *state.StateStore) *Harness {
 	}
 }
 
+// NewSimulationHarness creates a harness for invoking the schedulers against
+// the given state outside of tests. Plans are applied to the state starting at
+// index, which must be above the latest index of the state.
+func NewSimulationHarness(state *state.StateStore, index uint64) *Harness {
+	return &Harness{
+		State:                     state,
+		nextIndex:                 index,
+		serversMeetMinimumVersion: true,
+	}
+}
+
 // SubmitPlan is used to handle plan submission
 func (h *Harness) SubmitPlan(plan *structs.Plan) (*structs.PlanResult, State, error) {
 	// Ensure sequential plan application
@@ -360,6 +360,13 @@ func diffSystemAllocs(
 // readyNodesInDCs returns all the ready nodes in the given datacenters and a
 // mapping of each data center to the count of ready nodes.
 func readyNodesInDCs(state State, dcs []string) ([]*structs.Node, map[string]struct{}, map[string]int, error) {
+	return readyNodesInDCsAndPool(state, dcs, "")
+}
+
+// readyNodesInDCsAndPool returns all the ready nodes in the given datacenters
+// that are part of the given node pool, and a mapping of each data center to
+// the count of ready nodes. An empty pool matches every node.
+func readyNodesInDCsAndPool(state State, dcs []string, pool string) ([]*structs.Node, map[string]struct{}, map[string]int, error) {
 	// Index the DCs
 	dcMap := make(map[string]int, len(dcs))
 	for _, dc := range dcs {
@@ -380,7 +387,7 @@ func readyNodesInDCs(state State, dcs []string) ([]*structs.Node, map[string]str
 			break
 		}
 
-		// Filter on datacenter and status
+		// Filter on datacenter, node pool and status
 		node := raw.(*structs.Node)
 		if !node.Ready() {
 			notReady[node.ID] = struct{}{}
@@ -389,6 +396,9 @@ func readyNodesInDCs(state State, dcs []string) ([]*structs.Node, map[string]str
 		if _, ok := dcMap[node.Datacenter]; !ok {
 			continue
 		}
+		if !structs.NodePoolMatches(pool, node.NodePool) {
+			continue
+		}
 		out = append(out, node)
 		dcMap[node.Datacenter]++
 	}
@@ -525,6 +535,11 @@ func tasksUpdated(jobA, jobB *structs.Job, taskGroup string) bool {
 		return true
 	}
 
+	// Check allocation affinities
+	if allocAffinitiesUpdated(jobA, jobB, taskGroup) {
+		return true
+	}
+
 	// Check consul namespace updated
 	if consulNamespaceUpdated(a, b) {
 		return true
@@ -588,21 +603,39 @@ func tasksUpdated(jobA, jobB *structs.Job, taskGroup string) bool {
 		}
 
 		// Inspect the non-network resources
-		if ar, br := at.Resources, bt.Resources; ar.CPU != br.CPU {
+		if ar, br := at.Resources, bt.Resources; resizeDestructive(br, ar) {
 			return true
 		} else if ar.Cores != br.Cores {
 			return true
-		} else if ar.MemoryMB != br.MemoryMB {
-			return true
-		} else if ar.MemoryMaxMB != br.MemoryMaxMB {
+		} else if !ar.NUMA.Equal(br.NUMA) {
 			return true
 		} else if !ar.Devices.Equal(&br.Devices) {
 			return true
+		} else if !ar.Custom.Equal(br.Custom) {
+			return true
 		}
 	}
 	return false
 }
 
+// resizeDestructive returns whether changing the cpu and memory of a task from
+// the old to the new resources requires a destructive update. Increases of cpu
+// and memory are applied in place as long as they fit on the node.
+func resizeDestructive(old, new *structs.Resources) bool {
+	return new.CPU < old.CPU ||
+		new.MemoryMB < old.MemoryMB ||
+		memoryLimit(new) < memoryLimit(old)
+}
+
+// memoryLimit returns the memory limit of a task in MB, which is its memory
+// reservation unless memory oversubscription raises it.
+func memoryLimit(r *structs.Resources) int {
+	if r.MemoryMaxMB > r.MemoryMB {
+		return r.MemoryMaxMB
+	}
+	return r.MemoryMB
+}
+
 // consulNamespaceUpdated returns true if the Consul namespace in the task group
 // has been changed.
 //
@@ -775,6 +808,29 @@ func affinitiesUpdated(jobA, jobB *structs.Job, taskGroup string) bool {
 	return !reflect.DeepEqual(aAffinities, bAffinities)
 }
 
+func allocAffinitiesUpdated(jobA, jobB *structs.Job, taskGroup string) bool {
+	var aAffinities []*structs.AllocAffinity
+	var bAffinities []*structs.AllocAffinity
+
+	tgA := jobA.LookupTaskGroup(taskGroup)
+	tgB := jobB.LookupTaskGroup(taskGroup)
+
+	// Append jobA job and task group level allocation affinities
+	aAffinities = append(aAffinities, jobA.AllocAffinities...)
+	aAffinities = append(aAffinities, tgA.AllocAffinities...)
+
+	// Append jobB job and task group level allocation affinities
+	bAffinities = append(bAffinities, jobB.AllocAffinities...)
+	bAffinities = append(bAffinities, tgB.AllocAffinities...)
+
+	// Check for equality
+	if len(aAffinities) != len(bAffinities) {
+		return true
+	}
+
+	return !reflect.DeepEqual(aAffinities, bAffinities)
+}
+
 func spreadsUpdated(jobA, jobB *structs.Job, taskGroup string) bool {
 	var aSpreads []*structs.Spread
 	var bSpreads []*structs.Spread
@@ -1067,6 +1123,24 @@ func desiredUpdates(diff *diffResult, inplaceUpdates,
 	return desiredTgs
 }
 
+// schedulerAlgorithms returns the effective scheduler algorithm of each task
+// group of the job, taking the job, namespace and cluster settings into
+// account.
+func schedulerAlgorithms(state State, job *structs.Job) map[string]structs.SchedulerAlgorithm {
+	if job == nil || job.Stopped() {
+		return nil
+	}
+
+	_, schedConfig, _ := state.SchedulerConfig()
+	ns, _ := state.NamespaceByName(nil, job.Namespace)
+
+	algorithms := make(map[string]structs.SchedulerAlgorithm, len(job.TaskGroups))
+	for _, tg := range job.TaskGroups {
+		algorithms[tg.Name] = structs.EffectiveSchedulerAlgorithm(schedConfig, ns, job, tg)
+	}
+	return algorithms
+}
+
 // adjustQueuedAllocations decrements the number of allocations pending per task
 // group based on the number of allocations successfully placed
 func adjustQueuedAllocations(logger log.Logger, result *structs.PlanResult, queuedAllocs map[string]int) {
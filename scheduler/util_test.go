@@ -880,6 +880,27 @@ func TestTasksUpdated(t *testing.T) {
 	j11.TaskGroups[0].Tasks[0].Resources.CPU = 1337
 	require.True(t, tasksUpdated(j1, j11, name))
 
+	// Increases of cpu and memory are applied in place
+	j11c := mock.Job()
+	j11c.TaskGroups[0].Tasks[0].Resources.CPU = 1337
+	require.False(t, tasksUpdated(j11c, j1, name))
+
+	j11m := mock.Job()
+	j11m.TaskGroups[0].Tasks[0].Resources.MemoryMB = 1024
+	require.False(t, tasksUpdated(j11m, j1, name))
+	require.True(t, tasksUpdated(j1, j11m, name))
+
+	j11mm := mock.Job()
+	j11mm.TaskGroups[0].Tasks[0].Resources.MemoryMaxMB = 1024
+	require.False(t, tasksUpdated(j11mm, j1, name))
+	require.True(t, tasksUpdated(j1, j11mm, name))
+
+	// Raising the reservation up to the previous limit keeps the limit
+	j11mr := j11mm.Copy()
+	j11mr.TaskGroups[0].Tasks[0].Resources.MemoryMB = 1024
+	j11mr.TaskGroups[0].Tasks[0].Resources.MemoryMaxMB = 0
+	require.False(t, tasksUpdated(j11mr, j11mm, name))
+
 	j11d1 := mock.Job()
 	j11d1.TaskGroups[0].Tasks[0].Resources.Devices = structs.ResourceDevices{
 		&structs.RequestedDevice{